@@ -18,7 +18,7 @@ func NewRootCommand() *cobra.Command {
 	var envFile string
 	root := &cobra.Command{Use: "appointment", Short: "Appointment service", SilenceUsage: true}
 	root.PersistentFlags().StringVar(&envFile, "env-file", "", "optional dotenv file")
-	root.AddCommand(appCommand(&envFile), migrateCommand(&envFile))
+	root.AddCommand(appCommand(&envFile), migrateCommand(&envFile), backfillLegacyCommand(&envFile), repairCustomerNamesCommand(&envFile))
 	return root
 }
 
@@ -42,6 +42,7 @@ func appCommand(envFile *string) *cobra.Command {
 		runner.Add(appruntime.HTTPServer("http server", c.GetHttpServer(), 10*time.Second))
 		runner.Add(appruntime.Consumer("appointment lifecycle consumer", c.GetAppointmentLifecycleConsumer()))
 		runner.Add(appruntime.Consumer("notification outcomes consumer", c.GetNotificationOutcomeQueueConsumer()))
+		runner.Add(appruntime.Consumer("customer changes consumer", c.GetCustomerChangedConsumer()))
 
 		return runner.Run(ctx)
 	}}
@@ -79,3 +80,67 @@ func migrateCommand(envFile *string) *cobra.Command {
 		return nil
 	}}
 }
+
+func backfillLegacyCommand(envFile *string) *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{Use: "backfill-legacy", Short: "Backfill legacy agenda events into the detail tables and verify parity", Args: cobra.NoArgs, RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := di.NewDiContainer(cmd.Context(), *envFile)
+		if err != nil {
+			return err
+		}
+		defer c.GetPostgresDatabase().Close()
+		repository := c.GetPostgresRepository()
+		out := cmd.OutOrStdout()
+
+		report, err := repository.BackfillLegacyAgendaEvents(cmd.Context(), dryRun)
+		if err != nil {
+			return fmt.Errorf("backfill legacy agenda events: %w", err)
+		}
+		fmt.Fprintf(out, "candidates=%d backfilled=%d failed=%d dry_run=%v\n", report.Candidates, report.Backfilled, len(report.Failures), dryRun)
+		for _, failure := range report.Failures {
+			fmt.Fprintf(out, "failed %s: %v\n", failure.AgendaEventID, failure.Err)
+		}
+		mismatches, err := repository.VerifyLegacyAgendaEvents(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("verify legacy agenda events: %w", err)
+		}
+		mismatches = append(report.Mismatches, mismatches...)
+		fmt.Fprintf(out, "mismatches=%d\n", len(mismatches))
+		for _, mismatch := range mismatches {
+			fmt.Fprintf(out, "mismatch %s\n", mismatch)
+		}
+		if len(report.Failures) > 0 || len(mismatches) > 0 {
+			return fmt.Errorf("legacy backfill incomplete: %d failed, %d mismatches", len(report.Failures), len(mismatches))
+		}
+		return nil
+	}}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "count the legacy agenda events and verify parity without writing")
+	return cmd
+}
+
+func repairCustomerNamesCommand(envFile *string) *cobra.Command {
+	return &cobra.Command{Use: "repair-customer-names", Short: "Copy the current customer names from the customer service to all appointments", Args: cobra.NoArgs, RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := di.NewDiContainer(cmd.Context(), *envFile)
+		if err != nil {
+			return err
+		}
+		defer c.GetPostgresDatabase().Close()
+		out := cmd.OutOrStdout()
+
+		repair, err := c.GetCustomerNameService().RepairCustomerNames(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("repair customer names: %w", err)
+		}
+		fmt.Fprintf(out, "customers=%d renamed=%d missing=%d failed=%d\n", repair.Customers, repair.Renamed, len(repair.Missing), len(repair.Failures))
+		for _, id := range repair.Missing {
+			fmt.Fprintf(out, "missing %s\n", id)
+		}
+		for _, failure := range repair.Failures {
+			fmt.Fprintf(out, "failed %s: %v\n", failure.CustomerID, failure.Err)
+		}
+		if len(repair.Failures) > 0 {
+			return fmt.Errorf("customer name repair incomplete: %d failed", len(repair.Failures))
+		}
+		return nil
+	}}
+}
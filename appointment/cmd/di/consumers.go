@@ -7,7 +7,10 @@ func (d *DiContainer) GetAppointmentLifecycleConsumer() *messaging.Consumer {
 		return messaging.NewConsumer(
 			d.Config.RabbitMQ.URL,
 			d.Config.RabbitMQ.AppointmentInternalJobQueue,
-			messaging.NewAppointmentLifecycleConsumer(d.GetAppointmentLifecycleServiceV2(), d.Log),
+			messaging.NewAppointmentLifecycleConsumer(messaging.LifecycleEventHandlers{
+				d.GetAppointmentLifecycleServiceV2(),
+				d.GetWaitlistService(),
+			}, d.GetWebhookService(), d.Log),
 			d.Log,
 		)
 	})
@@ -23,3 +26,14 @@ func (d *DiContainer) GetNotificationOutcomeQueueConsumer() *messaging.Consumer
 		)
 	})
 }
+
+func (d *DiContainer) GetCustomerChangedConsumer() *messaging.Consumer {
+	return singleton(d, "customerChangedConsumer", func() *messaging.Consumer {
+		return messaging.NewConsumer(
+			d.Config.RabbitMQ.URL,
+			d.Config.RabbitMQ.CustomerChangesQueue,
+			messaging.NewCustomerChangedConsumer(d.GetCustomerNameService(), d.Log),
+			d.Log,
+		)
+	})
+}
@@ -10,7 +10,11 @@ import (
 func (d *DiContainer) GetHttpServer() *nethttp.Server {
 	return singleton(d, "httpServer", func() *nethttp.Server {
 		ginEngine := server.New(d.GetHttpHandlers(), d.Log)
-		return &nethttp.Server{Addr: d.Config.HTTP.Addr, Handler: ginEngine}
+		// gRPC clients talk HTTP/2 without TLS to the Connect handlers.
+		protocols := new(nethttp.Protocols)
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		return &nethttp.Server{Addr: d.Config.HTTP.Addr, Handler: ginEngine, Protocols: protocols}
 	})
 }
 
@@ -25,7 +29,7 @@ func (d *DiContainer) GetHttpHandlers() *server.HttpHandlers {
 
 func (d *DiContainer) CalendarHttpHandler() *server.Server {
 	return singleton(d, "calendarHttpHandler", func() *server.Server {
-		return server.NewServer(d.GetAppointmentLifecycleServiceV2(), d.GetCalendarService(), d.GetServiceService(), d.Log)
+		return server.NewServer(d.GetAppointmentLifecycleServiceV2(), d.GetCalendarService(), d.GetCalendarRegistry(), d.GetCalendarFeedService(), d.GetTimeBlockImportService(), d.GetServiceService(), d.GetInsightService(), d.GetAvailabilityService(), d.GetWaitlistService(), d.GetWebhookService(), d.Log)
 	})
 }
 
@@ -60,11 +60,27 @@ func (d *DiContainer) GetRiverClient() *river.Client[pgx.Tx] {
 		if err := river.AddWorkerSafely(workers, jobs.NewSendAppointmentReminderWorker(d.GetAppointmentLifecycleServiceV2())); err != nil {
 			return nil, err
 		}
+		if err := river.AddWorkerSafely(workers, jobs.NewExpireWaitlistOfferWorker(d.GetWaitlistService())); err != nil {
+			return nil, err
+		}
+		if err := river.AddWorkerSafely(workers, jobs.NewDeliverWebhookWorker(d.GetWebhookService())); err != nil {
+			return nil, err
+		}
+		if err := river.AddWorkerSafely(workers, jobs.NewExpireAppointmentNotificationsWorker(d.GetAppointmentLifecycleServiceV2(), d.Log)); err != nil {
+			return nil, err
+		}
+		if err := river.AddWorkerSafely(workers, jobs.NewReconcileAppointmentRemindersWorker(d.GetReminderReconciler(), d.Log)); err != nil {
+			return nil, err
+		}
 		return river.NewClient(riverpgxv5.New(d.GetPostgresDatabase()), &river.Config{
 			Queues: map[string]river.QueueConfig{
 				riverConfig.Queue: {MaxWorkers: riverConfig.Workers},
 			},
 			Workers: workers,
+			PeriodicJobs: []*river.PeriodicJob{
+				jobs.NewExpireAppointmentNotificationsPeriodicJob(riverConfig.NotificationExpiryInterval, riverConfig.Queue),
+				jobs.NewReconcileAppointmentRemindersPeriodicJob(riverConfig.ReminderReconciliationInterval, riverConfig.Queue),
+			},
 		})
 	})
 }
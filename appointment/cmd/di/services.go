@@ -1,27 +1,79 @@
 package di
 
 import (
+	"time"
+
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/application"
 	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/config"
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/jobs"
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/messaging"
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/postgres"
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/port/http/client/customer"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/port/http/client/webhook"
 )
 
 type RiverReminderConfig struct {
-	Queue       string
-	Workers     int
-	MaxAttempts int
+	Queue                          string
+	Workers                        int
+	MaxAttempts                    int
+	NotificationExpiryInterval     time.Duration
+	ReminderReconciliationInterval time.Duration
 }
 
 func (d *DiContainer) GetCalendarService() *applicationv2.CalendarService {
-	return singleton(d, "calendarService", func() *applicationv2.CalendarService {
+	return singletonWithError(d, "calendarService", func() (*applicationv2.CalendarService, error) {
+		conflictPolicy, err := applicationv2.ParseConflictPolicy(d.Config.Calendar.ConflictPolicy)
+		if err != nil {
+			return nil, err
+		}
 		return applicationv2.NewCalendarService(
 			d.GetPostgresRepository(),
 			d.GetCustomerResolver(),
 			d.GetClock(),
-		)
+			conflictPolicy,
+			d.GetReminderPolicy(),
+		), nil
+	})
+}
+
+func (d *DiContainer) GetReminderPolicy() applicationv2.ReminderPolicy {
+	remindBefore := d.Config.Reminder.RemindBefore
+	if len(remindBefore) == 0 {
+		triggerBefore := d.Config.Reminder.TriggerBefore
+		if triggerBefore <= 0 {
+			triggerBefore = 24 * time.Hour
+		}
+		remindBefore = []time.Duration{triggerBefore}
+	}
+	return applicationv2.ReminderPolicy{
+		RemindBefore:    remindBefore,
+		MinRemindBefore: d.Config.Reminder.MinRemindBefore,
+		MaxRemindBefore: d.Config.Reminder.MaxRemindBefore,
+	}
+}
+
+func (d *DiContainer) GetCalendarRegistry() *applicationv2.CalendarRegistry {
+	return singleton(d, "calendarRegistry", func() *applicationv2.CalendarRegistry {
+		return applicationv2.NewCalendarRegistry(d.GetPostgresRepository(), d.GetClock())
+	})
+}
+
+func (d *DiContainer) GetCalendarFeedService() *applicationv2.CalendarFeedService {
+	return singleton(d, "calendarFeedService", func() *applicationv2.CalendarFeedService {
+		repository := d.GetPostgresRepository()
+		return applicationv2.NewCalendarFeedService(repository, repository, d.Config.Calendar.FeedSecret, d.GetClock())
+	})
+}
+
+func (d *DiContainer) GetTimeBlockImportService() *applicationv2.TimeBlockImportService {
+	return singletonWithError(d, "timeBlockImportService", func() (*applicationv2.TimeBlockImportService, error) {
+		location, err := time.LoadLocation(d.GetCalendarConfig().Timezone)
+		if err != nil {
+			return nil, err
+		}
+		return applicationv2.NewTimeBlockImportService(d.GetPostgresRepository(), location, d.GetClock()), nil
 	})
 }
 
@@ -31,15 +83,99 @@ func (d *DiContainer) GetServiceService() *application.ServiceService {
 	})
 }
 
+func (d *DiContainer) GetAvailabilityService() *applicationv2.AvailabilityService {
+	return singletonWithError(d, "availabilityService", func() (*applicationv2.AvailabilityService, error) {
+		calendarConfig := d.GetCalendarConfig()
+		openingHours, err := domainv2.ParseOpeningHours(calendarConfig.Timezone, calendarConfig.OpeningHours)
+		if err != nil {
+			return nil, err
+		}
+		return applicationv2.NewAvailabilityService(
+			d.GetPostgresRepository(),
+			openingHours,
+			calendarConfig.SlotInterval,
+			d.GetClock(),
+		), nil
+	})
+}
+
+func (d *DiContainer) GetCalendarConfig() config.CalendarConfig {
+	cfg := d.Config.Calendar
+	if cfg.Timezone == "" {
+		cfg.Timezone = "Europe/Rome"
+	}
+	if cfg.SlotInterval <= 0 {
+		cfg.SlotInterval = 15 * time.Minute
+	}
+	return cfg
+}
+
+func (d *DiContainer) GetInsightService() *applicationv2.InsightService {
+	return singleton(d, "insightService", func() *applicationv2.InsightService {
+		return applicationv2.NewInsightService(d.GetPostgresRepository())
+	})
+}
+
 func (d *DiContainer) GetAppointmentLifecycleServiceV2() *applicationv2.AppointmentLifecycleService {
-	return singleton(d, "appointmentLifecycleServiceV2", func() *applicationv2.AppointmentLifecycleService {
+	return singletonWithError(d, "appointmentLifecycleServiceV2", func() (*applicationv2.AppointmentLifecycleService, error) {
+		reminderConfig := d.Config.Reminder
+		sendWindow, err := domainv2.ParseReminderSendWindow(d.GetCalendarConfig().Timezone, reminderConfig.SendWindow, reminderConfig.QuietWeekdays, reminderConfig.Holidays)
+		if err != nil {
+			return nil, err
+		}
 		return applicationv2.NewAppointmentLifecycleService(
 			d.GetPostgresRepository(),
 			d.GetReminderScheduler(),
 			d.GetCustomerNotificationSenderV2(),
 			d.GetClock(),
-			d.Config.Reminder.NoSendThreshold,
-			d.Config.Reminder.ImmediateSendThreshold,
+			reminderConfig.NoSendThreshold,
+			reminderConfig.ImmediateSendThreshold,
+			sendWindow,
+		), nil
+	})
+}
+
+func (d *DiContainer) GetReminderReconciler() *applicationv2.ReminderReconciler {
+	return singleton(d, "reminderReconciler", func() *applicationv2.ReminderReconciler {
+		scheduler := d.GetReminderScheduler()
+		return applicationv2.NewReminderReconciler(d.GetPostgresRepository(), scheduler, scheduler, d.GetClock())
+	})
+}
+
+func (d *DiContainer) GetCustomerNameService() *applicationv2.CustomerNameService {
+	return singleton(d, "customerNameService", func() *applicationv2.CustomerNameService {
+		return applicationv2.NewCustomerNameService(d.GetPostgresRepository(), d.GetCustomerResolver(), d.GetClock())
+	})
+}
+
+func (d *DiContainer) GetWaitlistService() *applicationv2.WaitlistService {
+	return singleton(d, "waitlistService", func() *applicationv2.WaitlistService {
+		offerTTL := d.Config.Waitlist.OfferTTL
+		if offerTTL <= 0 {
+			offerTTL = 2 * time.Hour
+		}
+		return applicationv2.NewWaitlistService(
+			d.GetPostgresRepository(),
+			d.GetCustomerResolver(),
+			d.GetCustomerNotificationSenderV2(),
+			d.GetWaitlistOfferScheduler(),
+			d.GetClock(),
+			offerTTL,
+		)
+	})
+}
+
+func (d *DiContainer) GetWebhookService() *applicationv2.WebhookService {
+	return singleton(d, "webhookService", func() *applicationv2.WebhookService {
+		timeout := d.Config.Webhook.Timeout
+		if timeout <= 0 {
+			timeout = 10 * time.Second
+		}
+		return applicationv2.NewWebhookService(
+			d.GetPostgresRepository(),
+			webhook.NewSender(timeout),
+			d.GetWebhookDeliveryScheduler(),
+			d.GetClock(),
 		)
 	})
 }
@@ -68,8 +204,8 @@ func (d *DiContainer) GetPostgresRepository() *postgres.Repository {
 	})
 }
 
-func (d *DiContainer) GetCustomerNotificationSenderV2() applicationv2.CalendarNotificationSender {
-	return singleton(d, "customerNotificationSenderV2", func() applicationv2.CalendarNotificationSender {
+func (d *DiContainer) GetCustomerNotificationSenderV2() *messaging.CustomerNotificationSender {
+	return singleton(d, "customerNotificationSenderV2", func() *messaging.CustomerNotificationSender {
 		return messaging.NewCustomerNotificationSender(d.GetOutboxPublisher())
 	})
 }
@@ -86,11 +222,40 @@ func (d *DiContainer) GetReminderScheduler() *jobs.ReminderScheduler {
 	})
 }
 
+func (d *DiContainer) GetWaitlistOfferScheduler() *jobs.WaitlistOfferScheduler {
+	return singleton(d, "waitlistOfferScheduler", func() *jobs.WaitlistOfferScheduler {
+		riverConfig := d.GetRiverReminderConfig()
+		return jobs.NewWaitlistOfferScheduler(
+			d.GetRiverJobInserter(),
+			riverConfig.Queue,
+			riverConfig.MaxAttempts,
+			d.Log,
+		)
+	})
+}
+
+func (d *DiContainer) GetWebhookDeliveryScheduler() *jobs.WebhookDeliveryScheduler {
+	return singleton(d, "webhookDeliveryScheduler", func() *jobs.WebhookDeliveryScheduler {
+		maxAttempts := d.Config.Webhook.MaxAttempts
+		if maxAttempts <= 0 {
+			maxAttempts = 8
+		}
+		return jobs.NewWebhookDeliveryScheduler(
+			d.GetRiverJobInserter(),
+			d.GetRiverReminderConfig().Queue,
+			maxAttempts,
+			d.Log,
+		)
+	})
+}
+
 func (d *DiContainer) GetRiverReminderConfig() RiverReminderConfig {
 	cfg := RiverReminderConfig{
-		Queue:       d.Config.River.Queue,
-		Workers:     d.Config.River.Workers,
-		MaxAttempts: d.Config.River.MaxAttempts,
+		Queue:                          d.Config.River.Queue,
+		Workers:                        d.Config.River.Workers,
+		MaxAttempts:                    d.Config.River.MaxAttempts,
+		NotificationExpiryInterval:     d.Config.River.NotificationExpiryInterval,
+		ReminderReconciliationInterval: d.Config.River.ReminderReconciliationInterval,
 	}
 	if cfg.Queue == "" {
 		cfg.Queue = "appointment_reminders"
@@ -101,5 +266,11 @@ func (d *DiContainer) GetRiverReminderConfig() RiverReminderConfig {
 	if cfg.MaxAttempts <= 0 {
 		cfg.MaxAttempts = 3
 	}
+	if cfg.NotificationExpiryInterval <= 0 {
+		cfg.NotificationExpiryInterval = 15 * time.Minute
+	}
+	if cfg.ReminderReconciliationInterval <= 0 {
+		cfg.ReminderReconciliationInterval = time.Hour
+	}
 	return cfg
 }
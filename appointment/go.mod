@@ -3,6 +3,7 @@ module github.com/petretiandrea/beaesthetic-backend/appointment
 go 1.25.0
 
 require (
+	connectrpc.com/connect v1.19.1
 	github.com/gin-gonic/gin v1.10.1
 	github.com/golang-migrate/migrate/v4 v4.18.3
 	github.com/google/uuid v1.6.0
@@ -13,6 +14,7 @@ require (
 	github.com/knadh/koanf/v2 v2.2.2
 	github.com/oapi-codegen/runtime v1.4.2
 	github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment v0.0.0
+	github.com/petretiandrea/beaesthetic-backend/core-contracts/customer v0.0.0
 	github.com/petretiandrea/beaesthetic-backend/core-contracts/notification v0.0.0
 	github.com/petretiandrea/beaesthetic-backend/core-contracts/runtime v0.0.0
 	github.com/petretiandrea/outbox-go/pkg/outbox v0.0.0-20260622171345-cccb1d641543
@@ -86,4 +88,6 @@ replace github.com/petretiandrea/beaesthetic-backend/core-contracts/notification
 
 replace github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment => ../core-contracts/appointment
 
+replace github.com/petretiandrea/beaesthetic-backend/core-contracts/customer => ../core-contracts/customer
+
 replace github.com/petretiandrea/beaesthetic-backend/core-contracts/runtime => ../core-contracts/runtime
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain"
@@ -24,15 +25,22 @@ func NewServiceService(repo ServiceRepository) *ServiceService {
 	return &ServiceService{repo: repo}
 }
 
-func (s *ServiceService) CreateService(ctx context.Context, name string, tags []string, color *string) (domain.AppointmentService, error) {
+func (s *ServiceService) CreateService(ctx context.Context, name string, tags []string, color *string, duration time.Duration, buffer time.Duration) (domain.AppointmentService, error) {
 	name = strings.TrimSpace(name)
 	if name == "" {
 		return domain.AppointmentService{}, fmt.Errorf("service name is required")
 	}
-	return s.repo.SaveService(ctx, domain.AppointmentService{ID: uuid.NewString(), Name: name, Tags: tags, Color: color})
+	if duration < 0 || buffer < 0 {
+		return domain.AppointmentService{}, domain.ErrInvalidServiceDuration
+	}
+	return s.repo.SaveService(ctx, domain.AppointmentService{ID: uuid.NewString(), Name: name, Tags: tags, Color: color, Duration: duration, Buffer: buffer})
 }
 
-func (s *ServiceService) UpdateService(ctx context.Context, id string, tags []string, color *string) (*domain.AppointmentService, error) {
+// UpdateService changes only the non-nil fields.
+func (s *ServiceService) UpdateService(ctx context.Context, id string, tags []string, color *string, duration *time.Duration, buffer *time.Duration) (*domain.AppointmentService, error) {
+	if (duration != nil && *duration < 0) || (buffer != nil && *buffer < 0) {
+		return nil, domain.ErrInvalidServiceDuration
+	}
 	service, err := s.repo.FindService(ctx, id)
 	if err != nil || service == nil {
 		return service, err
@@ -43,6 +51,12 @@ func (s *ServiceService) UpdateService(ctx context.Context, id string, tags []st
 	if color != nil {
 		service.Color = color
 	}
+	if duration != nil {
+		service.Duration = *duration
+	}
+	if buffer != nil {
+		service.Buffer = *buffer
+	}
 	updated, err := s.repo.SaveService(ctx, *service)
 	if err != nil {
 		return nil, err
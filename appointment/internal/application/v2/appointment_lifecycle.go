@@ -15,9 +15,11 @@ var (
 	ErrInvalidReminderRequest   = errors.New("invalid reminder request")
 )
 
+// CalendarReminderScheduler schedules the delivery of a single reminder,
+// identified by the calendar event and the reminder position.
 type CalendarReminderScheduler interface {
-	ScheduleCalendarReminder(ctx context.Context, calendarEventID string, expectedStartAt time.Time, sendAt time.Time) error
-	UnscheduleCalendarReminder(ctx context.Context, calendarEventID string) error
+	ScheduleCalendarReminder(ctx context.Context, calendarEventID string, position int, expectedStartAt time.Time, sendAt time.Time) error
+	UnscheduleCalendarReminder(ctx context.Context, calendarEventID string, position int) error
 }
 
 type CalendarNotificationSender interface {
@@ -30,6 +32,7 @@ type AppointmentLifecycleRepository interface {
 	SaveAppointmentReminderState(ctx context.Context, calendarEventID string, reminder domain.AppointmentReminder) error
 	FindAppointmentNotification(ctx context.Context, correlationKey string) (*domain.AppointmentNotification, error)
 	SaveAppointmentNotification(ctx context.Context, notification domain.AppointmentNotification) error
+	FindExpiredAppointmentNotifications(ctx context.Context, now time.Time, limit int) ([]domain.AppointmentNotification, error)
 }
 
 type AppointmentLifecycleService struct {
@@ -39,9 +42,10 @@ type AppointmentLifecycleService struct {
 	clock                  Clock
 	noSendThreshold        time.Duration
 	immediateSendThreshold time.Duration
+	sendWindow             domain.ReminderSendWindow
 }
 
-func NewAppointmentLifecycleService(repository AppointmentLifecycleRepository, scheduler CalendarReminderScheduler, notifications CalendarNotificationSender, clock Clock, noSendThreshold time.Duration, immediateSendThreshold time.Duration) *AppointmentLifecycleService {
+func NewAppointmentLifecycleService(repository AppointmentLifecycleRepository, scheduler CalendarReminderScheduler, notifications CalendarNotificationSender, clock Clock, noSendThreshold time.Duration, immediateSendThreshold time.Duration, sendWindow domain.ReminderSendWindow) *AppointmentLifecycleService {
 	return &AppointmentLifecycleService{
 		repository:             repository,
 		scheduler:              scheduler,
@@ -49,6 +53,7 @@ func NewAppointmentLifecycleService(repository AppointmentLifecycleRepository, s
 		clock:                  clock,
 		noSendThreshold:        noSendThreshold,
 		immediateSendThreshold: immediateSendThreshold,
+		sendWindow:             sendWindow,
 	}
 }
 
@@ -60,12 +65,14 @@ func (s *AppointmentLifecycleService) Handle(ctx context.Context, eventType stri
 		return s.handleScheduled(ctx, calendarEventID, domain.NotificationKindRescheduled)
 	case "CalendarEventCanceled":
 		return s.handleCanceled(ctx, calendarEventID)
+	case "CalendarEventRemindersChanged":
+		return s.handleRemindersChanged(ctx, calendarEventID)
 	default:
 		return nil
 	}
 }
 
-func (s *AppointmentLifecycleService) SendDueReminder(ctx context.Context, calendarEventID string, expectedStartAt *time.Time) error {
+func (s *AppointmentLifecycleService) SendDueReminder(ctx context.Context, calendarEventID string, position int, expectedStartAt *time.Time) error {
 	return s.repository.Tx(ctx, func(ctx context.Context) error {
 		view, appointment, err := s.findAppointment(ctx, calendarEventID)
 		if errors.Is(err, ErrAppointmentNotRemindable) {
@@ -74,22 +81,25 @@ func (s *AppointmentLifecycleService) SendDueReminder(ctx context.Context, calen
 		if err != nil {
 			return err
 		}
-		if view.Event.IsCanceled() || view.Reminder == nil || view.Reminder.Status != domain.ReminderStatusScheduled {
+		reminder := view.Reminder(position)
+		if view.Event.IsCanceled() || reminder == nil || reminder.Status != domain.ReminderStatusScheduled {
 			return nil
 		}
 		if expectedStartAt != nil && !view.Event.Range.Start.Equal(expectedStartAt.UTC()) {
 			return nil
 		}
-		if err := s.sendNotification(ctx, view.Event, appointment, domain.NotificationKindReminder, notificationIdempotencyKey(view.Event, domain.NotificationTypeAppointmentReminder)); err != nil {
+		idempotencyKey := fmt.Sprintf("%s:%d", notificationIdempotencyKey(view.Event, domain.NotificationTypeAppointmentReminder), reminder.Position)
+		if err := s.sendReminder(ctx, view.Event, appointment, reminder, idempotencyKey); err != nil {
 			return err
 		}
-		view.Reminder.MarkSendRequested(s.clock.Now())
-		return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *view.Reminder)
+		return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *reminder)
 	})
 }
 
-func (s *AppointmentLifecycleService) RequestReminderResend(ctx context.Context, calendarEventID string, requestKey string) error {
-	if calendarEventID == "" || requestKey == "" {
+// RequestReminderResend sends again the reminder at position, or the first
+// reminder of the appointment when position is nil.
+func (s *AppointmentLifecycleService) RequestReminderResend(ctx context.Context, calendarEventID string, position *int, requestKey string) error {
+	if calendarEventID == "" || requestKey == "" || (position != nil && *position < 0) {
 		return ErrInvalidReminderRequest
 	}
 	return s.repository.Tx(ctx, func(ctx context.Context) error {
@@ -97,15 +107,19 @@ func (s *AppointmentLifecycleService) RequestReminderResend(ctx context.Context,
 		if err != nil {
 			return err
 		}
-		if view.Event.IsCanceled() || view.Reminder == nil {
+		target := 0
+		if position != nil {
+			target = *position
+		}
+		reminder := view.Reminder(target)
+		if view.Event.IsCanceled() || reminder == nil || reminder.Status == domain.ReminderStatusDeleted {
 			return ErrAppointmentNotRemindable
 		}
-		idempotencyKey := fmt.Sprintf("appointment:%s:reminder:resend:%s", view.Event.ID, requestKey)
-		if err := s.sendNotification(ctx, view.Event, appointment, domain.NotificationKindReminder, idempotencyKey); err != nil {
+		idempotencyKey := fmt.Sprintf("appointment:%s:reminder:%d:resend:%s", view.Event.ID, reminder.Position, requestKey)
+		if err := s.sendReminder(ctx, view.Event, appointment, reminder, idempotencyKey); err != nil {
 			return err
 		}
-		view.Reminder.MarkSendRequested(s.clock.Now())
-		return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *view.Reminder)
+		return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *reminder)
 	})
 }
 
@@ -133,19 +147,77 @@ func (s *AppointmentLifecycleService) HandleNotificationOutcome(ctx context.Cont
 		if err != nil {
 			return err
 		}
-		if view == nil || view.Reminder == nil {
+		if view == nil {
+			return ErrCalendarEventNotFound
+		}
+		reminder := view.Reminder(notificationReminderPosition(*notification))
+		if reminder == nil {
 			return ErrCalendarEventNotFound
 		}
 		if sent {
-			view.Reminder.MarkSent(now)
+			reminder.MarkSent(now)
 		} else {
-			view.Reminder.MarkFailed(reason, now)
+			reminder.MarkFailed(reason, now)
 		}
-		return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *view.Reminder)
+		return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *reminder)
 	})
 	return calendarEventID, err
 }
 
+// ExpireStaleNotifications marks expired the pending notifications whose outcome did not
+// arrive before ExpiresAt, in batches of batchSize, and fails the reminders still waiting
+// for them. It returns how many notifications expired.
+func (s *AppointmentLifecycleService) ExpireStaleNotifications(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, ErrInvalidReminderRequest
+	}
+	expired := 0
+	for {
+		found := 0
+		err := s.repository.Tx(ctx, func(ctx context.Context) error {
+			now := s.clock.Now()
+			notifications, err := s.repository.FindExpiredAppointmentNotifications(ctx, now, batchSize)
+			if err != nil {
+				return err
+			}
+			found = len(notifications)
+			for _, notification := range notifications {
+				if err := s.expireNotification(ctx, notification, now); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return expired, err
+		}
+		expired += found
+		if found < batchSize {
+			return expired, nil
+		}
+	}
+}
+
+func (s *AppointmentLifecycleService) expireNotification(ctx context.Context, notification domain.AppointmentNotification, now time.Time) error {
+	notification.MarkExpired(now)
+	if err := s.repository.SaveAppointmentNotification(ctx, notification); err != nil {
+		return err
+	}
+	if notification.Kind != domain.NotificationKindReminder {
+		return nil
+	}
+	view, err := s.repository.FindCalendarEventView(ctx, notification.CalendarEventID)
+	if err != nil || view == nil {
+		return err
+	}
+	reminder := view.Reminder(notificationReminderPosition(notification))
+	if reminder == nil || reminder.Status != domain.ReminderStatusSendRequested {
+		return nil
+	}
+	reminder.MarkFailed("notification_expired", now)
+	return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *reminder)
+}
+
 func (s *AppointmentLifecycleService) handleScheduled(ctx context.Context, calendarEventID string, notificationKind domain.NotificationKind) error {
 	return s.repository.Tx(ctx, func(ctx context.Context) error {
 		view, appointment, err := s.findAppointment(ctx, calendarEventID)
@@ -155,30 +227,51 @@ func (s *AppointmentLifecycleService) handleScheduled(ctx context.Context, calen
 		if err != nil {
 			return err
 		}
-		if view.Event.IsCanceled() || view.Reminder == nil {
+		if view.Event.IsCanceled() {
+			return nil
+		}
+		if err := s.scheduleReminders(ctx, view); err != nil {
+			return err
+		}
+		notificationType, _ := notificationTypeForKind(notificationKind)
+		return s.sendNotification(ctx, view.Event, appointment, notificationKind, notificationIdempotencyKey(view.Event, notificationType), nil)
+	})
+}
+
+// handleCanceled deletes the reminders of the appointment and, when the salon canceled an
+// appointment that has not started yet, notifies the customer.
+func (s *AppointmentLifecycleService) handleCanceled(ctx context.Context, calendarEventID string) error {
+	return s.repository.Tx(ctx, func(ctx context.Context) error {
+		view, appointment, err := s.findAppointment(ctx, calendarEventID)
+		if errors.Is(err, ErrAppointmentNotRemindable) {
 			return nil
 		}
+		if err != nil {
+			return err
+		}
 		now := s.clock.Now()
-		sendAt, sendable := computeCalendarReminderSendAt(now, view.Event.Range.Start, view.Reminder.RemindBefore, s.noSendThreshold, s.immediateSendThreshold)
-		if !sendable {
-			view.Reminder.MarkUnprocessable("too_late", now)
-		} else {
-			if err := s.scheduler.ScheduleCalendarReminder(ctx, view.Event.ID, view.Event.Range.Start, *sendAt); err != nil {
+		for index := range view.Reminders {
+			reminder := &view.Reminders[index]
+			if err := s.scheduler.UnscheduleCalendarReminder(ctx, view.Event.ID, reminder.Position); err != nil {
 				return err
 			}
-			if err := view.Reminder.Schedule(*sendAt, now); err != nil {
+			reminder.MarkDeleted(now)
+			if err := s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *reminder); err != nil {
 				return err
 			}
 		}
-		if err := s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *view.Reminder); err != nil {
-			return err
+		cancellation := view.Event.Cancellation
+		if cancellation == nil || cancellation.Reason == domain.CancelReasonCustomer || !view.Event.Range.Start.After(now.UTC()) {
+			return nil
 		}
-		notificationType, _ := notificationTypeForKind(notificationKind)
-		return s.sendNotification(ctx, view.Event, appointment, notificationKind, notificationIdempotencyKey(view.Event, notificationType))
+		idempotencyKey := notificationIdempotencyKey(view.Event, domain.NotificationTypeAppointmentCanceled)
+		return s.sendNotification(ctx, view.Event, appointment, domain.NotificationKindCanceled, idempotencyKey, nil)
 	})
 }
 
-func (s *AppointmentLifecycleService) handleCanceled(ctx context.Context, calendarEventID string) error {
+// handleRemindersChanged schedules the replaced reminders and unschedules the deleted ones, without
+// notifying the customer.
+func (s *AppointmentLifecycleService) handleRemindersChanged(ctx context.Context, calendarEventID string) error {
 	return s.repository.Tx(ctx, func(ctx context.Context) error {
 		view, _, err := s.findAppointment(ctx, calendarEventID)
 		if errors.Is(err, ErrAppointmentNotRemindable) {
@@ -187,17 +280,78 @@ func (s *AppointmentLifecycleService) handleCanceled(ctx context.Context, calend
 		if err != nil {
 			return err
 		}
-		if err := s.scheduler.UnscheduleCalendarReminder(ctx, view.Event.ID); err != nil {
-			return err
-		}
-		if view.Reminder == nil {
+		if view.Event.IsCanceled() {
 			return nil
 		}
-		view.Reminder.MarkDeleted(s.clock.Now())
-		return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *view.Reminder)
+		for _, reminder := range view.Reminders {
+			if reminder.Status != domain.ReminderStatusDeleted {
+				continue
+			}
+			if err := s.scheduler.UnscheduleCalendarReminder(ctx, view.Event.ID, reminder.Position); err != nil {
+				return err
+			}
+		}
+		return s.scheduleReminders(ctx, view)
 	})
 }
 
+// scheduleReminders schedules every reminder of the appointment that was not deleted.
+func (s *AppointmentLifecycleService) scheduleReminders(ctx context.Context, view *CalendarEventView) error {
+	active := &CalendarEventView{Event: view.Event}
+	for _, reminder := range view.Reminders {
+		if reminder.Status != domain.ReminderStatusDeleted {
+			active.Reminders = append(active.Reminders, reminder)
+		}
+	}
+	now := s.clock.Now()
+	for index := range active.Reminders {
+		if err := s.scheduleReminder(ctx, active, index, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scheduleReminder schedules the reminder at index. A reminder whose send time
+// has already passed is skipped when a later reminder can still be sent on
+// time, so a late booking does not receive every reminder at once.
+func (s *AppointmentLifecycleService) scheduleReminder(ctx context.Context, view *CalendarEventView, index int, now time.Time) error {
+	reminder := &view.Reminders[index]
+	start := view.Event.Range.Start
+	last := index == len(view.Reminders)-1
+	sendAt, sendable := computeCalendarReminderSendAt(now, start, reminder.RemindBefore, s.noSendThreshold, s.immediateSendThreshold)
+	if sendable {
+		sendAt, sendable = s.shiftIntoSendWindow(now, start, *sendAt)
+	}
+	switch {
+	case !sendable:
+		reminder.MarkUnprocessable("too_late", now)
+	case !last && start.Add(-reminder.RemindBefore).Before(now.UTC()):
+		reminder.MarkUnprocessable("superseded", now)
+	default:
+		if err := s.scheduler.ScheduleCalendarReminder(ctx, view.Event.ID, reminder.Position, start, *sendAt); err != nil {
+			return err
+		}
+		if err := reminder.Schedule(*sendAt, now); err != nil {
+			return err
+		}
+	}
+	return s.repository.SaveAppointmentReminderState(ctx, view.Event.ID, *reminder)
+}
+
+// shiftIntoSendWindow moves sendAt to the closest allowed instant before it. When the
+// window stays closed from now until sendAt, the reminder goes out at the next
+// opening that is still noSendThreshold before the start.
+func (s *AppointmentLifecycleService) shiftIntoSendWindow(now time.Time, start time.Time, sendAt time.Time) (*time.Time, bool) {
+	if shifted, ok := s.sendWindow.LatestAllowed(sendAt, now.UTC()); ok {
+		return &shifted, true
+	}
+	if shifted, ok := s.sendWindow.EarliestAllowed(sendAt, start.Add(-s.noSendThreshold)); ok {
+		return &shifted, true
+	}
+	return nil, false
+}
+
 func (s *AppointmentLifecycleService) findAppointment(ctx context.Context, calendarEventID string) (*CalendarEventView, domain.Appointment, error) {
 	view, err := s.repository.FindCalendarEventView(ctx, calendarEventID)
 	if err != nil {
@@ -213,7 +367,16 @@ func (s *AppointmentLifecycleService) findAppointment(ctx context.Context, calen
 	return view, appointment, nil
 }
 
-func (s *AppointmentLifecycleService) sendNotification(ctx context.Context, event domain.CalendarEvent, appointment domain.Appointment, kind domain.NotificationKind, idempotencyKey string) error {
+func (s *AppointmentLifecycleService) sendReminder(ctx context.Context, event domain.CalendarEvent, appointment domain.Appointment, reminder *domain.AppointmentReminder, idempotencyKey string) error {
+	position := reminder.Position
+	if err := s.sendNotification(ctx, event, appointment, domain.NotificationKindReminder, idempotencyKey, &position); err != nil {
+		return err
+	}
+	reminder.MarkSendRequested(s.clock.Now())
+	return nil
+}
+
+func (s *AppointmentLifecycleService) sendNotification(ctx context.Context, event domain.CalendarEvent, appointment domain.Appointment, kind domain.NotificationKind, idempotencyKey string, reminderPosition *int) error {
 	notificationType, ok := notificationTypeForKind(kind)
 	if !ok {
 		return domain.ErrInvalidNotification
@@ -231,6 +394,7 @@ func (s *AppointmentLifecycleService) sendNotification(ctx context.Context, even
 	if err != nil {
 		return err
 	}
+	notification.ReminderPosition = reminderPosition
 	return s.repository.SaveAppointmentNotification(ctx, notification)
 }
 
@@ -242,11 +406,22 @@ func notificationTypeForKind(kind domain.NotificationKind) (domain.NotificationT
 		return domain.NotificationTypeAppointmentRescheduled, true
 	case domain.NotificationKindReminder:
 		return domain.NotificationTypeAppointmentReminder, true
+	case domain.NotificationKindCanceled:
+		return domain.NotificationTypeAppointmentCanceled, true
 	default:
 		return "", false
 	}
 }
 
+// notificationReminderPosition returns the reminder a notification was sent for; notifications
+// tracked before reminders had positions refer to the first one.
+func notificationReminderPosition(notification domain.AppointmentNotification) int {
+	if notification.ReminderPosition == nil {
+		return 0
+	}
+	return *notification.ReminderPosition
+}
+
 func notificationIdempotencyKey(event domain.CalendarEvent, notificationType domain.NotificationType) string {
 	return fmt.Sprintf("appointment:%s:%s:%s", event.ID, notificationType, event.Range.Start.UTC().Format(time.RFC3339))
 }
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,18 +14,21 @@ type calendarReminderSchedulerStub struct {
 	unscheduled     bool
 	eventID         string
 	expectedStartAt time.Time
-	sendAt          time.Time
+	sendAt          map[int]time.Time
 }
 
-func (s *calendarReminderSchedulerStub) ScheduleCalendarReminder(_ context.Context, eventID string, expectedStartAt time.Time, sendAt time.Time) error {
+func (s *calendarReminderSchedulerStub) ScheduleCalendarReminder(_ context.Context, eventID string, position int, expectedStartAt time.Time, sendAt time.Time) error {
 	s.scheduled = true
 	s.eventID = eventID
 	s.expectedStartAt = expectedStartAt
-	s.sendAt = sendAt
+	if s.sendAt == nil {
+		s.sendAt = map[int]time.Time{}
+	}
+	s.sendAt[position] = sendAt
 	return nil
 }
 
-func (s *calendarReminderSchedulerStub) UnscheduleCalendarReminder(_ context.Context, eventID string) error {
+func (s *calendarReminderSchedulerStub) UnscheduleCalendarReminder(_ context.Context, eventID string, _ int) error {
 	s.unscheduled = true
 	s.eventID = eventID
 	return nil
@@ -48,16 +52,16 @@ func TestAppointmentLifecycleCreatedSchedulesAndTracksConfirmationAtomically(t *
 	event := newAppointmentLifecycleEvent(t, now.Add(48*time.Hour), now.Add(49*time.Hour), now)
 	repository := &repositoryStub{
 		found:     &event,
-		reminders: map[string]domain.AppointmentReminder{event.ID: mustReminder(t, 24*time.Hour, now)},
+		reminders: map[string][]domain.AppointmentReminder{event.ID: {mustReminder(t, 24*time.Hour, now)}},
 	}
 	scheduler := &calendarReminderSchedulerStub{}
 	notifications := &calendarNotificationSenderStub{}
-	service := NewAppointmentLifecycleService(repository, scheduler, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute)
+	service := NewAppointmentLifecycleService(repository, scheduler, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
 
 	if err := service.Handle(context.Background(), "CalendarEventCreated", event.ID); err != nil {
 		t.Fatalf("Handle() error = %v", err)
 	}
-	reminder := repository.reminders[event.ID]
+	reminder := repository.reminders[event.ID][0]
 	if !scheduler.scheduled || reminder.Status != domain.ReminderStatusScheduled || reminder.ScheduledAt == nil {
 		t.Fatalf("scheduler=%v reminder=%#v", scheduler.scheduled, reminder)
 	}
@@ -69,6 +73,169 @@ func TestAppointmentLifecycleCreatedSchedulesAndTracksConfirmationAtomically(t *
 	}
 }
 
+func TestAppointmentLifecycleSchedulesEachReminderAndSkipsOverdueOnes(t *testing.T) {
+	now := time.Date(2026, 8, 8, 10, 0, 0, 0, time.UTC)
+	event := newAppointmentLifecycleEvent(t, now.Add(24*time.Hour), now.Add(25*time.Hour), now)
+	reminders, err := domain.NewAppointmentReminders([]time.Duration{72 * time.Hour, 48 * time.Hour, 2 * time.Hour}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: reminders}}
+	scheduler := &calendarReminderSchedulerStub{}
+	service := NewAppointmentLifecycleService(repository, scheduler, &calendarNotificationSenderStub{}, clockStub{now: now}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
+
+	if err := service.Handle(context.Background(), "CalendarEventCreated", event.ID); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	saved := repository.reminders[event.ID]
+	if saved[0].Status != domain.ReminderStatusUnprocessable || saved[1].Status != domain.ReminderStatusUnprocessable {
+		t.Fatalf("overdue reminders = %#v, want them skipped", saved[:2])
+	}
+	if saved[2].Status != domain.ReminderStatusScheduled || len(scheduler.sendAt) != 1 || !scheduler.sendAt[2].Equal(now.Add(22*time.Hour)) {
+		t.Fatalf("reminder=%#v scheduled=%v, want only the 2h reminder scheduled", saved[2], scheduler.sendAt)
+	}
+}
+
+func TestAppointmentLifecycleShiftsRemindersIntoTheSendWindow(t *testing.T) {
+	rome, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	window, err := domain.ParseReminderSendWindow("Europe/Rome", "08:00-20:00", []string{"sun"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		now   time.Time
+		start time.Time
+		lead  time.Duration
+		want  time.Time
+	}{
+		{"after hours moves to the closing time", time.Date(2026, 10, 5, 10, 0, 0, 0, rome), time.Date(2026, 10, 7, 9, 0, 0, 0, rome), 12 * time.Hour, time.Date(2026, 10, 6, 20, 0, 0, 0, rome)},
+		{"excluded weekday moves to the day before", time.Date(2026, 10, 1, 10, 0, 0, 0, rome), time.Date(2026, 10, 5, 9, 0, 0, 0, rome), 24 * time.Hour, time.Date(2026, 10, 3, 20, 0, 0, 0, rome)},
+		{"late booking waits for the opening", time.Date(2026, 10, 5, 22, 0, 0, 0, rome), time.Date(2026, 10, 6, 12, 0, 0, 0, rome), 24 * time.Hour, time.Date(2026, 10, 6, 8, 0, 0, 0, rome)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newAppointmentLifecycleEvent(t, test.start, test.start.Add(time.Hour), test.now)
+			repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: {mustReminder(t, test.lead, test.now)}}}
+			scheduler := &calendarReminderSchedulerStub{}
+			service := NewAppointmentLifecycleService(repository, scheduler, &calendarNotificationSenderStub{}, clockStub{now: test.now}, 30*time.Minute, 2*time.Minute, window)
+
+			if err := service.Handle(context.Background(), "CalendarEventCreated", event.ID); err != nil {
+				t.Fatalf("Handle() error = %v", err)
+			}
+			if !scheduler.sendAt[0].Equal(test.want) {
+				t.Fatalf("sendAt = %s, want %s", scheduler.sendAt[0].In(rome), test.want)
+			}
+		})
+	}
+}
+
+func TestAppointmentLifecycleReschedulesChangedRemindersWithoutNotifying(t *testing.T) {
+	now := time.Date(2026, 8, 8, 10, 0, 0, 0, time.UTC)
+	event := newAppointmentLifecycleEvent(t, now.Add(72*time.Hour), now.Add(73*time.Hour), now)
+	reminders, err := domain.NewAppointmentReminders([]time.Duration{48 * time.Hour, 2 * time.Hour}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reminders[1].MarkDeleted(now)
+	repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: reminders}}
+	scheduler := &calendarReminderSchedulerStub{}
+	notifications := &calendarNotificationSenderStub{}
+	service := NewAppointmentLifecycleService(repository, scheduler, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
+
+	if err := service.Handle(context.Background(), "CalendarEventRemindersChanged", event.ID); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if !scheduler.unscheduled || len(scheduler.sendAt) != 1 || !scheduler.sendAt[0].Equal(now.Add(24*time.Hour)) {
+		t.Fatalf("unscheduled=%v scheduled=%v, want the deleted reminder unscheduled and the 48h one scheduled", scheduler.unscheduled, scheduler.sendAt)
+	}
+	if repository.reminders[event.ID][1].Status != domain.ReminderStatusDeleted || notifications.calls != 0 {
+		t.Fatalf("reminders=%#v notifications=%d", repository.reminders[event.ID], notifications.calls)
+	}
+}
+
+func TestAppointmentLifecycleNotifiesOnlySalonCancellations(t *testing.T) {
+	now := time.Date(2026, 8, 8, 10, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		reason domain.CancelReason
+		notify bool
+	}{{domain.CancelReasonDeleted, true}, {domain.CancelReasonCustomer, false}} {
+		t.Run(string(test.reason), func(t *testing.T) {
+			event := newAppointmentLifecycleEvent(t, now.Add(48*time.Hour), now.Add(49*time.Hour), now)
+			event.Cancel(test.reason, now)
+			repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: {mustReminder(t, 24*time.Hour, now)}}}
+			scheduler := &calendarReminderSchedulerStub{}
+			notifications := &calendarNotificationSenderStub{}
+			service := NewAppointmentLifecycleService(repository, scheduler, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
+
+			if err := service.Handle(context.Background(), "CalendarEventCanceled", event.ID); err != nil {
+				t.Fatalf("Handle() error = %v", err)
+			}
+			if !scheduler.unscheduled || repository.reminders[event.ID][0].Status != domain.ReminderStatusDeleted {
+				t.Fatalf("unscheduled=%v reminders=%#v", scheduler.unscheduled, repository.reminders[event.ID])
+			}
+			if (notifications.calls == 1) != test.notify || len(repository.notifications) != notifications.calls {
+				t.Fatalf("notifications=%d tracked=%d, want notify=%v", notifications.calls, len(repository.notifications), test.notify)
+			}
+			if test.notify && notifications.notificationType != domain.NotificationTypeAppointmentCanceled {
+				t.Fatalf("notification type=%s", notifications.notificationType)
+			}
+		})
+	}
+}
+
+func TestAppointmentLifecycleExpiresStaleNotificationsAndFailsTheirReminders(t *testing.T) {
+	now := time.Date(2026, 8, 8, 10, 0, 0, 0, time.UTC)
+	event := newAppointmentLifecycleEvent(t, now.Add(2*time.Hour), now.Add(3*time.Hour), now.Add(-48*time.Hour))
+	reminders, err := domain.NewAppointmentReminders([]time.Duration{24 * time.Hour, 4 * time.Hour}, now.Add(-48*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reminders[0].MarkSendRequested(now.Add(-26 * time.Hour))
+	reminders[1].MarkSendRequested(now.Add(-2 * time.Hour))
+	recipient, err := domain.NewCustomerNotificationRecipient("customer-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	notifications := map[string]domain.AppointmentNotification{}
+	for index, createdAt := range []time.Time{now.Add(-26 * time.Hour), now.Add(-25 * time.Hour), now.Add(-2 * time.Hour)} {
+		kind, position := domain.NotificationKindReminder, index
+		if index == 1 {
+			kind = domain.NotificationKindConfirmation
+		}
+		notification, err := domain.NewAppointmentNotification(fmt.Sprintf("notification-%d", index), event.ID, kind, recipient, nil, createdAt, createdAt.Add(24*time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kind == domain.NotificationKindReminder {
+			notification.ReminderPosition = &position
+		}
+		notifications[notification.CorrelationKey] = notification
+	}
+	repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: reminders}, notifications: notifications}
+	service := NewAppointmentLifecycleService(repository, &calendarReminderSchedulerStub{}, &calendarNotificationSenderStub{}, clockStub{now: now}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
+
+	expired, err := service.ExpireStaleNotifications(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("ExpireStaleNotifications() error = %v", err)
+	}
+	if expired != 2 || repository.txCalls != 3 || repository.writesOutsideTx != 0 {
+		t.Fatalf("expired=%d tx=%d writes outside=%d", expired, repository.txCalls, repository.writesOutsideTx)
+	}
+	for key, want := range map[string]domain.NotificationStatus{"notification-0": domain.NotificationStatusExpired, "notification-1": domain.NotificationStatusExpired, "notification-2": domain.NotificationStatusPending} {
+		if got := repository.notifications[key].Status; got != want {
+			t.Fatalf("%s status = %s, want %s", key, got, want)
+		}
+	}
+	saved := repository.reminders[event.ID]
+	if saved[0].Status != domain.ReminderStatusFailed || saved[1].Status != domain.ReminderStatusSendRequested {
+		t.Fatalf("reminders = %#v, want only the expired reminder failed", saved)
+	}
+}
+
 func TestAppointmentLifecycleIgnoresManualEvents(t *testing.T) {
 	now := time.Date(2026, 8, 8, 10, 0, 0, 0, time.UTC)
 	eventRange, err := domain.NewTimeRange(now.Add(time.Hour), now.Add(2*time.Hour), "Europe/Rome", false)
@@ -82,7 +249,7 @@ func TestAppointmentLifecycleIgnoresManualEvents(t *testing.T) {
 	repository := &repositoryStub{found: &event}
 	scheduler := &calendarReminderSchedulerStub{}
 	notifications := &calendarNotificationSenderStub{}
-	service := NewAppointmentLifecycleService(repository, scheduler, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute)
+	service := NewAppointmentLifecycleService(repository, scheduler, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
 
 	if err := service.Handle(context.Background(), "CalendarEventCreated", event.ID); err != nil {
 		t.Fatalf("Handle() error = %v", err)
@@ -106,17 +273,17 @@ func TestAppointmentLifecycleOutcomeUpdatesNotificationAndReminder(t *testing.T)
 	}
 	repository := &repositoryStub{
 		found:         &event,
-		reminders:     map[string]domain.AppointmentReminder{event.ID: mustReminder(t, 24*time.Hour, now)},
+		reminders:     map[string][]domain.AppointmentReminder{event.ID: {mustReminder(t, 24*time.Hour, now)}},
 		notifications: map[string]domain.AppointmentNotification{notification.CorrelationKey: notification},
 	}
-	service := NewAppointmentLifecycleService(repository, &calendarReminderSchedulerStub{}, &calendarNotificationSenderStub{}, clockStub{now: now.Add(time.Hour)}, 30*time.Minute, 2*time.Minute)
+	service := NewAppointmentLifecycleService(repository, &calendarReminderSchedulerStub{}, &calendarNotificationSenderStub{}, clockStub{now: now.Add(time.Hour)}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
 
 	eventID, err := service.HandleNotificationOutcome(context.Background(), notification.CorrelationKey, true, "", "")
 	if err != nil {
 		t.Fatalf("HandleNotificationOutcome() error = %v", err)
 	}
-	if eventID != event.ID || repository.notifications[notification.CorrelationKey].Status != domain.NotificationStatusSent || repository.reminders[event.ID].Status != domain.ReminderStatusSent {
-		t.Fatalf("event=%s notification=%#v reminder=%#v", eventID, repository.notifications[notification.CorrelationKey], repository.reminders[event.ID])
+	if eventID != event.ID || repository.notifications[notification.CorrelationKey].Status != domain.NotificationStatusSent || repository.reminders[event.ID][0].Status != domain.ReminderStatusSent {
+		t.Fatalf("event=%s notification=%#v reminder=%#v", eventID, repository.notifications[notification.CorrelationKey], repository.reminders[event.ID][0])
 	}
 }
 
@@ -127,31 +294,31 @@ func TestAppointmentLifecycleSendsDueReminderWithoutLegacyDomain(t *testing.T) {
 	if err := reminder.Schedule(now.Add(22*time.Hour), now); err != nil {
 		t.Fatal(err)
 	}
-	repository := &repositoryStub{found: &event, reminders: map[string]domain.AppointmentReminder{event.ID: reminder}}
+	repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: {reminder}}}
 	notifications := &calendarNotificationSenderStub{}
-	service := NewAppointmentLifecycleService(repository, &calendarReminderSchedulerStub{}, notifications, clockStub{now: now.Add(22 * time.Hour)}, 30*time.Minute, 2*time.Minute)
+	service := NewAppointmentLifecycleService(repository, &calendarReminderSchedulerStub{}, notifications, clockStub{now: now.Add(22 * time.Hour)}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
 
-	if err := service.SendDueReminder(context.Background(), event.ID, &event.Range.Start); err != nil {
+	if err := service.SendDueReminder(context.Background(), event.ID, 0, &event.Range.Start); err != nil {
 		t.Fatalf("SendDueReminder() error = %v", err)
 	}
-	if notifications.notificationType != domain.NotificationTypeAppointmentReminder || repository.reminders[event.ID].Status != domain.ReminderStatusSendRequested || len(repository.notifications) != 1 {
-		t.Fatalf("notification=%s reminder=%#v tracked=%d", notifications.notificationType, repository.reminders[event.ID], len(repository.notifications))
+	if notifications.notificationType != domain.NotificationTypeAppointmentReminder || repository.reminders[event.ID][0].Status != domain.ReminderStatusSendRequested || len(repository.notifications) != 1 {
+		t.Fatalf("notification=%s reminder=%#v tracked=%d", notifications.notificationType, repository.reminders[event.ID][0], len(repository.notifications))
 	}
 }
 
 func TestAppointmentLifecycleResendUsesRequestIdempotencyKey(t *testing.T) {
 	now := time.Date(2026, 8, 8, 10, 0, 0, 0, time.UTC)
 	event := newAppointmentLifecycleEvent(t, now.Add(24*time.Hour), now.Add(25*time.Hour), now)
-	repository := &repositoryStub{found: &event, reminders: map[string]domain.AppointmentReminder{event.ID: mustReminder(t, 2*time.Hour, now)}}
+	repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: {mustReminder(t, 2*time.Hour, now)}}}
 	notifications := &calendarNotificationSenderStub{}
-	service := NewAppointmentLifecycleService(repository, &calendarReminderSchedulerStub{}, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute)
+	service := NewAppointmentLifecycleService(repository, &calendarReminderSchedulerStub{}, notifications, clockStub{now: now}, 30*time.Minute, 2*time.Minute, domain.ReminderSendWindow{})
 
-	if err := service.RequestReminderResend(context.Background(), event.ID, "request-1"); err != nil {
+	if err := service.RequestReminderResend(context.Background(), event.ID, nil, "request-1"); err != nil {
 		t.Fatalf("RequestReminderResend() error = %v", err)
 	}
-	wantKey := "appointment:event-1:reminder:resend:request-1"
-	if notifications.idempotencyKey != wantKey || repository.reminders[event.ID].Status != domain.ReminderStatusSendRequested {
-		t.Fatalf("idempotency=%q reminder=%#v", notifications.idempotencyKey, repository.reminders[event.ID])
+	wantKey := "appointment:event-1:reminder:0:resend:request-1"
+	if notifications.idempotencyKey != wantKey || repository.reminders[event.ID][0].Status != domain.ReminderStatusSendRequested {
+		t.Fatalf("idempotency=%q reminder=%#v", notifications.idempotencyKey, repository.reminders[event.ID][0])
 	}
 	if notification, ok := repository.notifications[wantKey]; !ok || notification.IdempotencyKey == nil || *notification.IdempotencyKey != wantKey {
 		t.Fatalf("tracked notification=%#v", notification)
@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+const maxAvailabilityRange = 31 * 24 * time.Hour
+
+var ErrInvalidAvailabilityQuery = errors.New("invalid availability query")
+
+type FindAvailableSlotsQuery struct {
+	CalendarID string
+	Start      time.Time
+	End        time.Time
+	Duration   time.Duration
+}
+
+type AvailableSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+type AvailabilityService struct {
+	events       CalendarEventReadRepository
+	openingHours domain.OpeningHours
+	slotInterval time.Duration
+	clock        Clock
+}
+
+func NewAvailabilityService(events CalendarEventReadRepository, openingHours domain.OpeningHours, slotInterval time.Duration, clock Clock) *AvailabilityService {
+	return &AvailabilityService{events: events, openingHours: openingHours, slotInterval: slotInterval, clock: clock}
+}
+
+// FindAvailableSlots lists future start times, spaced by the slot interval, where a booking of query.Duration
+// fits inside the opening hours without overlapping events an appointment conflicts with.
+func (s *AvailabilityService) FindAvailableSlots(ctx context.Context, query FindAvailableSlotsQuery) ([]AvailableSlot, error) {
+	if query.Duration <= 0 || !query.End.After(query.Start) || query.End.Sub(query.Start) > maxAvailabilityRange || s.slotInterval <= 0 {
+		return nil, ErrInvalidAvailabilityQuery
+	}
+	from := query.Start
+	if now := s.clock.Now(); now.After(from) {
+		from = now
+	}
+	windows := s.openingHours.Windows(from, query.End)
+	if len(windows) == 0 {
+		return []AvailableSlot{}, nil
+	}
+	busy, err := appointmentBusyRanges(ctx, s.events, query.CalendarID, from, query.End)
+	if err != nil {
+		return nil, err
+	}
+	slots := []AvailableSlot{}
+	for _, window := range windows {
+		start := alignToSlot(window.Start, s.slotInterval, s.openingHours.Location())
+		for ; !start.Add(query.Duration).After(window.End); start = start.Add(s.slotInterval) {
+			candidate := domain.TimeRange{Start: start, End: start.Add(query.Duration)}
+			if !overlapsAny(candidate, busy) {
+				slots = append(slots, AvailableSlot{Start: candidate.Start, End: candidate.End})
+			}
+		}
+	}
+	return slots, nil
+}
+
+// alignToSlot returns the first slot starting at or after t. The slots are spaced by interval from the local
+// midnight of location, so a calendar outside whole-hour offsets still offers slots on the hour.
+func alignToSlot(t time.Time, interval time.Duration, location *time.Location) time.Time {
+	local := t.In(location)
+	sinceMidnight := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second + time.Duration(local.Nanosecond())
+	aligned := sinceMidnight / interval * interval
+	if aligned < sinceMidnight {
+		aligned += interval
+	}
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, int(aligned), location).UTC()
+}
+
+// appointmentBusyRanges lists, sorted by start, the time ranges of the calendar an appointment may not overlap.
+func appointmentBusyRanges(ctx context.Context, events CalendarEventReadRepository, calendarID string, start time.Time, end time.Time) ([]domain.TimeRange, error) {
+	views, err := searchCalendarEventOccurrences(ctx, events, ListCalendarEventsQuery{
+		CalendarIDs: []string{calendarID},
+		Start:       &start,
+		End:         &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	busy := make([]domain.TimeRange, 0, len(views))
+	for _, view := range views {
+		if domain.CalendarEventTypeAppointment.ConflictsWith(view.Event.Type) {
+			busy = append(busy, view.Event.Range)
+		}
+	}
+	sort.Slice(busy, func(i, j int) bool { return busy[i].Start.Before(busy[j].Start) })
+	return busy, nil
+}
+
+func overlapsAny(candidate domain.TimeRange, busy []domain.TimeRange) bool {
+	for _, eventRange := range busy {
+		if !eventRange.Start.Before(candidate.End) {
+			return false
+		}
+		if candidate.Overlaps(eventRange) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestFindAvailableSlotsSkipsBusyTimeOutsideOpeningHours(t *testing.T) {
+	rome, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	monday := time.Date(2026, 8, 3, 0, 0, 0, 0, rome)
+	openingHours, err := domain.ParseOpeningHours("Europe/Rome", []string{"mon=09:00-12:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventRange, err := domain.NewTimeRange(monday.Add(10*time.Hour), monday.Add(11*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appointment, err := domain.NewAppointmentEvent(domain.AppointmentEventParams{
+		EventID:    "event-1",
+		CalendarID: domain.DefaultCalendarID,
+		Range:      eventRange,
+		Customer:   domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+		Now:        monday,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := NewAvailabilityService(&repositoryStub{found: &appointment}, openingHours, 30*time.Minute, clockStub{now: monday.Add(-24 * time.Hour)})
+
+	slots, err := service.FindAvailableSlots(context.Background(), FindAvailableSlotsQuery{
+		CalendarID: domain.DefaultCalendarID,
+		Start:      monday,
+		End:        monday.Add(24 * time.Hour),
+		Duration:   time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("FindAvailableSlots() error = %v", err)
+	}
+	want := []time.Time{monday.Add(9 * time.Hour), monday.Add(11 * time.Hour)}
+	if len(slots) != len(want) {
+		t.Fatalf("slots = %#v, want starts %v", slots, want)
+	}
+	for index, slot := range slots {
+		if !slot.Start.Equal(want[index]) || !slot.End.Equal(want[index].Add(time.Hour)) {
+			t.Fatalf("slot %d = %#v, want start %s", index, slot, want[index])
+		}
+	}
+}
+
+func TestFindAvailableSlotsStartsFromNowAlignedToInterval(t *testing.T) {
+	start := time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)
+	service := NewAvailabilityService(&repositoryStub{}, domain.OpeningHours{}, 15*time.Minute, clockStub{now: start.Add(7 * time.Minute)})
+
+	slots, err := service.FindAvailableSlots(context.Background(), FindAvailableSlotsQuery{
+		CalendarID: domain.DefaultCalendarID,
+		Start:      start,
+		End:        start.Add(time.Hour),
+		Duration:   30 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("FindAvailableSlots() error = %v", err)
+	}
+	if len(slots) != 2 || !slots[0].Start.Equal(start.Add(15*time.Minute)) || !slots[1].Start.Equal(start.Add(30*time.Minute)) {
+		t.Fatalf("slots = %#v", slots)
+	}
+}
+
+func TestFindAvailableSlotsAlignsToTheCalendarTimezone(t *testing.T) {
+	for _, timezone := range []string{"Asia/Kolkata", "Australia/Darwin"} {
+		openingHours, err := domain.NewOpeningHours(timezone, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		start := time.Date(2026, 8, 3, 9, 10, 0, 0, openingHours.Location())
+		service := NewAvailabilityService(&repositoryStub{}, openingHours, time.Hour, clockStub{now: start.Add(-time.Hour)})
+
+		slots, err := service.FindAvailableSlots(context.Background(), FindAvailableSlotsQuery{
+			CalendarID: domain.DefaultCalendarID,
+			Start:      start,
+			End:        start.Add(3 * time.Hour),
+			Duration:   time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("%s: FindAvailableSlots() error = %v", timezone, err)
+		}
+		want := []time.Time{
+			time.Date(2026, 8, 3, 10, 0, 0, 0, openingHours.Location()),
+			time.Date(2026, 8, 3, 11, 0, 0, 0, openingHours.Location()),
+		}
+		if len(slots) != len(want) || !slots[0].Start.Equal(want[0]) || !slots[1].Start.Equal(want[1]) {
+			t.Fatalf("%s: slots = %#v, want starts %v", timezone, slots, want)
+		}
+	}
+}
+
+func TestFindAvailableSlotsRejectsInvalidQueries(t *testing.T) {
+	start := time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)
+	service := NewAvailabilityService(&repositoryStub{}, domain.OpeningHours{}, 15*time.Minute, clockStub{now: start})
+
+	for _, query := range []FindAvailableSlotsQuery{
+		{Start: start, End: start.Add(time.Hour)},
+		{Start: start, End: start, Duration: time.Hour},
+		{Start: start, End: start.Add(40 * 24 * time.Hour), Duration: time.Hour},
+	} {
+		if _, err := service.FindAvailableSlots(context.Background(), query); !errors.Is(err, ErrInvalidAvailabilityQuery) {
+			t.Fatalf("FindAvailableSlots(%#v) error = %v, want ErrInvalidAvailabilityQuery", query, err)
+		}
+	}
+}
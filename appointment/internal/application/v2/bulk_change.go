@@ -0,0 +1,169 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+var ErrInvalidBulkChange = errors.New("invalid bulk change")
+
+// BulkChangeEventsCommand applies Action to every active event of the calendar overlapping [Start, End).
+// Occurrences of recurring events are changed one by one; the rest of their series is left untouched.
+type BulkChangeEventsCommand struct {
+	CalendarID string
+	Start      time.Time
+	End        time.Time
+	// EventTypes restricts the changed events; empty changes every type.
+	EventTypes []domain.CalendarEventType
+	Action     BulkEventAction
+}
+
+type BulkEventAction interface {
+	bulkEventAction()
+}
+
+// BulkCancelEvents cancels the events with Reason.
+type BulkCancelEvents struct {
+	Reason domain.CancelReason
+}
+
+// BulkShiftEvents moves the events by By, keeping their duration.
+type BulkShiftEvents struct {
+	By time.Duration
+}
+
+func (BulkCancelEvents) bulkEventAction() {}
+func (BulkShiftEvents) bulkEventAction()  {}
+
+type BulkEventStatus string
+
+const (
+	BulkEventCanceled BulkEventStatus = "canceled"
+	BulkEventShifted  BulkEventStatus = "shifted"
+	// BulkEventRejected marks events left unchanged because the action is not allowed on them.
+	BulkEventRejected BulkEventStatus = "rejected"
+)
+
+type BulkEventResult struct {
+	CalendarEventID string
+	// Start is the start of the event, or of the occurrence, before the change.
+	Start  time.Time
+	Status BulkEventStatus
+	// Event is the changed event; a shifted occurrence is detached from its series into a new event.
+	Event *domain.CalendarEvent
+	// Err tells why a rejected event was left unchanged.
+	Err error
+}
+
+// BulkChangeEvents changes the events of a time range in a single transaction and reports the outcome of
+// each one. An event the action cannot apply to, such as a shift into a conflict, is rejected without
+// stopping the others; storage errors roll the whole change back. The changed events record the usual
+// lifecycle events, so customers are notified and reminders rescheduled as for single changes.
+func (s *CalendarService) BulkChangeEvents(ctx context.Context, command BulkChangeEventsCommand) ([]BulkEventResult, error) {
+	calendarID, err := domain.NormalizeCalendarID(command.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+	if !command.End.After(command.Start) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+	switch action := command.Action.(type) {
+	case BulkCancelEvents:
+		if action.Reason == "" {
+			return nil, ErrInvalidBulkChange
+		}
+	case BulkShiftEvents:
+		if action.By == 0 {
+			return nil, ErrInvalidBulkChange
+		}
+	default:
+		return nil, ErrInvalidBulkChange
+	}
+	now := s.clock.Now()
+	var results []BulkEventResult
+	if err := s.repository.Tx(ctx, func(ctx context.Context) error {
+		calendar, err := findCalendar(ctx, s.repository, calendarID)
+		if err != nil {
+			return err
+		}
+		if _, shift := command.Action.(BulkShiftEvents); shift {
+			if err := calendar.AcceptEvents(); err != nil {
+				return err
+			}
+		}
+		views, err := searchCalendarEventOccurrences(ctx, s.repository, ListCalendarEventsQuery{
+			CalendarIDs: []string{calendarID},
+			Start:       &command.Start,
+			End:         &command.End,
+			EventTypes:  command.EventTypes,
+		})
+		if err != nil {
+			return err
+		}
+		events := make([]domain.CalendarEvent, 0, len(views))
+		for _, view := range views {
+			events = append(events, view.Event)
+		}
+		if action, ok := command.Action.(BulkShiftEvents); ok && action.By > 0 {
+			// Moving the latest events first keeps an event from clashing with one that is about to move too.
+			sort.SliceStable(events, func(i, j int) bool {
+				return events[i].Range.Start.After(events[j].Range.Start)
+			})
+		}
+		results = make([]BulkEventResult, 0, len(events))
+		for _, event := range events {
+			result, err := s.bulkChangeEvent(ctx, event, command.Action, now)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *CalendarService) bulkChangeEvent(ctx context.Context, event domain.CalendarEvent, action BulkEventAction, now time.Time) (BulkEventResult, error) {
+	result := BulkEventResult{CalendarEventID: event.ID, Start: event.Range.Start}
+	target := calendarEventChange{calendarEventID: event.ID, now: now}
+	var occurrence *OccurrenceSelection
+	if event.IsRecurring() {
+		occurrence = &OccurrenceSelection{Scope: RecurrenceScopeThis, Start: event.Range.Start}
+	}
+	var change func(*domain.CalendarEvent) error
+	var conflicts *ConflictDetector
+	switch action := action.(type) {
+	case BulkCancelEvents:
+		result.Status = BulkEventCanceled
+		change = cancelCalendarEvent(occurrence, action.Reason, now)
+	case BulkShiftEvents:
+		result.Status = BulkEventShifted
+		conflicts = s.conflicts
+		target.occurrence = occurrence
+		change = func(event *domain.CalendarEvent) error {
+			shifted, err := domain.NewTimeRange(event.Range.Start.Add(action.By), event.Range.End.Add(action.By), event.Range.Timezone, event.Range.AllDay)
+			if err != nil {
+				return err
+			}
+			event.Reschedule(shifted, now)
+			return nil
+		}
+	}
+	changed, _, err := changeCalendarEventInTx(ctx, s.repository, conflicts, target, change)
+	var rejected rejectedChangeError
+	if errors.As(err, &rejected) {
+		result.Status, result.Err = BulkEventRejected, rejected.err
+		return result, nil
+	}
+	if err != nil {
+		return BulkEventResult{}, err
+	}
+	result.Event = changed
+	return result, nil
+}
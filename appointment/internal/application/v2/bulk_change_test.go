@@ -0,0 +1,152 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestBulkChangeEventsCancelsTheEventsOfTheRange(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC)
+	repository := newBulkRepositoryStub(
+		mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-1", day.Add(10*time.Hour), "UTC", now),
+		mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-2", day.Add(15*time.Hour), "UTC", now),
+		mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-3", day.Add(34*time.Hour), "UTC", now),
+	)
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyReject, ReminderPolicy{})
+
+	results, err := service.BulkChangeEvents(context.Background(), BulkChangeEventsCommand{
+		CalendarID: domain.DefaultCalendarID,
+		Start:      day,
+		End:        day.Add(24 * time.Hour),
+		Action:     BulkCancelEvents{Reason: domain.CancelReasonDeleted},
+	})
+	if err != nil {
+		t.Fatalf("BulkChangeEvents() error = %v", err)
+	}
+
+	if len(results) != 2 || results[0].CalendarEventID != "event-1" || results[1].CalendarEventID != "event-2" {
+		t.Fatalf("results = %#v, want the two events of the day", results)
+	}
+	for _, result := range results {
+		if result.Status != BulkEventCanceled || result.Event == nil || !result.Event.IsCanceled() {
+			t.Fatalf("result = %#v, want a canceled event", result)
+		}
+	}
+	if repository.events["event-3"].IsCanceled() {
+		t.Fatal("the event of the following day was canceled")
+	}
+	if repository.txCalls != 1 || len(repository.lifecycle) != 2 || repository.lifecycle[0] != domain.CalendarEventCanceled("event-1") {
+		t.Fatalf("tx calls = %d, lifecycle = %#v, want two cancellations in one transaction", repository.txCalls, repository.lifecycle)
+	}
+}
+
+func TestBulkChangeEventsShiftsTheLatestEventsFirstAndRejectsConflicts(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC)
+	repository := newBulkRepositoryStub(
+		mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-1", day.Add(10*time.Hour), "UTC", now),
+		mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-2", day.Add(11*time.Hour), "UTC", now),
+		mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-3", day.Add(14*time.Hour), "UTC", now),
+		mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "block-1", day.Add(15*time.Hour+30*time.Minute), "UTC", now),
+	)
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyReject, ReminderPolicy{})
+
+	results, err := service.BulkChangeEvents(context.Background(), BulkChangeEventsCommand{
+		CalendarID: domain.DefaultCalendarID,
+		Start:      day.Add(9 * time.Hour),
+		End:        day.Add(15 * time.Hour),
+		EventTypes: []domain.CalendarEventType{domain.CalendarEventTypeAppointment},
+		Action:     BulkShiftEvents{By: time.Hour},
+	})
+	if err != nil {
+		t.Fatalf("BulkChangeEvents() error = %v", err)
+	}
+
+	statuses := make(map[string]BulkEventStatus, len(results))
+	for _, result := range results {
+		statuses[result.CalendarEventID] = result.Status
+	}
+	if statuses["event-1"] != BulkEventShifted || statuses["event-2"] != BulkEventShifted || statuses["event-3"] != BulkEventRejected {
+		t.Fatalf("statuses = %#v, want event-3 rejected by the time block and the others shifted", statuses)
+	}
+	var conflictErr *CalendarEventConflictError
+	if rejected := results[0]; rejected.CalendarEventID != "event-3" || !errors.As(rejected.Err, &conflictErr) {
+		t.Fatalf("first result = %#v, want the latest event rejected with its conflicts", rejected)
+	}
+	if start := repository.events["event-1"].Range.Start; !start.Equal(day.Add(11 * time.Hour)) {
+		t.Fatalf("event-1 start = %s, want it moved into the slot event-2 left", start)
+	}
+	if start := repository.events["event-3"].Range.Start; !start.Equal(day.Add(14 * time.Hour)) {
+		t.Fatalf("event-3 start = %s, want it unchanged", start)
+	}
+}
+
+func TestBulkChangeEventsRejectsAnEmptyAction(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	repository := newBulkRepositoryStub()
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyReject, ReminderPolicy{})
+
+	_, err := service.BulkChangeEvents(context.Background(), BulkChangeEventsCommand{
+		CalendarID: domain.DefaultCalendarID,
+		Start:      now,
+		End:        now.Add(time.Hour),
+		Action:     BulkShiftEvents{},
+	})
+	if !errors.Is(err, ErrInvalidBulkChange) {
+		t.Fatalf("BulkChangeEvents() error = %v, want ErrInvalidBulkChange", err)
+	}
+	if repository.txCalls != 0 {
+		t.Fatalf("tx calls = %d, want none", repository.txCalls)
+	}
+}
+
+// bulkRepositoryStub stores several events by id and records the lifecycle events of the saved ones.
+type bulkRepositoryStub struct {
+	repositoryStub
+	events    map[string]domain.CalendarEvent
+	lifecycle []domain.LifecycleEvent
+}
+
+func newBulkRepositoryStub(events ...*domain.CalendarEvent) *bulkRepositoryStub {
+	repository := &bulkRepositoryStub{events: make(map[string]domain.CalendarEvent, len(events))}
+	for _, event := range events {
+		event.PullEvents()
+		repository.events[event.ID] = *event
+	}
+	return repository
+}
+
+func (r *bulkRepositoryStub) FindCalendarEvent(_ context.Context, calendarEventID string) (*domain.CalendarEvent, error) {
+	event, ok := r.events[calendarEventID]
+	if !ok {
+		return nil, nil
+	}
+	return &event, nil
+}
+
+func (r *bulkRepositoryStub) SearchCalendarEventViews(_ context.Context, query ListCalendarEventsQuery) ([]CalendarEventView, error) {
+	var views []CalendarEventView
+	for _, event := range r.events {
+		if event.IsCanceled() || (len(query.EventTypes) > 0 && !slices.Contains(query.EventTypes, event.Type)) {
+			continue
+		}
+		views = append(views, CalendarEventView{Event: event})
+	}
+	sort.Slice(views, func(i, j int) bool {
+		return views[i].Event.Range.Start.Before(views[j].Event.Range.Start)
+	})
+	return views, nil
+}
+
+func (r *bulkRepositoryStub) SaveCalendarEvent(ctx context.Context, event *domain.CalendarEvent) error {
+	r.lifecycle = append(r.lifecycle, event.PullEvents()...)
+	r.events[event.ID] = *event
+	return r.repositoryStub.SaveCalendarEvent(ctx, event)
+}
@@ -0,0 +1,123 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+var ErrCalendarNotFound = errors.New("calendar not found")
+
+type CalendarReader interface {
+	FindCalendar(ctx context.Context, calendarID string) (*domain.Calendar, error)
+}
+
+type CalendarRepository interface {
+	CalendarReader
+	NextCalendarID() string
+	SaveCalendar(ctx context.Context, calendar domain.Calendar) error
+	ListCalendars(ctx context.Context, includeArchived bool) ([]domain.Calendar, error)
+}
+
+type CreateCalendarCommand struct {
+	Name  string
+	Color *string
+}
+
+type UpdateCalendarCommand struct {
+	CalendarID string
+	Name       *string
+	// Color replaces the calendar color when set; an empty color removes it.
+	Color *string
+}
+
+// CalendarRegistry manages the calendars of the salon that calendar events are booked into.
+type CalendarRegistry struct {
+	calendars CalendarRepository
+	clock     Clock
+}
+
+func NewCalendarRegistry(calendars CalendarRepository, clock Clock) *CalendarRegistry {
+	return &CalendarRegistry{calendars: calendars, clock: clock}
+}
+
+func (r *CalendarRegistry) CreateCalendar(ctx context.Context, command CreateCalendarCommand) (*domain.Calendar, error) {
+	calendar, err := domain.NewCalendar(domain.CalendarParams{
+		ID:    r.calendars.NextCalendarID(),
+		Name:  command.Name,
+		Color: command.Color,
+		Now:   r.clock.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.calendars.SaveCalendar(ctx, calendar); err != nil {
+		return nil, err
+	}
+	return &calendar, nil
+}
+
+func (r *CalendarRegistry) ListCalendars(ctx context.Context, includeArchived bool) ([]domain.Calendar, error) {
+	return r.calendars.ListCalendars(ctx, includeArchived)
+}
+
+func (r *CalendarRegistry) UpdateCalendar(ctx context.Context, command UpdateCalendarCommand) (*domain.Calendar, error) {
+	return r.changeCalendar(ctx, command.CalendarID, func(calendar *domain.Calendar, now time.Time) error {
+		if command.Name != nil {
+			if err := calendar.Rename(*command.Name, now); err != nil {
+				return err
+			}
+		}
+		if command.Color != nil {
+			return calendar.ChangeColor(*command.Color, now)
+		}
+		return nil
+	})
+}
+
+// ArchiveCalendar stops new bookings into the calendar; its existing events are kept.
+func (r *CalendarRegistry) ArchiveCalendar(ctx context.Context, calendarID string) (*domain.Calendar, error) {
+	return r.changeCalendar(ctx, calendarID, func(calendar *domain.Calendar, now time.Time) error {
+		return calendar.Archive(now)
+	})
+}
+
+func (r *CalendarRegistry) changeCalendar(ctx context.Context, calendarID string, change func(*domain.Calendar, time.Time) error) (*domain.Calendar, error) {
+	calendar, err := findCalendar(ctx, r.calendars, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(calendar, r.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := r.calendars.SaveCalendar(ctx, *calendar); err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
+
+// ensureCalendarAcceptsEvents fails when the calendar does not exist or is archived.
+func ensureCalendarAcceptsEvents(ctx context.Context, calendars CalendarReader, calendarID string) error {
+	calendar, err := findCalendar(ctx, calendars, calendarID)
+	if err != nil {
+		return err
+	}
+	return calendar.AcceptEvents()
+}
+
+func findCalendar(ctx context.Context, calendars CalendarReader, calendarID string) (*domain.Calendar, error) {
+	calendarID, err := domain.NormalizeCalendarID(calendarID)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := calendars.FindCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return nil, ErrCalendarNotFound
+	}
+	return calendar, nil
+}
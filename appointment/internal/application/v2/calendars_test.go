@@ -0,0 +1,118 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+const roomCalendarID = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+
+func TestCalendarRegistryCreatesUpdatesAndArchivesCalendars(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	repository := &calendarRepositoryStub{ids: []string{roomCalendarID}, calendars: map[string]domain.Calendar{}}
+	registry := NewCalendarRegistry(repository, clockStub{now: now})
+	color := "#00aa88"
+
+	created, err := registry.CreateCalendar(context.Background(), CreateCalendarCommand{Name: "Room 1", Color: &color})
+	if err != nil {
+		t.Fatalf("CreateCalendar() error = %v", err)
+	}
+	if created.ID != roomCalendarID || created.Name != "Room 1" || created.Color == nil || *created.Color != "#00AA88" {
+		t.Fatalf("created = %#v", created)
+	}
+
+	name := "Solarium"
+	noColor := ""
+	updated, err := registry.UpdateCalendar(context.Background(), UpdateCalendarCommand{CalendarID: roomCalendarID, Name: &name, Color: &noColor})
+	if err != nil {
+		t.Fatalf("UpdateCalendar() error = %v", err)
+	}
+	if updated.Name != name || updated.Color != nil {
+		t.Fatalf("updated = %#v, want renamed calendar without color", updated)
+	}
+
+	archived, err := registry.ArchiveCalendar(context.Background(), roomCalendarID)
+	if err != nil {
+		t.Fatalf("ArchiveCalendar() error = %v", err)
+	}
+	if !archived.IsArchived() || !repository.calendars[roomCalendarID].IsArchived() {
+		t.Fatalf("archived = %#v, want a stored archived calendar", archived)
+	}
+
+	if _, err := registry.ArchiveCalendar(context.Background(), "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"); !errors.Is(err, ErrCalendarNotFound) {
+		t.Fatalf("ArchiveCalendar(missing) error = %v, want ErrCalendarNotFound", err)
+	}
+}
+
+func TestCreateRejectsMissingAndArchivedCalendars(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	archivedAt := now.Add(-time.Hour)
+	repository := &repositoryStub{
+		ids:       []string{"event-1", "event-2", "event-3"},
+		calendars: map[string]domain.Calendar{roomCalendarID: {ID: roomCalendarID, Name: "Room 1"}},
+	}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	command := CreateTimeBlockCommand{
+		CalendarID: roomCalendarID,
+		Start:      now.Add(time.Hour),
+		End:        now.Add(2 * time.Hour),
+		Title:      "Maintenance",
+		Reason:     "maintenance",
+	}
+
+	if _, _, err := service.Create(context.Background(), command); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(repository.saved) != 1 || repository.saved[0].CalendarID != roomCalendarID {
+		t.Fatalf("saved = %d events, want one event in the room calendar", len(repository.saved))
+	}
+
+	repository.calendars[roomCalendarID] = domain.Calendar{ID: roomCalendarID, Name: "Room 1", ArchivedAt: &archivedAt}
+	if _, _, err := service.Create(context.Background(), command); !errors.Is(err, domain.ErrCalendarArchived) {
+		t.Fatalf("Create(archived) error = %v, want ErrCalendarArchived", err)
+	}
+
+	command.CalendarID = domain.DefaultCalendarID
+	if _, _, err := service.Create(context.Background(), command); !errors.Is(err, ErrCalendarNotFound) {
+		t.Fatalf("Create(missing) error = %v, want ErrCalendarNotFound", err)
+	}
+	if len(repository.saved) != 1 {
+		t.Fatalf("saved = %d events, want rejected creates to write nothing", len(repository.saved))
+	}
+}
+
+type calendarRepositoryStub struct {
+	ids       []string
+	calendars map[string]domain.Calendar
+}
+
+func (r *calendarRepositoryStub) NextCalendarID() string {
+	value := r.ids[0]
+	r.ids = r.ids[1:]
+	return value
+}
+
+func (r *calendarRepositoryStub) FindCalendar(_ context.Context, calendarID string) (*domain.Calendar, error) {
+	calendar, ok := r.calendars[calendarID]
+	if !ok {
+		return nil, nil
+	}
+	return &calendar, nil
+}
+
+func (r *calendarRepositoryStub) SaveCalendar(_ context.Context, calendar domain.Calendar) error {
+	r.calendars[calendar.ID] = calendar
+	return nil
+}
+
+func (r *calendarRepositoryStub) ListCalendars(context.Context, bool) ([]domain.Calendar, error) {
+	out := make([]domain.Calendar, 0, len(r.calendars))
+	for _, calendar := range r.calendars {
+		out = append(out, calendar)
+	}
+	return out, nil
+}
@@ -11,17 +11,19 @@ type CreateEventCommand interface {
 }
 
 type CreateAppointmentCommand struct {
-	CalendarID   string
-	Start        time.Time
-	End          time.Time
-	Timezone     string
-	AllDay       bool
-	Title        string
-	Description  string
-	Visibility   domain.Visibility
-	CustomerID   string
-	Services     []domain.ServiceItem
-	RemindBefore time.Duration
+	CalendarID  string
+	Start       time.Time
+	End         time.Time
+	Timezone    string
+	AllDay      bool
+	Title       string
+	Description string
+	Visibility  domain.Visibility
+	CustomerID  string
+	Services    []domain.ServiceItem
+	// RemindBefore lists the reminder lead times; nil applies the ReminderPolicy of the service and
+	// an empty slice books the appointment without reminders.
+	RemindBefore []time.Duration
 }
 
 func (CreateAppointmentCommand) createEventCommand() {}
@@ -38,6 +40,7 @@ type CreateManualEventCommand struct {
 	ManualTitle   string
 	ManualDetails string
 	Location      *string
+	Recurrence    *domain.Recurrence
 }
 
 func (CreateManualEventCommand) createEventCommand() {}
@@ -52,6 +55,7 @@ type CreateTimeBlockCommand struct {
 	Description string
 	Visibility  domain.Visibility
 	Reason      string
+	Recurrence  *domain.Recurrence
 }
 
 func (CreateTimeBlockCommand) createEventCommand() {}
@@ -59,14 +63,64 @@ func (CreateTimeBlockCommand) createEventCommand() {}
 type CancelEventCommand struct {
 	CalendarEventID string
 	Reason          domain.CancelReason
+	ExpectedVersion *int64
+	Occurrence      *OccurrenceSelection
+}
+
+// RecordAttendanceCommand targets the appointment whose outcome is recorded by MarkCompleted or MarkNoShow.
+type RecordAttendanceCommand struct {
+	CalendarEventID string
+	ExpectedVersion *int64
+}
+
+// RecurrenceScope selects the occurrences of a recurring event an update or cancel applies to.
+type RecurrenceScope string
+
+const (
+	RecurrenceScopeAll              RecurrenceScope = "all"
+	RecurrenceScopeThis             RecurrenceScope = "this"
+	RecurrenceScopeThisAndFollowing RecurrenceScope = "this_and_following"
+)
+
+// OccurrenceSelection targets the occurrence of a recurring event that starts at Start.
+type OccurrenceSelection struct {
+	Start time.Time
+	Scope RecurrenceScope
+}
+
+// appliesToSeries reports whether the change is applied to the series as a whole, which is also the case
+// when this and following occurrences start from the first one.
+func (occurrence *OccurrenceSelection) appliesToSeries(event domain.CalendarEvent) bool {
+	if occurrence == nil || occurrence.Scope == RecurrenceScopeAll {
+		return true
+	}
+	return occurrence.Scope == RecurrenceScopeThisAndFollowing && occurrence.Start.Equal(event.Range.Start)
 }
 
 type ListCalendarEventsQuery struct {
-	CalendarID string
-	Start      *time.Time
-	End        *time.Time
-	CustomerID string
-	EventTypes []domain.CalendarEventType
+	CalendarIDs []string
+	Start       *time.Time
+	End         *time.Time
+	CustomerID  string
+	EventTypes  []domain.CalendarEventType
+	// IncludeCanceled also returns canceled events, which are skipped by default.
+	IncludeCanceled bool
+	// Text matches title, description, customer display name and service names, ignoring case.
+	Text string
+	// Descending orders the events by start from the latest one.
+	Descending bool
+	// PageSize caps the number of listed events, counting each occurrence within a time window; zero
+	// returns every matching event.
+	PageSize int
+	// After resumes the listing past the event or occurrence a previous page ended with.
+	After *CalendarEventCursor
+}
+
+// CalendarEventCursor is the position of a listed event or occurrence in the listing order.
+type CalendarEventCursor struct {
+	Start time.Time
+	End   time.Time
+	ID    string
 }
 
 type UpdateEventCommand interface {
@@ -78,10 +132,13 @@ type CalendarEventChanges struct {
 	Title       *string
 	Description *string
 	Visibility  *domain.Visibility
+	Recurrence  **domain.Recurrence
 }
 
 type UpdateCalendarFieldsCommand struct {
 	CalendarEventID string
+	ExpectedVersion *int64
+	Occurrence      *OccurrenceSelection
 	Changes         CalendarEventChanges
 }
 
@@ -89,14 +146,21 @@ func (UpdateCalendarFieldsCommand) updateEventCommand() {}
 
 type UpdateAppointmentCommand struct {
 	CalendarEventID string
+	ExpectedVersion *int64
+	Occurrence      *OccurrenceSelection
 	Changes         CalendarEventChanges
-	Services        []domain.ServiceItem
+	// Services replaces the booked services; nil keeps them.
+	Services []domain.ServiceItem
+	// RemindBefore replaces the reminder lead times; nil keeps the reminders and an empty slice disables them.
+	RemindBefore []time.Duration
 }
 
 func (UpdateAppointmentCommand) updateEventCommand() {}
 
 type UpdateManualEventCommand struct {
 	CalendarEventID string
+	ExpectedVersion *int64
+	Occurrence      *OccurrenceSelection
 	Changes         CalendarEventChanges
 	Title           *string
 	Description     *string
@@ -107,6 +171,8 @@ func (UpdateManualEventCommand) updateEventCommand() {}
 
 type UpdateTimeBlockCommand struct {
 	CalendarEventID string
+	ExpectedVersion *int64
+	Occurrence      *OccurrenceSelection
 	Changes         CalendarEventChanges
 	Reason          string
 }
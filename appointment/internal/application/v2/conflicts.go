@@ -0,0 +1,137 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+// recurringConflictHorizon bounds how far ahead the occurrences of a recurring event are checked for conflicts.
+const recurringConflictHorizon = 366 * 24 * time.Hour
+
+var (
+	ErrCalendarEventConflict = errors.New("calendar event conflicts with existing events")
+	ErrInvalidConflictPolicy = errors.New("invalid conflict policy")
+)
+
+// ConflictPolicy decides what happens when a calendar event overlaps events it may not share time with.
+type ConflictPolicy string
+
+const (
+	ConflictPolicyReject ConflictPolicy = "reject"
+	ConflictPolicyWarn   ConflictPolicy = "warn"
+	ConflictPolicyAllow  ConflictPolicy = "allow"
+)
+
+// ParseConflictPolicy accepts the configured policy name; an empty value selects ConflictPolicyReject.
+func ParseConflictPolicy(value string) (ConflictPolicy, error) {
+	switch policy := ConflictPolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case "":
+		return ConflictPolicyReject, nil
+	case ConflictPolicyReject, ConflictPolicyWarn, ConflictPolicyAllow:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidConflictPolicy, value)
+	}
+}
+
+type CalendarEventConflict struct {
+	CalendarEventID string
+	Type            domain.CalendarEventType
+	Range           domain.TimeRange
+}
+
+// CalendarEventConflictError is returned by writes rejected under ConflictPolicyReject.
+type CalendarEventConflictError struct {
+	Conflicts []CalendarEventConflict
+}
+
+func (e *CalendarEventConflictError) Error() string {
+	ids := make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		ids = append(ids, conflict.CalendarEventID)
+	}
+	return fmt.Sprintf("%s: %s", ErrCalendarEventConflict, strings.Join(ids, ", "))
+}
+
+func (e *CalendarEventConflictError) Unwrap() error {
+	return ErrCalendarEventConflict
+}
+
+type CalendarEventConflictRepository interface {
+	CalendarEventReadRepository
+	// LockCalendarEvents holds, until the end of the surrounding transaction, a lock on the calendar taken
+	// by every write checked for conflicts, so concurrent writes cannot both pass the check.
+	LockCalendarEvents(ctx context.Context, calendarID string) error
+}
+
+type ConflictDetector struct {
+	events CalendarEventConflictRepository
+	policy ConflictPolicy
+}
+
+func NewConflictDetector(events CalendarEventConflictRepository, policy ConflictPolicy) *ConflictDetector {
+	return &ConflictDetector{events: events, policy: policy}
+}
+
+// Check fails with a *CalendarEventConflictError when the policy rejects conflicts and the event has any,
+// and returns the conflicts to report next to the write when the policy only warns about them.
+// It must run in the transaction that saves the event: it locks the calendar first, so a concurrent write of
+// the same calendar waits for the commit and then sees the saved event.
+func (d *ConflictDetector) Check(ctx context.Context, event domain.CalendarEvent) ([]CalendarEventConflict, error) {
+	if d == nil || (d.policy != ConflictPolicyReject && d.policy != ConflictPolicyWarn) {
+		return nil, nil
+	}
+	if err := d.events.LockCalendarEvents(ctx, event.CalendarID); err != nil {
+		return nil, err
+	}
+	conflicts, err := d.find(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 && d.policy == ConflictPolicyReject {
+		return nil, &CalendarEventConflictError{Conflicts: conflicts}
+	}
+	return conflicts, nil
+}
+
+func (d *ConflictDetector) find(ctx context.Context, event domain.CalendarEvent) ([]CalendarEventConflict, error) {
+	if event.IsCanceled() || event.Type == domain.CalendarEventTypeManual {
+		return nil, nil
+	}
+	start, end := event.Range.Start, event.Range.End
+	if event.IsRecurring() {
+		end = start.Add(recurringConflictHorizon)
+		if seriesEnd := event.Recurrence.End(event.Range); seriesEnd != nil && seriesEnd.Before(end) {
+			end = *seriesEnd
+		}
+	}
+	views, err := searchCalendarEventOccurrences(ctx, d.events, ListCalendarEventsQuery{
+		CalendarIDs: []string{event.CalendarID},
+		Start:       &start,
+		End:         &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	occurrences := event.Occurrences(start, end)
+	var conflicts []CalendarEventConflict
+	for _, view := range views {
+		for _, occurrence := range occurrences {
+			if !occurrence.ConflictsWith(view.Event) {
+				continue
+			}
+			conflicts = append(conflicts, CalendarEventConflict{
+				CalendarEventID: view.Event.ID,
+				Type:            view.Event.Type,
+				Range:           view.Event.Range,
+			})
+			break
+		}
+	}
+	return conflicts, nil
+}
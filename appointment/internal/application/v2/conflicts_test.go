@@ -0,0 +1,211 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestCreateAppointmentRejectsOverlappingTimeBlock(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	repository := &repositoryStub{ids: []string{"event-2"}}
+	repository.found = mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-1", now.Add(time.Hour), "Europe/Rome", now)
+	customers := &customerResolverStub{customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}}
+	service := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyReject, ReminderPolicy{})
+
+	_, _, err := service.Create(context.Background(), CreateAppointmentCommand{
+		CalendarID:   domain.DefaultCalendarID,
+		Start:        now.Add(90 * time.Minute),
+		End:          now.Add(150 * time.Minute),
+		CustomerID:   "customer-1",
+		RemindBefore: []time.Duration{24 * time.Hour},
+	})
+
+	var conflictErr *CalendarEventConflictError
+	if !errors.As(err, &conflictErr) || !errors.Is(err, ErrCalendarEventConflict) {
+		t.Fatalf("Create() error = %v, want CalendarEventConflictError", err)
+	}
+	if len(conflictErr.Conflicts) != 1 || conflictErr.Conflicts[0].CalendarEventID != "event-1" || conflictErr.Conflicts[0].Type != domain.CalendarEventTypeTimeBlock {
+		t.Fatalf("conflicts = %#v", conflictErr.Conflicts)
+	}
+	if len(repository.saved) != 0 || len(repository.reminders) != 0 {
+		t.Fatalf("rejected create wrote data: saved=%d reminders=%d", len(repository.saved), len(repository.reminders))
+	}
+}
+
+func TestUpdateChecksConflictsOnlyWhenRescheduled(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	blocking := mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-1", now.Add(time.Hour), "Europe/Rome", now)
+	repository := &conflictRepositoryStub{repositoryStub: repositoryStub{found: blocking}}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyReject, ReminderPolicy{})
+	title := "Holiday"
+
+	if _, _, err := service.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "event-1",
+		Changes:         CalendarEventChanges{Title: &title},
+	}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if repository.searches != 0 {
+		t.Fatalf("searches = %d, want no conflict lookup without a reschedule", repository.searches)
+	}
+
+	if _, _, err := service.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "event-1",
+		Changes: CalendarEventChanges{TimeRange: &TimeRangeUpdate{
+			Start:    now.Add(3 * time.Hour),
+			End:      now.Add(4 * time.Hour),
+			Timezone: "Europe/Rome",
+		}},
+	}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if repository.searches != 1 {
+		t.Fatalf("searches = %d, want 1", repository.searches)
+	}
+}
+
+func TestUpdateChecksConflictsWhenTheRecurrenceChanges(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	start := now.Add(time.Hour)
+	series := mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-1", start, "Europe/Rome", now)
+	twoDays, err := domain.NewRecurrence("FREQ=DAILY;COUNT=2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := series.ChangeRecurrence(&twoDays, now); err != nil {
+		t.Fatal(err)
+	}
+	appointment := mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-2", start.Add(72*time.Hour), "Europe/Rome", now)
+	repository := &conflictRepositoryStub{repositoryStub: repositoryStub{found: series}, views: []CalendarEventView{{Event: *appointment}}}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyReject, ReminderPolicy{})
+	fiveDays, err := domain.NewRecurrence("FREQ=DAILY;COUNT=5", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recurrence := &fiveDays
+
+	_, _, err = service.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "event-1",
+		Changes:         CalendarEventChanges{Recurrence: &recurrence},
+	})
+
+	var conflictErr *CalendarEventConflictError
+	if !errors.As(err, &conflictErr) || len(conflictErr.Conflicts) != 1 || conflictErr.Conflicts[0].CalendarEventID != "event-2" {
+		t.Fatalf("Update() error = %v, want a conflict with the appointment on the fourth day", err)
+	}
+	if len(repository.saved) != 0 {
+		t.Fatalf("saved = %d, want the rejected recurrence not stored", len(repository.saved))
+	}
+}
+
+func TestCreateLocksTheCalendarBeforeCheckingConflicts(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	repository := &conflictRepositoryStub{repositoryStub: repositoryStub{ids: []string{"event-2"}}}
+	customers := &customerResolverStub{customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}}
+	command := CreateAppointmentCommand{
+		CalendarID: domain.DefaultCalendarID,
+		Start:      now.Add(time.Hour),
+		End:        now.Add(2 * time.Hour),
+		CustomerID: "customer-1",
+	}
+
+	if _, _, err := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyReject, ReminderPolicy{}).Create(context.Background(), command); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(repository.locks) != 1 || repository.locks[0] != domain.DefaultCalendarID || repository.searchesBeforeLock != 0 {
+		t.Fatalf("locks = %v, searches before lock = %d, want the calendar locked before the lookup", repository.locks, repository.searchesBeforeLock)
+	}
+	if repository.writesOutsideTx != 0 {
+		t.Fatalf("writes outside transaction = %d, want the lock taken in the transaction", repository.writesOutsideTx)
+	}
+
+	repository = &conflictRepositoryStub{repositoryStub: repositoryStub{ids: []string{"event-3"}}}
+	if _, _, err := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{}).Create(context.Background(), command); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(repository.locks) != 0 {
+		t.Fatalf("locks = %v, want no lock when conflicts are allowed", repository.locks)
+	}
+}
+
+func TestCreateReturnsWarningsComputedUnderTheCalendarLock(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	repository := &conflictRepositoryStub{repositoryStub: repositoryStub{ids: []string{"event-2"}, found: mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-1", now.Add(time.Hour), "Europe/Rome", now)}}
+	customers := &customerResolverStub{customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}}
+	service := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyWarn, ReminderPolicy{})
+
+	event, warnings, err := service.Create(context.Background(), CreateAppointmentCommand{
+		CalendarID: domain.DefaultCalendarID,
+		Start:      now.Add(90 * time.Minute),
+		End:        now.Add(150 * time.Minute),
+		CustomerID: "customer-1",
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if event == nil || len(repository.saved) != 1 {
+		t.Fatalf("event = %#v, saved = %d, want the event stored under warn policy", event, len(repository.saved))
+	}
+	if len(warnings) != 1 || warnings[0].CalendarEventID != "event-1" {
+		t.Fatalf("warnings = %#v", warnings)
+	}
+	if len(repository.locks) != 1 || repository.searchesBeforeLock != 0 || repository.writesOutsideTx != 0 {
+		t.Fatalf("locks = %v, searches before lock = %d, writes outside tx = %d, want the warnings found under the lock in the transaction", repository.locks, repository.searchesBeforeLock, repository.writesOutsideTx)
+	}
+}
+
+func TestConflictDetectorWarnsWithoutRejecting(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	repository := &repositoryStub{found: mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-1", now.Add(time.Hour), "Europe/Rome", now)}
+	detector := NewConflictDetector(repository, ConflictPolicyWarn)
+	appointment := mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "event-2", now.Add(time.Hour), "Europe/Rome", now)
+
+	warnings, err := detector.Check(context.Background(), *appointment)
+	if err != nil {
+		t.Fatalf("Check() error = %v, want nil under warn policy", err)
+	}
+	if len(warnings) != 1 || warnings[0].CalendarEventID != "event-1" {
+		t.Fatalf("warnings = %#v", warnings)
+	}
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	for value, want := range map[string]ConflictPolicy{
+		"":        ConflictPolicyReject,
+		"reject":  ConflictPolicyReject,
+		" WARN ":  ConflictPolicyWarn,
+		"allow":   ConflictPolicyAllow,
+		"unknown": "",
+	} {
+		got, err := ParseConflictPolicy(value)
+		if got != want {
+			t.Errorf("ParseConflictPolicy(%q) = %q, want %q", value, got, want)
+		}
+		if want == "" && !errors.Is(err, ErrInvalidConflictPolicy) {
+			t.Errorf("ParseConflictPolicy(%q) error = %v, want ErrInvalidConflictPolicy", value, err)
+		}
+	}
+}
+
+type conflictRepositoryStub struct {
+	repositoryStub
+	searches           int
+	searchesBeforeLock int
+	// views replaces the found event as the search result when set.
+	views []CalendarEventView
+}
+
+func (r *conflictRepositoryStub) SearchCalendarEventViews(ctx context.Context, query ListCalendarEventsQuery) ([]CalendarEventView, error) {
+	r.searches++
+	if len(r.locks) == 0 {
+		r.searchesBeforeLock++
+	}
+	if r.views != nil {
+		return r.views, nil
+	}
+	return r.repositoryStub.SearchCalendarEventViews(ctx, query)
+}
@@ -0,0 +1,87 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+type CustomerNameRepository interface {
+	// RenameAppointmentCustomer stores the display name of customer on its appointments ending after
+	// endsAfter, increments the version of their calendar events and returns the ids of the events whose
+	// name changed.
+	RenameAppointmentCustomer(ctx context.Context, customer domain.CustomerRef, endsAfter time.Time, now time.Time) ([]string, error)
+	ListAppointmentCustomerIDs(ctx context.Context) ([]string, error)
+}
+
+// CustomerNameRepair counts the customers whose appointments were compared with the customer service,
+// the appointments renamed and the customers the customer service no longer knows.
+type CustomerNameRepair struct {
+	Customers int
+	Renamed   int
+	Missing   []string
+	Failures  []CustomerNameRepairFailure
+}
+
+type CustomerNameRepairFailure struct {
+	CustomerID string
+	Err        error
+}
+
+// CustomerNameService keeps the customer display name snapshotted on the appointments in sync with the
+// customer service. Renaming increments the version of the events, so a write loaded before the rename
+// fails with ErrCalendarEventVersionConflict instead of saving the old name back. No lifecycle event is
+// recorded: the name is a copy of customer data, not a change of the appointment.
+type CustomerNameService struct {
+	repository CustomerNameRepository
+	customers  CustomerResolver
+	clock      Clock
+}
+
+func NewCustomerNameService(repository CustomerNameRepository, customers CustomerResolver, clock Clock) *CustomerNameService {
+	return &CustomerNameService{repository: repository, customers: customers, clock: clock}
+}
+
+// RenameCustomer applies a name change published by the customer service to the appointments of the
+// customer that have not ended yet; ended appointments keep the name they were held with.
+func (s *CustomerNameService) RenameCustomer(ctx context.Context, customerID string, displayName string) ([]string, error) {
+	customer, err := domain.NewCustomerRef(customerID, displayName)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
+	return s.repository.RenameAppointmentCustomer(ctx, customer, now, now)
+}
+
+// RepairCustomerNames reads the current name of every customer with appointments from the customer
+// service and stores it on all their appointments, past ones included. It fixes the names snapshotted
+// before the customer changes were published and the changes whose events were lost. A customer that
+// fails is reported without stopping the others.
+func (s *CustomerNameService) RepairCustomerNames(ctx context.Context) (CustomerNameRepair, error) {
+	ids, err := s.repository.ListAppointmentCustomerIDs(ctx)
+	if err != nil {
+		return CustomerNameRepair{}, err
+	}
+	repair := CustomerNameRepair{Customers: len(ids)}
+	now := s.clock.Now()
+	for _, id := range ids {
+		customer, err := s.customers.ResolveCustomer(ctx, id)
+		if errors.Is(err, domain.ErrMissingRequiredData) {
+			repair.Missing = append(repair.Missing, id)
+			continue
+		}
+		if err != nil {
+			repair.Failures = append(repair.Failures, CustomerNameRepairFailure{CustomerID: id, Err: err})
+			continue
+		}
+		renamed, err := s.repository.RenameAppointmentCustomer(ctx, customer, time.Time{}, now)
+		if err != nil {
+			repair.Failures = append(repair.Failures, CustomerNameRepairFailure{CustomerID: id, Err: err})
+			continue
+		}
+		repair.Renamed += len(renamed)
+	}
+	return repair, nil
+}
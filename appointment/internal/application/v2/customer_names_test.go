@@ -0,0 +1,155 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+type customerNameRepositoryStub struct {
+	customerIDs []string
+	renames     []customerRename
+}
+
+type customerRename struct {
+	customer  domain.CustomerRef
+	endsAfter time.Time
+}
+
+func (r *customerNameRepositoryStub) RenameAppointmentCustomer(_ context.Context, customer domain.CustomerRef, endsAfter time.Time, _ time.Time) ([]string, error) {
+	r.renames = append(r.renames, customerRename{customer: customer, endsAfter: endsAfter})
+	return []string{"event-" + customer.ID}, nil
+}
+
+func (r *customerNameRepositoryStub) ListAppointmentCustomerIDs(context.Context) ([]string, error) {
+	return r.customerIDs, nil
+}
+
+type customerDirectoryStub map[string]domain.CustomerRef
+
+func (d customerDirectoryStub) ResolveCustomer(_ context.Context, customerID string) (domain.CustomerRef, error) {
+	if customerID == "customer-broken" {
+		return domain.CustomerRef{}, errors.New("customer service unavailable")
+	}
+	customer, ok := d[customerID]
+	if !ok {
+		return domain.CustomerRef{}, domain.ErrMissingRequiredData
+	}
+	return customer, nil
+}
+
+func TestRenameCustomerRenamesTheAppointmentsNotEndedYet(t *testing.T) {
+	now := time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)
+	repository := &customerNameRepositoryStub{}
+	service := NewCustomerNameService(repository, customerDirectoryStub{}, clockStub{now: now})
+
+	renamed, err := service.RenameCustomer(context.Background(), "customer-1", "Ada Lovelace")
+	if err != nil {
+		t.Fatalf("RenameCustomer() error = %v", err)
+	}
+	if len(renamed) != 1 || len(repository.renames) != 1 {
+		t.Fatalf("renamed = %v, renames = %v", renamed, repository.renames)
+	}
+	rename := repository.renames[0]
+	if rename.customer != (domain.CustomerRef{ID: "customer-1", DisplayName: "Ada Lovelace"}) || !rename.endsAfter.Equal(now) {
+		t.Fatalf("rename = %+v, want customer-1 renamed from now", rename)
+	}
+
+	if _, err := service.RenameCustomer(context.Background(), "", "Ada Lovelace"); !errors.Is(err, domain.ErrMissingRequiredData) {
+		t.Fatalf("RenameCustomer() without id error = %v, want %v", err, domain.ErrMissingRequiredData)
+	}
+}
+
+func TestRepairCustomerNamesRenamesEveryAppointmentAndReportsTheRest(t *testing.T) {
+	repository := &customerNameRepositoryStub{customerIDs: []string{"customer-1", "customer-deleted", "customer-broken"}}
+	customers := customerDirectoryStub{"customer-1": {ID: "customer-1", DisplayName: "Ada Lovelace"}}
+	service := NewCustomerNameService(repository, customers, clockStub{now: time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)})
+
+	repair, err := service.RepairCustomerNames(context.Background())
+	if err != nil {
+		t.Fatalf("RepairCustomerNames() error = %v", err)
+	}
+	if repair.Customers != 3 || repair.Renamed != 1 {
+		t.Fatalf("repair = %+v, want 3 customers and 1 renamed", repair)
+	}
+	if len(repair.Missing) != 1 || repair.Missing[0] != "customer-deleted" {
+		t.Fatalf("missing = %v, want customer-deleted", repair.Missing)
+	}
+	if len(repair.Failures) != 1 || repair.Failures[0].CustomerID != "customer-broken" {
+		t.Fatalf("failures = %v, want customer-broken", repair.Failures)
+	}
+	if len(repository.renames) != 1 || !repository.renames[0].endsAfter.IsZero() {
+		t.Fatalf("renames = %+v, want one rename of every appointment", repository.renames)
+	}
+}
+
+func TestRenameCustomerRejectsAnUpdateLoadedBeforeTheRename(t *testing.T) {
+	now := time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)
+	eventRange, err := domain.NewTimeRange(now.Add(24*time.Hour), now.Add(25*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appointment, err := domain.NewAppointmentEvent(domain.AppointmentEventParams{
+		EventID:    "event-1",
+		CalendarID: domain.DefaultCalendarID,
+		Range:      eventRange,
+		Customer:   domain.CustomerRef{ID: "customer-1", DisplayName: "Ada Lovelce"},
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository := &renamingRepositoryStub{repositoryStub: repositoryStub{found: &appointment}}
+	calendar := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	names := NewCustomerNameService(repository, customerDirectoryStub{}, clockStub{now: now})
+	loadedVersion := appointment.Version
+
+	if _, err := names.RenameCustomer(context.Background(), "customer-1", "Ada Lovelace"); err != nil {
+		t.Fatalf("RenameCustomer() error = %v", err)
+	}
+	title := "Facial"
+	_, _, err = calendar.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "event-1",
+		ExpectedVersion: &loadedVersion,
+		Changes:         CalendarEventChanges{Title: &title},
+	})
+	if !errors.Is(err, ErrCalendarEventVersionConflict) {
+		t.Fatalf("Update() error = %v, want %v", err, ErrCalendarEventVersionConflict)
+	}
+	if len(repository.saved) != 0 {
+		t.Fatalf("saved = %d, want the stale update rejected", len(repository.saved))
+	}
+
+	currentVersion := repository.found.Version
+	updated, _, err := calendar.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "event-1",
+		ExpectedVersion: &currentVersion,
+		Changes:         CalendarEventChanges{Title: &title},
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if customer := updated.Detail.(domain.Appointment).Customer; customer.DisplayName != "Ada Lovelace" {
+		t.Fatalf("customer = %+v, want the renamed customer kept", customer)
+	}
+}
+
+// renamingRepositoryStub renames the stored appointment the way the repository does, version included.
+type renamingRepositoryStub struct {
+	repositoryStub
+}
+
+func (r *renamingRepositoryStub) RenameAppointmentCustomer(_ context.Context, customer domain.CustomerRef, _ time.Time, _ time.Time) ([]string, error) {
+	appointment := r.found.Detail.(domain.Appointment)
+	appointment.Customer = customer
+	r.found.Detail = appointment
+	r.found.Version++
+	return []string{r.found.ID}, nil
+}
+
+func (r *renamingRepositoryStub) ListAppointmentCustomerIDs(context.Context) ([]string, error) {
+	return nil, nil
+}
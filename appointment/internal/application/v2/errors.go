@@ -2,4 +2,9 @@ package v2
 
 import "errors"
 
-var ErrUnsupportedEventType = errors.New("unsupported event type")
+var (
+	ErrUnsupportedEventType = errors.New("unsupported event type")
+	// ErrCalendarEventVersionConflict reports that the calendar event changed after the caller read it.
+	ErrCalendarEventVersionConflict = errors.New("calendar event version conflict")
+	ErrInvalidRecurrenceScope       = errors.New("invalid recurrence scope")
+)
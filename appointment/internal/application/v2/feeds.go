@@ -0,0 +1,145 @@
+package v2
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+const (
+	// calendarFeedPast and calendarFeedFuture bound the events published by a subscription feed around now.
+	calendarFeedPast   = 30 * 24 * time.Hour
+	calendarFeedFuture = 366 * 24 * time.Hour
+)
+
+var (
+	ErrCalendarFeedDisabled = errors.New("calendar feeds are disabled")
+	ErrInvalidFeedToken     = errors.New("invalid calendar feed token")
+)
+
+// CalendarFeedKind tells what a subscription feed publishes.
+type CalendarFeedKind string
+
+const (
+	CalendarFeedKindCalendar CalendarFeedKind = "calendar"
+	CalendarFeedKindCustomer CalendarFeedKind = "customer"
+)
+
+type CalendarFeedSubject struct {
+	Kind CalendarFeedKind
+	ID   string
+}
+
+// CalendarFeed holds the events of a subscription feed, canceled ones included, with recurring events kept as
+// series.
+type CalendarFeed struct {
+	Name   string
+	Events []CalendarEventView
+}
+
+type CalendarFeedService struct {
+	events    CalendarEventReadRepository
+	calendars CalendarReader
+	secret    []byte
+	clock     Clock
+}
+
+// NewCalendarFeedService signs feed tokens with secret; an empty secret disables the feeds.
+func NewCalendarFeedService(events CalendarEventReadRepository, calendars CalendarReader, secret string, clock Clock) *CalendarFeedService {
+	return &CalendarFeedService{events: events, calendars: calendars, secret: []byte(secret), clock: clock}
+}
+
+// FeedToken returns the token that grants read access to the subject feed. Tokens do not expire; changing the
+// secret revokes all of them.
+func (s *CalendarFeedService) FeedToken(ctx context.Context, subject CalendarFeedSubject) (string, error) {
+	if len(s.secret) == 0 {
+		return "", ErrCalendarFeedDisabled
+	}
+	subject, err := normalizeFeedSubject(subject)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.newFeed(ctx, subject); err != nil {
+		return "", err
+	}
+	return s.sign(subject), nil
+}
+
+// Feed checks the token before looking the subject up, so a caller without a valid token cannot tell existing
+// calendars and customers from unknown ones.
+func (s *CalendarFeedService) Feed(ctx context.Context, subject CalendarFeedSubject, token string) (*CalendarFeed, error) {
+	if len(s.secret) == 0 {
+		return nil, ErrCalendarFeedDisabled
+	}
+	subject, err := normalizeFeedSubject(subject)
+	if err != nil || !hmac.Equal([]byte(token), []byte(s.sign(subject))) {
+		return nil, ErrInvalidFeedToken
+	}
+	feed, err := s.newFeed(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
+	start, end := now.Add(-calendarFeedPast), now.Add(calendarFeedFuture)
+	query := ListCalendarEventsQuery{Start: &start, End: &end, IncludeCanceled: true}
+	if subject.Kind == CalendarFeedKindCalendar {
+		query.CalendarIDs = []string{subject.ID}
+	} else {
+		query.CustomerID = subject.ID
+		query.EventTypes = []domain.CalendarEventType{domain.CalendarEventTypeAppointment}
+	}
+	feed.Events, err = s.events.SearchCalendarEventViews(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, view := range feed.Events {
+		if appointment, ok := view.Event.Detail.(domain.Appointment); ok && feed.Name == "" {
+			feed.Name = appointment.Customer.DisplayName
+		}
+	}
+	return feed, nil
+}
+
+// normalizeFeedSubject returns the subject in the form its token is signed for, without looking it up.
+func normalizeFeedSubject(subject CalendarFeedSubject) (CalendarFeedSubject, error) {
+	switch subject.Kind {
+	case CalendarFeedKindCalendar:
+		calendarID, err := domain.NormalizeCalendarID(subject.ID)
+		if err != nil {
+			return CalendarFeedSubject{}, err
+		}
+		subject.ID = calendarID
+		return subject, nil
+	case CalendarFeedKindCustomer:
+		if subject.ID == "" {
+			return CalendarFeedSubject{}, domain.ErrMissingRequiredData
+		}
+		return subject, nil
+	default:
+		return CalendarFeedSubject{}, ErrInvalidFeedToken
+	}
+}
+
+// newFeed looks the normalized subject up; calendar feeds are named after the calendar, customer feeds after
+// the customer of their appointments.
+func (s *CalendarFeedService) newFeed(ctx context.Context, subject CalendarFeedSubject) (*CalendarFeed, error) {
+	if subject.Kind != CalendarFeedKindCalendar {
+		return &CalendarFeed{}, nil
+	}
+	calendar, err := findCalendar(ctx, s.calendars, subject.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &CalendarFeed{Name: calendar.Name}, nil
+}
+
+func (s *CalendarFeedService) sign(subject CalendarFeedSubject) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(string(subject.Kind) + ":" + subject.ID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestCalendarFeedRequiresTheSubjectToken(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	repository := &feedRepositoryStub{repositoryStub: repositoryStub{
+		found:     mustRecurringManualEvent(t, now, "FREQ=DAILY"),
+		calendars: map[string]domain.Calendar{roomCalendarID: {ID: roomCalendarID, Name: "Room 1"}},
+	}}
+	feeds := NewCalendarFeedService(repository, repository, "secret", clockStub{now: now})
+	subject := CalendarFeedSubject{Kind: CalendarFeedKindCalendar, ID: "AAAAAAAA-AAAA-AAAA-AAAA-AAAAAAAAAAAA"}
+
+	token, err := feeds.FeedToken(context.Background(), subject)
+	if err != nil {
+		t.Fatalf("FeedToken() error = %v", err)
+	}
+	feed, err := feeds.Feed(context.Background(), subject, token)
+	if err != nil {
+		t.Fatalf("Feed() error = %v", err)
+	}
+	if feed.Name != "Room 1" || len(feed.Events) != 1 || !feed.Events[0].Event.IsRecurring() {
+		t.Fatalf("feed = %#v, want the room calendar with the unexpanded series", feed)
+	}
+	if query := repository.query; len(query.CalendarIDs) != 1 || query.CalendarIDs[0] != roomCalendarID || !query.IncludeCanceled || query.Start == nil || query.End == nil {
+		t.Fatalf("query = %#v", query)
+	}
+
+	customerToken, err := feeds.FeedToken(context.Background(), CalendarFeedSubject{Kind: CalendarFeedKindCustomer, ID: roomCalendarID})
+	if err != nil {
+		t.Fatalf("FeedToken(customer) error = %v", err)
+	}
+	if _, err := feeds.Feed(context.Background(), subject, customerToken); !errors.Is(err, ErrInvalidFeedToken) {
+		t.Fatalf("Feed(other token) error = %v, want ErrInvalidFeedToken", err)
+	}
+	unknown := CalendarFeedSubject{Kind: CalendarFeedKindCalendar, ID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"}
+	if _, err := feeds.Feed(context.Background(), unknown, token); !errors.Is(err, ErrInvalidFeedToken) {
+		t.Fatalf("Feed(unknown calendar) error = %v, want ErrInvalidFeedToken before the calendar lookup", err)
+	}
+	if _, err := NewCalendarFeedService(repository, repository, "", clockStub{now: now}).Feed(context.Background(), subject, token); !errors.Is(err, ErrCalendarFeedDisabled) {
+		t.Fatalf("Feed(no secret) error = %v, want ErrCalendarFeedDisabled", err)
+	}
+}
+
+func TestCustomerFeedListsOnlyTheCustomerAppointments(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	repository := &feedRepositoryStub{}
+	feeds := NewCalendarFeedService(repository, repository, "secret", clockStub{now: now})
+	subject := CalendarFeedSubject{Kind: CalendarFeedKindCustomer, ID: "customer-1"}
+	token, err := feeds.FeedToken(context.Background(), subject)
+	if err != nil {
+		t.Fatalf("FeedToken() error = %v", err)
+	}
+
+	if _, err := feeds.Feed(context.Background(), subject, token); err != nil {
+		t.Fatalf("Feed() error = %v", err)
+	}
+	query := repository.query
+	if query.CustomerID != "customer-1" || len(query.CalendarIDs) != 0 || len(query.EventTypes) != 1 || query.EventTypes[0] != domain.CalendarEventTypeAppointment {
+		t.Fatalf("query = %#v, want the customer appointments of every calendar", query)
+	}
+}
+
+type feedRepositoryStub struct {
+	repositoryStub
+	query ListCalendarEventsQuery
+}
+
+func (r *feedRepositoryStub) SearchCalendarEventViews(ctx context.Context, query ListCalendarEventsQuery) ([]CalendarEventView, error) {
+	r.query = query
+	return r.repositoryStub.SearchCalendarEventViews(ctx, query)
+}
@@ -0,0 +1,140 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	defaultInsightPageLimit = 10
+	maxInsightPageLimit     = 100
+)
+
+var ErrInvalidPageRequest = errors.New("invalid page request")
+
+type PageQuery struct {
+	Page  int
+	Limit int
+}
+
+type CustomerAppointmentCount struct {
+	CustomerID          string
+	CustomerDisplayName string
+	Count               int
+}
+
+// CustomerNoShowCount counts the no-shows of a customer among the appointments with a recorded outcome.
+type CustomerNoShowCount struct {
+	CustomerID          string
+	CustomerDisplayName string
+	NoShows             int
+	Attendances         int
+}
+
+// NoShowRate is the share of recorded outcomes that were no-shows, between 0 and 1.
+func (count CustomerNoShowCount) NoShowRate() float64 {
+	if count.Attendances == 0 {
+		return 0
+	}
+	return float64(count.NoShows) / float64(count.Attendances)
+}
+
+type WeekdayCount struct {
+	Weekday time.Weekday
+	Count   int
+}
+
+type CustomerRankingPage struct {
+	Items    []CustomerAppointmentCount
+	NextPage *int
+}
+
+type CustomerNoShowRankingPage struct {
+	Items    []CustomerNoShowCount
+	NextPage *int
+}
+
+type InsightOverview struct {
+	// CancellationsByWeekday always lists every weekday from Monday to Sunday.
+	CancellationsByWeekday []WeekdayCount
+}
+
+type InsightRepository interface {
+	RankCustomersByAppointments(ctx context.Context, limit int, offset int) ([]CustomerAppointmentCount, error)
+	RankCustomersByCancellations(ctx context.Context, limit int, offset int) ([]CustomerAppointmentCount, error)
+	RankCustomersByNoShows(ctx context.Context, limit int, offset int) ([]CustomerNoShowCount, error)
+	CountCustomerCancellationsByWeekday(ctx context.Context) ([]WeekdayCount, error)
+}
+
+type InsightService struct {
+	repository InsightRepository
+}
+
+func NewInsightService(repository InsightRepository) *InsightService {
+	return &InsightService{repository: repository}
+}
+
+// CustomerRanking ranks customers by their appointments that were not canceled.
+func (s *InsightService) CustomerRanking(ctx context.Context, page PageQuery) (CustomerRankingPage, error) {
+	items, nextPage, err := rankingPage(ctx, page, s.repository.RankCustomersByAppointments)
+	return CustomerRankingPage{Items: items, NextPage: nextPage}, err
+}
+
+// CustomerCancellationRanking ranks customers by the appointments they canceled themselves.
+func (s *InsightService) CustomerCancellationRanking(ctx context.Context, page PageQuery) (CustomerRankingPage, error) {
+	items, nextPage, err := rankingPage(ctx, page, s.repository.RankCustomersByCancellations)
+	return CustomerRankingPage{Items: items, NextPage: nextPage}, err
+}
+
+// CustomerNoShowRanking ranks customers with at least one no-show by their number of no-shows. Canceled
+// appointments and appointments without a recorded outcome do not count.
+func (s *InsightService) CustomerNoShowRanking(ctx context.Context, page PageQuery) (CustomerNoShowRankingPage, error) {
+	items, nextPage, err := rankingPage(ctx, page, s.repository.RankCustomersByNoShows)
+	return CustomerNoShowRankingPage{Items: items, NextPage: nextPage}, err
+}
+
+func (s *InsightService) Overview(ctx context.Context) (InsightOverview, error) {
+	counts, err := s.repository.CountCustomerCancellationsByWeekday(ctx)
+	if err != nil {
+		return InsightOverview{}, err
+	}
+	byWeekday := make(map[time.Weekday]int, len(counts))
+	for _, count := range counts {
+		byWeekday[count.Weekday] += count.Count
+	}
+	overview := InsightOverview{CancellationsByWeekday: make([]WeekdayCount, 0, 7)}
+	for offset := range 7 {
+		weekday := time.Weekday((int(time.Monday) + offset) % 7)
+		overview.CancellationsByWeekday = append(overview.CancellationsByWeekday, WeekdayCount{Weekday: weekday, Count: byWeekday[weekday]})
+	}
+	return overview, nil
+}
+
+func rankingPage[T any](ctx context.Context, page PageQuery, find func(context.Context, int, int) ([]T, error)) ([]T, *int, error) {
+	page, err := normalizePageQuery(page)
+	if err != nil {
+		return nil, nil, err
+	}
+	// One extra row tells whether a following page exists without a separate count query.
+	items, err := find(ctx, page.Limit+1, page.Page*page.Limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(items) > page.Limit {
+		nextPage := page.Page + 1
+		return items[:page.Limit], &nextPage, nil
+	}
+	return items, nil, nil
+}
+
+func normalizePageQuery(page PageQuery) (PageQuery, error) {
+	if page.Page < 0 || page.Limit < 0 {
+		return page, ErrInvalidPageRequest
+	}
+	if page.Limit == 0 {
+		page.Limit = defaultInsightPageLimit
+	}
+	page.Limit = min(page.Limit, maxInsightPageLimit)
+	return page, nil
+}
@@ -0,0 +1,135 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type insightRepositoryStub struct {
+	ranking       []CustomerAppointmentCount
+	noShows       []CustomerNoShowCount
+	cancellations []WeekdayCount
+	limit         int
+	offset        int
+}
+
+func (r *insightRepositoryStub) RankCustomersByAppointments(_ context.Context, limit int, offset int) ([]CustomerAppointmentCount, error) {
+	r.limit, r.offset = limit, offset
+	end := min(offset+limit, len(r.ranking))
+	if offset >= end {
+		return nil, nil
+	}
+	return r.ranking[offset:end], nil
+}
+
+func (r *insightRepositoryStub) RankCustomersByCancellations(ctx context.Context, limit int, offset int) ([]CustomerAppointmentCount, error) {
+	return r.RankCustomersByAppointments(ctx, limit, offset)
+}
+
+func (r *insightRepositoryStub) RankCustomersByNoShows(_ context.Context, limit int, offset int) ([]CustomerNoShowCount, error) {
+	r.limit, r.offset = limit, offset
+	end := min(offset+limit, len(r.noShows))
+	if offset >= end {
+		return nil, nil
+	}
+	return r.noShows[offset:end], nil
+}
+
+func (r *insightRepositoryStub) CountCustomerCancellationsByWeekday(context.Context) ([]WeekdayCount, error) {
+	return r.cancellations, nil
+}
+
+func TestCustomerRankingPagesThroughRepository(t *testing.T) {
+	repository := &insightRepositoryStub{ranking: []CustomerAppointmentCount{
+		{CustomerID: "customer-1", Count: 5},
+		{CustomerID: "customer-2", Count: 4},
+		{CustomerID: "customer-3", Count: 3},
+	}}
+	service := NewInsightService(repository)
+
+	first, err := service.CustomerRanking(context.Background(), PageQuery{Page: 0, Limit: 2})
+	if err != nil {
+		t.Fatalf("CustomerRanking() error = %v", err)
+	}
+	if repository.limit != 3 || repository.offset != 0 {
+		t.Fatalf("limit/offset = %d/%d, want 3/0", repository.limit, repository.offset)
+	}
+	if len(first.Items) != 2 || first.NextPage == nil || *first.NextPage != 1 {
+		t.Fatalf("first page = %#v", first)
+	}
+
+	last, err := service.CustomerRanking(context.Background(), PageQuery{Page: 1, Limit: 2})
+	if err != nil {
+		t.Fatalf("CustomerRanking() error = %v", err)
+	}
+	if len(last.Items) != 1 || last.Items[0].CustomerID != "customer-3" || last.NextPage != nil {
+		t.Fatalf("last page = %#v", last)
+	}
+}
+
+func TestCustomerRankingNormalizesPageLimit(t *testing.T) {
+	repository := &insightRepositoryStub{}
+	service := NewInsightService(repository)
+
+	if _, err := service.CustomerCancellationRanking(context.Background(), PageQuery{}); err != nil {
+		t.Fatalf("CustomerCancellationRanking() error = %v", err)
+	}
+	if repository.limit != defaultInsightPageLimit+1 {
+		t.Fatalf("limit = %d, want default page limit plus one", repository.limit)
+	}
+	if _, err := service.CustomerCancellationRanking(context.Background(), PageQuery{Limit: 1000}); err != nil {
+		t.Fatalf("CustomerCancellationRanking() error = %v", err)
+	}
+	if repository.limit != maxInsightPageLimit+1 {
+		t.Fatalf("limit = %d, want max page limit plus one", repository.limit)
+	}
+	if _, err := service.CustomerRanking(context.Background(), PageQuery{Page: -1}); !errors.Is(err, ErrInvalidPageRequest) {
+		t.Fatalf("CustomerRanking() error = %v, want ErrInvalidPageRequest", err)
+	}
+}
+
+func TestCustomerNoShowRankingReportsTheNoShowRate(t *testing.T) {
+	repository := &insightRepositoryStub{noShows: []CustomerNoShowCount{
+		{CustomerID: "customer-1", NoShows: 3, Attendances: 4},
+		{CustomerID: "customer-2", NoShows: 1, Attendances: 10},
+	}}
+	service := NewInsightService(repository)
+
+	page, err := service.CustomerNoShowRanking(context.Background(), PageQuery{Limit: 1})
+	if err != nil {
+		t.Fatalf("CustomerNoShowRanking() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.NextPage == nil || *page.NextPage != 1 {
+		t.Fatalf("page = %#v", page)
+	}
+	if rate := page.Items[0].NoShowRate(); rate != 0.75 {
+		t.Fatalf("no-show rate = %v, want 0.75", rate)
+	}
+	if rate := (CustomerNoShowCount{}).NoShowRate(); rate != 0 {
+		t.Fatalf("empty no-show rate = %v, want 0", rate)
+	}
+}
+
+func TestOverviewListsEveryWeekdayFromMonday(t *testing.T) {
+	service := NewInsightService(&insightRepositoryStub{cancellations: []WeekdayCount{
+		{Weekday: time.Sunday, Count: 2},
+		{Weekday: time.Tuesday, Count: 1},
+	}})
+
+	overview, err := service.Overview(context.Background())
+	if err != nil {
+		t.Fatalf("Overview() error = %v", err)
+	}
+	if len(overview.CancellationsByWeekday) != 7 {
+		t.Fatalf("weekdays = %#v", overview.CancellationsByWeekday)
+	}
+	first, last := overview.CancellationsByWeekday[0], overview.CancellationsByWeekday[6]
+	if first.Weekday != time.Monday || first.Count != 0 || last.Weekday != time.Sunday || last.Count != 2 {
+		t.Fatalf("weekdays = %#v", overview.CancellationsByWeekday)
+	}
+	if overview.CancellationsByWeekday[1].Count != 1 {
+		t.Fatalf("tuesday = %#v", overview.CancellationsByWeekday[1])
+	}
+}
@@ -0,0 +1,148 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestUpdateThisOccurrenceDetachesItFromTheSeries(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	series := mustRecurringManualEvent(t, now, "FREQ=DAILY;COUNT=5")
+	repository := &repositoryStub{found: series, ids: []string{"event-2"}}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	occurrenceStart := series.Range.Start.Add(48 * time.Hour)
+	title := "Moved sync"
+
+	updated, _, err := service.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "event-1",
+		Occurrence:      &OccurrenceSelection{Start: occurrenceStart, Scope: RecurrenceScopeThis},
+		Changes:         CalendarEventChanges{Title: &title},
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if updated.ID != "event-2" || updated.Title != title || updated.IsRecurring() || !updated.Range.Start.Equal(occurrenceStart) {
+		t.Fatalf("updated = %#v, want detached one-off occurrence", updated)
+	}
+	if len(repository.saved) != 2 || repository.saved[0].ID != "event-1" || repository.saved[0].Title == title {
+		t.Fatalf("saved = %d events, want the untouched series and the detached occurrence", len(repository.saved))
+	}
+	if series.HasOccurrence(occurrenceStart) || !series.HasOccurrence(occurrenceStart.Add(24*time.Hour)) {
+		t.Fatalf("series exception dates = %v, want only the detached occurrence", series.Recurrence.ExceptionDates)
+	}
+}
+
+func TestUpdateThisAndFollowingOccurrencesSplitsTheSeries(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	series := mustRecurringManualEvent(t, now, "FREQ=DAILY;COUNT=5")
+	repository := &repositoryStub{found: series, ids: []string{"event-2"}}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	occurrenceStart := series.Range.Start.Add(48 * time.Hour)
+
+	updated, _, err := service.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "event-1",
+		Occurrence:      &OccurrenceSelection{Start: occurrenceStart, Scope: RecurrenceScopeThisAndFollowing},
+		Changes: CalendarEventChanges{TimeRange: &TimeRangeUpdate{
+			Start:    occurrenceStart.Add(time.Hour),
+			End:      occurrenceStart.Add(2 * time.Hour),
+			Timezone: "UTC",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if updated.ID != "event-2" || updated.Recurrence == nil || updated.Recurrence.Rule.Count != 3 {
+		t.Fatalf("updated = %#v, want a new series with the 3 remaining occurrences", updated.Recurrence)
+	}
+	if got := len(updated.Occurrences(now, now.Add(30*24*time.Hour))); got != 3 {
+		t.Fatalf("following occurrences = %d, want 3", got)
+	}
+	if got := len(series.Occurrences(now, now.Add(30*24*time.Hour))); got != 2 {
+		t.Fatalf("series occurrences = %d, want 2", got)
+	}
+}
+
+func TestCancelOccurrenceKeepsTheSeries(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	series := mustRecurringManualEvent(t, now, "FREQ=WEEKLY")
+	repository := &repositoryStub{found: series}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	occurrenceStart := series.Range.Start.Add(7 * 24 * time.Hour)
+
+	canceled, err := service.CancelEvent(context.Background(), CancelEventCommand{
+		CalendarEventID: "event-1",
+		Reason:          domain.CancelReasonDeleted,
+		Occurrence:      &OccurrenceSelection{Start: occurrenceStart, Scope: RecurrenceScopeThis},
+	})
+	if err != nil {
+		t.Fatalf("CancelEvent() error = %v", err)
+	}
+	if canceled.IsCanceled() || canceled.HasOccurrence(occurrenceStart) || !canceled.HasOccurrence(series.Range.Start) {
+		t.Fatalf("canceled = %#v, want the series without the occurrence", canceled.Recurrence)
+	}
+
+	_, err = service.CancelEvent(context.Background(), CancelEventCommand{
+		CalendarEventID: "event-1",
+		Reason:          domain.CancelReasonDeleted,
+		Occurrence:      &OccurrenceSelection{Start: occurrenceStart.Add(time.Minute), Scope: RecurrenceScopeThis},
+	})
+	if !errors.Is(err, domain.ErrInvalidOccurrence) {
+		t.Fatalf("CancelEvent() error = %v, want ErrInvalidOccurrence", err)
+	}
+}
+
+func TestListCalendarEventViewsExpandsRecurringEventsInTheWindow(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	repository := &repositoryStub{found: mustRecurringManualEvent(t, now, "FREQ=DAILY")}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	start := time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := start.Add(72 * time.Hour)
+
+	views, err := service.ListCalendarEventViews(context.Background(), ListCalendarEventsQuery{Start: &start, End: &end})
+	if err != nil {
+		t.Fatalf("ListCalendarEventViews() error = %v", err)
+	}
+
+	if len(views) != 3 {
+		t.Fatalf("views = %d, want 3 occurrences", len(views))
+	}
+	for index, view := range views {
+		want := time.Date(2026, 8, 10+index, 10, 0, 0, 0, time.UTC)
+		if view.Event.ID != "event-1" || !view.Event.Range.Start.Equal(want) {
+			t.Fatalf("occurrence %d = %s, want %s", index, view.Event.Range.Start, want)
+		}
+	}
+}
+
+func mustRecurringManualEvent(t *testing.T, now time.Time, rule string) *domain.CalendarEvent {
+	t.Helper()
+	start := time.Date(2026, 8, 3, 10, 0, 0, 0, time.UTC)
+	eventRange, err := domain.NewTimeRange(start, start.Add(time.Hour), "UTC", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recurrence, err := domain.NewRecurrence(rule, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := domain.NewManualCalendarEvent(domain.ManualEventParams{
+		EventID:    "event-1",
+		CalendarID: domain.DefaultCalendarID,
+		Range:      eventRange,
+		EventTitle: "Team sync",
+		Title:      "Team sync",
+		Recurrence: &recurrence,
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event.PullEvents()
+	return &event
+}
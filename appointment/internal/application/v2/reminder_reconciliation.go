@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"context"
+	"time"
+)
+
+// ScheduledCalendarReminder is a reminder expected to be delivered at SendAt for an
+// appointment starting at ExpectedStartAt, as stored by the service or by the job queue.
+type ScheduledCalendarReminder struct {
+	CalendarEventID string
+	Position        int
+	ExpectedStartAt time.Time
+	SendAt          time.Time
+}
+
+type ReminderReconciliationRepository interface {
+	Tx(ctx context.Context, atomicFn func(context.Context) error) error
+	FindScheduledCalendarReminders(ctx context.Context, startAfter time.Time) ([]ScheduledCalendarReminder, error)
+}
+
+// CalendarReminderJobLister lists the reminder jobs that are waiting or running.
+type CalendarReminderJobLister interface {
+	ListCalendarReminderJobs(ctx context.Context) ([]ScheduledCalendarReminder, error)
+}
+
+// ReminderDrift counts the differences repaired by a reconciliation: scheduled reminders
+// without a job, reminders whose jobs target an old start or are duplicated, and jobs
+// without a scheduled reminder of a future appointment.
+type ReminderDrift struct {
+	Missing  int
+	Stale    int
+	Orphaned int
+}
+
+func (drift ReminderDrift) Total() int {
+	return drift.Missing + drift.Stale + drift.Orphaned
+}
+
+type ReminderReconciler struct {
+	repository ReminderReconciliationRepository
+	scheduler  CalendarReminderScheduler
+	jobs       CalendarReminderJobLister
+	clock      Clock
+}
+
+func NewReminderReconciler(repository ReminderReconciliationRepository, scheduler CalendarReminderScheduler, jobs CalendarReminderJobLister, clock Clock) *ReminderReconciler {
+	return &ReminderReconciler{repository: repository, scheduler: scheduler, jobs: jobs, clock: clock}
+}
+
+// ReconcileReminders compares, in one transaction, the scheduled reminders of future
+// appointments with the waiting reminder jobs. Missing and stale jobs are scheduled again
+// at the stored send time; orphaned jobs are cancelled.
+func (r *ReminderReconciler) ReconcileReminders(ctx context.Context) (ReminderDrift, error) {
+	var drift ReminderDrift
+	err := r.repository.Tx(ctx, func(ctx context.Context) error {
+		drift = ReminderDrift{}
+		scheduled, err := r.repository.FindScheduledCalendarReminders(ctx, r.clock.Now())
+		if err != nil {
+			return err
+		}
+		jobs, err := r.jobs.ListCalendarReminderJobs(ctx)
+		if err != nil {
+			return err
+		}
+		jobsByReminder := make(map[reminderJobKey][]ScheduledCalendarReminder, len(jobs))
+		for _, job := range jobs {
+			key := reminderJobKey{calendarEventID: job.CalendarEventID, position: job.Position}
+			jobsByReminder[key] = append(jobsByReminder[key], job)
+		}
+		for _, reminder := range scheduled {
+			key := reminderJobKey{calendarEventID: reminder.CalendarEventID, position: reminder.Position}
+			queued := jobsByReminder[key]
+			delete(jobsByReminder, key)
+			switch {
+			case len(queued) == 0:
+				drift.Missing++
+			case len(queued) > 1 || !queued[0].ExpectedStartAt.Equal(reminder.ExpectedStartAt):
+				drift.Stale++
+			default:
+				continue
+			}
+			if err := r.scheduler.ScheduleCalendarReminder(ctx, reminder.CalendarEventID, reminder.Position, reminder.ExpectedStartAt, reminder.SendAt); err != nil {
+				return err
+			}
+		}
+		for key := range jobsByReminder {
+			drift.Orphaned++
+			if err := r.scheduler.UnscheduleCalendarReminder(ctx, key.calendarEventID, key.position); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return drift, err
+}
+
+type reminderJobKey struct {
+	calendarEventID string
+	position        int
+}
@@ -0,0 +1,76 @@
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type reminderReconciliationRepositoryStub struct {
+	txCalls   int
+	scheduled []ScheduledCalendarReminder
+	startedAt time.Time
+}
+
+func (r *reminderReconciliationRepositoryStub) Tx(ctx context.Context, atomicFn func(context.Context) error) error {
+	r.txCalls++
+	return atomicFn(ctx)
+}
+
+func (r *reminderReconciliationRepositoryStub) FindScheduledCalendarReminders(_ context.Context, startAfter time.Time) ([]ScheduledCalendarReminder, error) {
+	r.startedAt = startAfter
+	return r.scheduled, nil
+}
+
+type calendarReminderJobListerStub struct {
+	jobs []ScheduledCalendarReminder
+}
+
+func (l calendarReminderJobListerStub) ListCalendarReminderJobs(context.Context) ([]ScheduledCalendarReminder, error) {
+	return l.jobs, nil
+}
+
+type recordingReminderSchedulerStub struct {
+	scheduled   []ScheduledCalendarReminder
+	unscheduled []string
+}
+
+func (s *recordingReminderSchedulerStub) ScheduleCalendarReminder(_ context.Context, calendarEventID string, position int, expectedStartAt time.Time, sendAt time.Time) error {
+	s.scheduled = append(s.scheduled, ScheduledCalendarReminder{CalendarEventID: calendarEventID, Position: position, ExpectedStartAt: expectedStartAt, SendAt: sendAt})
+	return nil
+}
+
+func (s *recordingReminderSchedulerStub) UnscheduleCalendarReminder(_ context.Context, calendarEventID string, _ int) error {
+	s.unscheduled = append(s.unscheduled, calendarEventID)
+	return nil
+}
+
+func TestReminderReconcilerRepairsMissingStaleAndOrphanedJobs(t *testing.T) {
+	now := time.Date(2026, 8, 8, 10, 0, 0, 0, time.UTC)
+	start := now.Add(48 * time.Hour)
+	inSync := ScheduledCalendarReminder{CalendarEventID: "in-sync", ExpectedStartAt: start, SendAt: start.Add(-24 * time.Hour)}
+	missing := ScheduledCalendarReminder{CalendarEventID: "missing", Position: 1, ExpectedStartAt: start, SendAt: start.Add(-2 * time.Hour)}
+	moved := ScheduledCalendarReminder{CalendarEventID: "moved", ExpectedStartAt: start, SendAt: start.Add(-24 * time.Hour)}
+	repository := &reminderReconciliationRepositoryStub{scheduled: []ScheduledCalendarReminder{inSync, missing, moved}}
+	jobs := calendarReminderJobListerStub{jobs: []ScheduledCalendarReminder{
+		inSync,
+		{CalendarEventID: "moved", ExpectedStartAt: start.Add(-time.Hour), SendAt: start.Add(-25 * time.Hour)},
+		{CalendarEventID: "canceled", ExpectedStartAt: start, SendAt: start.Add(-24 * time.Hour)},
+	}}
+	scheduler := &recordingReminderSchedulerStub{}
+	reconciler := NewReminderReconciler(repository, scheduler, jobs, clockStub{now: now})
+
+	drift, err := reconciler.ReconcileReminders(context.Background())
+	if err != nil {
+		t.Fatalf("ReconcileReminders() error = %v", err)
+	}
+	if drift != (ReminderDrift{Missing: 1, Stale: 1, Orphaned: 1}) || repository.txCalls != 1 || !repository.startedAt.Equal(now) {
+		t.Fatalf("drift=%+v tx=%d startAfter=%s", drift, repository.txCalls, repository.startedAt)
+	}
+	if len(scheduler.scheduled) != 2 || scheduler.scheduled[0] != missing || scheduler.scheduled[1] != moved {
+		t.Fatalf("scheduled = %+v, want the missing and moved reminders at their stored send time", scheduler.scheduled)
+	}
+	if len(scheduler.unscheduled) != 1 || scheduler.unscheduled[0] != "canceled" {
+		t.Fatalf("unscheduled = %v, want only the orphaned job", scheduler.unscheduled)
+	}
+}
@@ -2,6 +2,8 @@ package v2
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
@@ -19,6 +21,8 @@ type CalendarEventRepository interface {
 	NextCalendarEventID() string
 	Tx(ctx context.Context, atomicFn func(context.Context) error) error
 	FindCalendarEvent(ctx context.Context, agendaEventID string) (*domain.CalendarEvent, error)
+	// SaveCalendarEvent stores the event only if the stored version still equals event.Version,
+	// then advances event.Version. A stale event fails with ErrCalendarEventVersionConflict.
 	SaveCalendarEvent(ctx context.Context, event *domain.CalendarEvent) error
 }
 
@@ -37,10 +41,29 @@ type CalendarEventReadRepository interface {
 }
 
 type Repository interface {
+	CalendarReader
 	CalendarEventRepository
 	AppointmentReminderRepository
 	AppointmentNotificationRepository
-	CalendarEventReadRepository
+	CalendarEventConflictRepository
+}
+
+// ReminderPolicy holds the lead times of the reminders given to appointments
+// that do not choose their own, and the bounds of the lead times they can choose.
+type ReminderPolicy struct {
+	RemindBefore []time.Duration
+	// MinRemindBefore and MaxRemindBefore bound chosen lead times; zero leaves the bound open.
+	MinRemindBefore time.Duration
+	MaxRemindBefore time.Duration
+}
+
+func (policy ReminderPolicy) validate(remindBefore []time.Duration) error {
+	for _, lead := range remindBefore {
+		if lead <= 0 || lead < policy.MinRemindBefore || (policy.MaxRemindBefore > 0 && lead > policy.MaxRemindBefore) {
+			return domain.ErrInvalidReminder
+		}
+	}
+	return nil
 }
 
 type CalendarService struct {
@@ -48,70 +71,98 @@ type CalendarService struct {
 	appointments *AppointmentEventService
 	manualEvents *ManualEventService
 	timeBlocks   *TimeBlockService
+	conflicts    *ConflictDetector
+	reminders    ReminderPolicy
 	clock        Clock
 }
 
-func NewCalendarService(repository Repository, customers CustomerResolver, clock Clock) *CalendarService {
+func NewCalendarService(repository Repository, customers CustomerResolver, clock Clock, conflictPolicy ConflictPolicy, reminders ReminderPolicy) *CalendarService {
+	conflicts := NewConflictDetector(repository, conflictPolicy)
 	return &CalendarService{
 		repository:   repository,
-		appointments: NewAppointmentEventService(repository, customers, clock),
+		appointments: NewAppointmentEventService(repository, customers, conflicts, clock),
 		manualEvents: NewManualEventService(repository, clock),
-		timeBlocks:   NewTimeBlockService(repository, clock),
+		timeBlocks:   NewTimeBlockService(repository, conflicts, clock),
+		conflicts:    conflicts,
+		reminders:    reminders,
 		clock:        clock,
 	}
 }
 
-func (s *CalendarService) Create(ctx context.Context, command CreateEventCommand) (*domain.CalendarEvent, error) {
+// Create stores the event; under ConflictPolicyWarn it also returns the events it conflicts with.
+func (s *CalendarService) Create(ctx context.Context, command CreateEventCommand) (*domain.CalendarEvent, []CalendarEventConflict, error) {
 	var calendarEvent domain.CalendarEvent
-	var reminder *domain.AppointmentReminder
+	var reminders []domain.AppointmentReminder
 	var err error
 	switch command := command.(type) {
 	case CreateAppointmentCommand:
+		remindBefore := command.RemindBefore
+		if remindBefore == nil {
+			remindBefore = s.reminders.RemindBefore
+		} else if err := s.reminders.validate(remindBefore); err != nil {
+			return nil, nil, err
+		}
 		calendarEvent, err = s.appointments.Create(ctx, command)
 		if err == nil {
-			createdReminder, reminderErr := domain.NewAppointmentReminder(command.RemindBefore, calendarEvent.CreatedAt)
-			if reminderErr != nil {
-				return nil, reminderErr
-			}
-			reminder = &createdReminder
+			reminders, err = domain.NewAppointmentReminders(remindBefore, calendarEvent.CreatedAt)
 		}
 	case CreateManualEventCommand:
 		calendarEvent, err = s.manualEvents.Create(ctx, command)
 	case CreateTimeBlockCommand:
 		calendarEvent, err = s.timeBlocks.Create(ctx, command)
 	default:
-		return nil, ErrUnsupportedEventType
+		return nil, nil, ErrUnsupportedEventType
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	var conflicts []CalendarEventConflict
 	if err := s.repository.Tx(ctx, func(ctx context.Context) error {
+		if err := ensureCalendarAcceptsEvents(ctx, s.repository, calendarEvent.CalendarID); err != nil {
+			return err
+		}
+		conflicts, err = s.conflicts.Check(ctx, calendarEvent)
+		if err != nil {
+			return err
+		}
 		if err := s.repository.SaveCalendarEvent(ctx, &calendarEvent); err != nil {
 			return err
 		}
-		if reminder != nil {
-			return s.repository.SaveAppointmentReminderState(ctx, calendarEvent.ID, *reminder)
+		for _, reminder := range reminders {
+			if err := s.repository.SaveAppointmentReminderState(ctx, calendarEvent.ID, reminder); err != nil {
+				return err
+			}
 		}
 		return nil
 	}); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return &calendarEvent, nil
+	return &calendarEvent, conflicts, nil
 }
 
 type AppointmentEventService struct {
 	calendarEvents CalendarEventRepository
 	customers      CustomerResolver
+	conflicts      *ConflictDetector
 	clock          Clock
 }
 
-func NewAppointmentEventService(repository CalendarEventRepository, customers CustomerResolver, clock Clock) *AppointmentEventService {
-	return &AppointmentEventService{calendarEvents: repository, customers: customers, clock: clock}
+func NewAppointmentEventService(repository CalendarEventRepository, customers CustomerResolver, conflicts *ConflictDetector, clock Clock) *AppointmentEventService {
+	return &AppointmentEventService{calendarEvents: repository, customers: customers, conflicts: conflicts, clock: clock}
 }
 
+// Create computes a missing end from the duration and buffer of the booked services.
 func (s *AppointmentEventService) Create(ctx context.Context, command CreateAppointmentCommand) (domain.CalendarEvent, error) {
 	now := s.clock.Now()
-	eventRange, err := domain.NewTimeRange(command.Start, command.End, command.Timezone, command.AllDay)
+	end := command.End
+	if end.IsZero() {
+		duration := domain.ServicesDuration(command.Services)
+		if duration <= 0 || command.AllDay {
+			return domain.CalendarEvent{}, fmt.Errorf("%w: end is required unless the services have a duration", domain.ErrInvalidTimeRange)
+		}
+		end = command.Start.Add(duration)
+	}
+	eventRange, err := domain.NewTimeRange(command.Start, end, command.Timezone, command.AllDay)
 	if err != nil {
 		return domain.CalendarEvent{}, err
 	}
@@ -139,15 +190,31 @@ func (s *AppointmentEventService) Create(ctx context.Context, command CreateAppo
 	return calendarEvent, nil
 }
 
-func (s *AppointmentEventService) Update(ctx context.Context, command UpdateAppointmentCommand) (*domain.CalendarEvent, error) {
+// Update changes the appointment; saved, when not nil, runs in the same transaction once the
+// appointment is stored.
+func (s *AppointmentEventService) Update(ctx context.Context, command UpdateAppointmentCommand, saved func(context.Context, *domain.CalendarEvent) error) (*domain.CalendarEvent, []CalendarEventConflict, error) {
 	now := s.clock.Now()
-	return changeCalendarEvent(ctx, s.calendarEvents, command.CalendarEventID, func(event *domain.CalendarEvent) error {
+	return changeCalendarEvent(ctx, s.calendarEvents, s.conflicts, calendarEventChange{
+		calendarEventID: command.CalendarEventID,
+		expectedVersion: command.ExpectedVersion,
+		occurrence:      command.Occurrence,
+		now:             now,
+		saved:           saved,
+	}, func(event *domain.CalendarEvent) error {
 		if _, ok := event.Detail.(domain.Appointment); !ok {
 			return domain.ErrInvalidEventDetail
 		}
 		if err := applyCalendarEventChanges(event, command.Changes, now); err != nil {
 			return err
 		}
+		if command.RemindBefore != nil {
+			if err := event.ChangeReminders(now); err != nil {
+				return err
+			}
+		}
+		if command.Services == nil {
+			return nil
+		}
 		return event.ReplaceAppointmentServices(command.Services, now)
 	})
 }
@@ -177,6 +244,7 @@ func (s *ManualEventService) Create(ctx context.Context, command CreateManualEve
 		Title:            command.ManualTitle,
 		Description:      command.ManualDetails,
 		Location:         command.Location,
+		Recurrence:       command.Recurrence,
 		Now:              now,
 	})
 	if err != nil {
@@ -187,7 +255,12 @@ func (s *ManualEventService) Create(ctx context.Context, command CreateManualEve
 
 func (s *ManualEventService) Update(ctx context.Context, command UpdateManualEventCommand) (*domain.CalendarEvent, error) {
 	now := s.clock.Now()
-	return changeCalendarEvent(ctx, s.calendarEvents, command.CalendarEventID, func(event *domain.CalendarEvent) error {
+	event, _, err := changeCalendarEvent(ctx, s.calendarEvents, nil, calendarEventChange{
+		calendarEventID: command.CalendarEventID,
+		expectedVersion: command.ExpectedVersion,
+		occurrence:      command.Occurrence,
+		now:             now,
+	}, func(event *domain.CalendarEvent) error {
 		manualEvent, ok := event.Detail.(domain.ManualEvent)
 		if !ok {
 			return domain.ErrInvalidEventDetail
@@ -209,15 +282,17 @@ func (s *ManualEventService) Update(ctx context.Context, command UpdateManualEve
 		}
 		return event.ChangeManualDetails(title, description, location, now)
 	})
+	return event, err
 }
 
 type TimeBlockService struct {
 	calendarEvents CalendarEventRepository
+	conflicts      *ConflictDetector
 	clock          Clock
 }
 
-func NewTimeBlockService(repository CalendarEventRepository, clock Clock) *TimeBlockService {
-	return &TimeBlockService{calendarEvents: repository, clock: clock}
+func NewTimeBlockService(repository CalendarEventRepository, conflicts *ConflictDetector, clock Clock) *TimeBlockService {
+	return &TimeBlockService{calendarEvents: repository, conflicts: conflicts, clock: clock}
 }
 
 func (s *TimeBlockService) Create(ctx context.Context, command CreateTimeBlockCommand) (domain.CalendarEvent, error) {
@@ -234,6 +309,7 @@ func (s *TimeBlockService) Create(ctx context.Context, command CreateTimeBlockCo
 		Description: command.Description,
 		Visibility:  command.Visibility,
 		Reason:      command.Reason,
+		Recurrence:  command.Recurrence,
 		Now:         now,
 	})
 	if err != nil {
@@ -242,9 +318,14 @@ func (s *TimeBlockService) Create(ctx context.Context, command CreateTimeBlockCo
 	return calendarEvent, nil
 }
 
-func (s *TimeBlockService) Update(ctx context.Context, command UpdateTimeBlockCommand) (*domain.CalendarEvent, error) {
+func (s *TimeBlockService) Update(ctx context.Context, command UpdateTimeBlockCommand) (*domain.CalendarEvent, []CalendarEventConflict, error) {
 	now := s.clock.Now()
-	return changeCalendarEvent(ctx, s.calendarEvents, command.CalendarEventID, func(event *domain.CalendarEvent) error {
+	return changeCalendarEvent(ctx, s.calendarEvents, s.conflicts, calendarEventChange{
+		calendarEventID: command.CalendarEventID,
+		expectedVersion: command.ExpectedVersion,
+		occurrence:      command.Occurrence,
+		now:             now,
+	}, func(event *domain.CalendarEvent) error {
 		if _, ok := event.Detail.(domain.TimeBlock); !ok {
 			return domain.ErrInvalidEventDetail
 		}
@@ -259,67 +340,284 @@ func (s *CalendarService) GetCalendarEventView(ctx context.Context, calendarEven
 	return s.repository.FindCalendarEventView(ctx, calendarEventID)
 }
 
+// ListCalendarEventViews lists the matching events; within a time window recurring events are expanded into
+// their occurrences.
 func (s *CalendarService) ListCalendarEventViews(ctx context.Context, query ListCalendarEventsQuery) ([]CalendarEventView, error) {
-	return s.repository.SearchCalendarEventViews(ctx, query)
+	return searchCalendarEventOccurrences(ctx, s.repository, query)
 }
 
-func (s *CalendarService) Update(ctx context.Context, command UpdateEventCommand) (*domain.CalendarEvent, error) {
+// ListCalendarEventPage lists at most query.PageSize events past query.After. Within a time window recurring
+// events are expanded like ListCalendarEventViews and the page and its cursor count the occurrences; a paged
+// window may span at most 31 days. Next is set while more events are available.
+func (s *CalendarService) ListCalendarEventPage(ctx context.Context, query ListCalendarEventsQuery) (CalendarEventPage, error) {
+	if query.PageSize < 0 {
+		return CalendarEventPage{}, ErrInvalidPageRequest
+	}
+	if query.PageSize > 0 {
+		query.PageSize = min(query.PageSize, maxCalendarEventPageSize)
+	}
+	if query.Start != nil && query.End != nil {
+		if query.PageSize > 0 && query.End.Sub(*query.Start) > maxCalendarEventPageRange {
+			return CalendarEventPage{}, ErrInvalidPageRequest
+		}
+		// Occurrences of a series are not in the stored order, so the window is listed whole and paged here.
+		window := query
+		window.PageSize, window.After = 0, nil
+		views, err := searchCalendarEventOccurrences(ctx, s.repository, window)
+		if err != nil {
+			return CalendarEventPage{}, err
+		}
+		sortCalendarEventViews(views, query.Descending)
+		if query.After != nil {
+			views = views[countCalendarEventViewsUpTo(views, *query.After, query.Descending):]
+		}
+		return newCalendarEventPage(views, query.PageSize), nil
+	}
+	if query.PageSize > 0 {
+		// One extra row tells whether a following page exists without a separate count query.
+		query.PageSize++
+	}
+	views, err := s.repository.SearchCalendarEventViews(ctx, query)
+	if err != nil {
+		return CalendarEventPage{}, err
+	}
+	return newCalendarEventPage(views, query.PageSize-1), nil
+}
+
+// Update changes the event; under ConflictPolicyWarn it also returns the events a rescheduled event
+// conflicts with.
+func (s *CalendarService) Update(ctx context.Context, command UpdateEventCommand) (*domain.CalendarEvent, []CalendarEventConflict, error) {
 	switch command := command.(type) {
 	case UpdateCalendarFieldsCommand:
 		now := s.clock.Now()
-		return changeCalendarEvent(ctx, s.repository, command.CalendarEventID, func(event *domain.CalendarEvent) error {
+		return changeCalendarEvent(ctx, s.repository, s.conflicts, calendarEventChange{
+			calendarEventID: command.CalendarEventID,
+			expectedVersion: command.ExpectedVersion,
+			occurrence:      command.Occurrence,
+			now:             now,
+		}, func(event *domain.CalendarEvent) error {
 			return applyCalendarEventChanges(event, command.Changes, now)
 		})
 	case UpdateAppointmentCommand:
-		return s.appointments.Update(ctx, command)
+		if command.RemindBefore == nil {
+			return s.appointments.Update(ctx, command, nil)
+		}
+		if err := s.reminders.validate(command.RemindBefore); err != nil {
+			return nil, nil, err
+		}
+		return s.appointments.Update(ctx, command, func(ctx context.Context, event *domain.CalendarEvent) error {
+			return s.replaceReminders(ctx, event, command.RemindBefore)
+		})
 	case UpdateManualEventCommand:
-		return s.manualEvents.Update(ctx, command)
+		event, err := s.manualEvents.Update(ctx, command)
+		return event, nil, err
 	case UpdateTimeBlockCommand:
 		return s.timeBlocks.Update(ctx, command)
 	default:
-		return nil, ErrUnsupportedEventType
+		return nil, nil, ErrUnsupportedEventType
+	}
+}
+
+// replaceReminders stores a fresh pending reminder for each lead time and deletes the stored
+// reminders past the new set, so the lifecycle can unschedule them.
+func (s *CalendarService) replaceReminders(ctx context.Context, event *domain.CalendarEvent, remindBefore []time.Duration) error {
+	reminders, err := domain.NewAppointmentReminders(remindBefore, event.UpdatedAt)
+	if err != nil {
+		return err
 	}
+	view, err := s.repository.FindCalendarEventView(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	if view != nil {
+		for _, stored := range view.Reminders {
+			if stored.Position < len(reminders) || stored.Status == domain.ReminderStatusDeleted {
+				continue
+			}
+			stored.MarkDeleted(event.UpdatedAt)
+			reminders = append(reminders, stored)
+		}
+	}
+	for _, reminder := range reminders {
+		if err := s.repository.SaveAppointmentReminderState(ctx, event.ID, reminder); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// CancelEvent cancels a calendar event. On a recurring event, a single occurrence is removed with an
+// exception date and this and following occurrences by ending the series before them.
 func (s *CalendarService) CancelEvent(ctx context.Context, command CancelEventCommand) (*domain.CalendarEvent, error) {
 	now := s.clock.Now()
-	var calendarEvent *domain.CalendarEvent
-	if err := s.repository.Tx(ctx, func(ctx context.Context) error {
-		found, err := s.repository.FindCalendarEvent(ctx, command.CalendarEventID)
-		if err != nil {
-			return err
+	event, _, err := changeCalendarEvent(ctx, s.repository, nil, calendarEventChange{
+		calendarEventID: command.CalendarEventID,
+		expectedVersion: command.ExpectedVersion,
+		now:             now,
+	}, cancelCalendarEvent(command.Occurrence, command.Reason, now))
+	return event, err
+}
+
+// cancelCalendarEvent returns the change cancelling the event, or the selected occurrences of a series.
+func cancelCalendarEvent(occurrence *OccurrenceSelection, reason domain.CancelReason, now time.Time) func(*domain.CalendarEvent) error {
+	return func(event *domain.CalendarEvent) error {
+		if occurrence.appliesToSeries(*event) {
+			event.Cancel(reason, now)
+			return nil
 		}
-		found.Cancel(command.Reason, now)
-		if err := s.repository.SaveCalendarEvent(ctx, found); err != nil {
+		switch occurrence.Scope {
+		case RecurrenceScopeThis:
+			return event.ExcludeOccurrence(occurrence.Start, now)
+		case RecurrenceScopeThisAndFollowing:
+			_, err := event.EndSeriesBefore(occurrence.Start, now)
 			return err
+		default:
+			return ErrInvalidRecurrenceScope
 		}
-		calendarEvent = found
-		return nil
-	}); err != nil {
-		return nil, err
 	}
-	return calendarEvent, nil
 }
 
-func changeCalendarEvent(ctx context.Context, repository CalendarEventRepository, calendarEventID string, change func(*domain.CalendarEvent) error) (*domain.CalendarEvent, error) {
+// MarkCompleted records that the customer showed up to a started appointment.
+func (s *CalendarService) MarkCompleted(ctx context.Context, command RecordAttendanceCommand) (*domain.CalendarEvent, error) {
+	now := s.clock.Now()
+	event, _, err := changeCalendarEvent(ctx, s.repository, nil, calendarEventChange{
+		calendarEventID: command.CalendarEventID,
+		expectedVersion: command.ExpectedVersion,
+		now:             now,
+	}, func(event *domain.CalendarEvent) error {
+		return event.MarkCompleted(now)
+	})
+	return event, err
+}
+
+// MarkNoShow records that the customer did not show up to a started appointment.
+func (s *CalendarService) MarkNoShow(ctx context.Context, command RecordAttendanceCommand) (*domain.CalendarEvent, error) {
+	now := s.clock.Now()
+	event, _, err := changeCalendarEvent(ctx, s.repository, nil, calendarEventChange{
+		calendarEventID: command.CalendarEventID,
+		expectedVersion: command.ExpectedVersion,
+		now:             now,
+	}, func(event *domain.CalendarEvent) error {
+		return event.MarkNoShow(now)
+	})
+	return event, err
+}
+
+type calendarEventChange struct {
+	calendarEventID string
+	expectedVersion *int64
+	occurrence      *OccurrenceSelection
+	now             time.Time
+	// saved runs in the transaction after the changed event is stored; nil skips it.
+	saved func(context.Context, *domain.CalendarEvent) error
+}
+
+// changeCalendarEvent loads, changes and saves a calendar event in one transaction.
+// A non-nil expectedVersion must match the stored version, otherwise ErrCalendarEventVersionConflict is returned.
+// When an occurrence of a recurring event is selected, the change applies to a new event detached from the
+// series, which is returned instead of the series.
+// When conflicts is set, a changed time range or recurrence is checked against the other events of the
+// calendar, and the conflicts the policy only warns about are returned with the saved event.
+func changeCalendarEvent(ctx context.Context, repository CalendarEventRepository, conflicts *ConflictDetector, target calendarEventChange, change func(*domain.CalendarEvent) error) (*domain.CalendarEvent, []CalendarEventConflict, error) {
 	var calendarEvent *domain.CalendarEvent
+	var warnings []CalendarEventConflict
 	if err := repository.Tx(ctx, func(ctx context.Context) error {
-		found, err := repository.FindCalendarEvent(ctx, calendarEventID)
+		var err error
+		calendarEvent, warnings, err = changeCalendarEventInTx(ctx, repository, conflicts, target, change)
+		return err
+	}); err != nil {
+		var rejected rejectedChangeError
+		if errors.As(err, &rejected) {
+			return nil, nil, rejected.err
+		}
+		return nil, nil, err
+	}
+	return calendarEvent, warnings, nil
+}
+
+// rejectedChangeError wraps an error of the change or of its conflict check, returned before anything is
+// written, so the surrounding transaction can go on with other changes.
+type rejectedChangeError struct {
+	err error
+}
+
+func (e rejectedChangeError) Error() string {
+	return e.err.Error()
+}
+
+func (e rejectedChangeError) Unwrap() error {
+	return e.err
+}
+
+// changeCalendarEventInTx is changeCalendarEvent inside the caller's transaction.
+func changeCalendarEventInTx(ctx context.Context, repository CalendarEventRepository, conflicts *ConflictDetector, target calendarEventChange, change func(*domain.CalendarEvent) error) (*domain.CalendarEvent, []CalendarEventConflict, error) {
+	found, err := repository.FindCalendarEvent(ctx, target.calendarEventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if found == nil {
+		return nil, nil, ErrCalendarEventNotFound
+	}
+	if target.expectedVersion != nil && found.Version != *target.expectedVersion {
+		return nil, nil, ErrCalendarEventVersionConflict
+	}
+	changed := found
+	var series *domain.CalendarEvent
+	if !target.occurrence.appliesToSeries(*found) {
+		detached, err := detachOccurrence(repository, found, *target.occurrence, target.now)
 		if err != nil {
-			return err
+			return nil, nil, rejectedChangeError{err: err}
 		}
-		if err := change(found); err != nil {
-			return err
+		series, changed = found, &detached
+	}
+	previousRange, previousRecurrence := changed.Range, changed.Recurrence
+	if err := change(changed); err != nil {
+		return nil, nil, rejectedChangeError{err: err}
+	}
+	var warnings []CalendarEventConflict
+	if !changed.Range.Equals(previousRange) || !sameRecurrence(previousRecurrence, changed.Recurrence) {
+		var conflictErr *CalendarEventConflictError
+		if warnings, err = conflicts.Check(ctx, *changed); errors.As(err, &conflictErr) {
+			return nil, nil, rejectedChangeError{err: err}
+		} else if err != nil {
+			return nil, nil, err
 		}
-		if err := repository.SaveCalendarEvent(ctx, found); err != nil {
-			return err
+	}
+	if series != nil {
+		if err := repository.SaveCalendarEvent(ctx, series); err != nil {
+			return nil, nil, err
 		}
-		calendarEvent = found
-		return nil
-	}); err != nil {
-		return nil, err
 	}
-	return calendarEvent, nil
+	if err := repository.SaveCalendarEvent(ctx, changed); err != nil {
+		return nil, nil, err
+	}
+	if target.saved != nil {
+		if err := target.saved(ctx, changed); err != nil {
+			return nil, nil, err
+		}
+	}
+	return changed, warnings, nil
+}
+
+// detachOccurrence takes the selected occurrences out of the series and returns them as a new event: a one-off
+// event for RecurrenceScopeThis, a series with the remaining occurrences for RecurrenceScopeThisAndFollowing.
+func detachOccurrence(repository CalendarEventRepository, series *domain.CalendarEvent, occurrence OccurrenceSelection, now time.Time) (domain.CalendarEvent, error) {
+	original := *series
+	switch occurrence.Scope {
+	case RecurrenceScopeThis:
+		if err := series.ExcludeOccurrence(occurrence.Start, now); err != nil {
+			return domain.CalendarEvent{}, err
+		}
+		return original.DetachOccurrence(repository.NextCalendarEventID(), occurrence.Start, nil, now)
+	case RecurrenceScopeThisAndFollowing:
+		following, err := series.EndSeriesBefore(occurrence.Start, now)
+		if err != nil {
+			return domain.CalendarEvent{}, err
+		}
+		return original.DetachOccurrence(repository.NextCalendarEventID(), occurrence.Start, &following, now)
+	default:
+		return domain.CalendarEvent{}, ErrInvalidRecurrenceScope
+	}
 }
 
 func applyCalendarEventChanges(event *domain.CalendarEvent, changes CalendarEventChanges, now time.Time) error {
@@ -337,7 +635,15 @@ func applyCalendarEventChanges(event *domain.CalendarEvent, changes CalendarEven
 		event.ChangeDescription(*changes.Description, now)
 	}
 	if changes.Visibility != nil {
-		return event.ChangeVisibility(*changes.Visibility, now)
+		if err := event.ChangeVisibility(*changes.Visibility, now); err != nil {
+			return err
+		}
+	}
+	if changes.Recurrence != nil {
+		return event.ChangeRecurrence(*changes.Recurrence, now)
+	}
+	if event.Recurrence != nil && changes.TimeRange != nil {
+		return event.Recurrence.Validate(event.Range)
 	}
 	return nil
 }
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"errors"
+	"sort"
 	"testing"
 	"time"
 
@@ -17,10 +18,13 @@ type repositoryStub struct {
 	appointmentSaved bool
 	manualSaved      bool
 	timeBlockSaved   bool
-	reminders        map[string]domain.AppointmentReminder
+	reminders        map[string][]domain.AppointmentReminder
 	notifications    map[string]domain.AppointmentNotification
 	inTx             bool
 	writesOutsideTx  int
+	locks            []string
+	// calendars replaces the active calendar returned for any id when set.
+	calendars map[string]domain.Calendar
 }
 
 type customerResolverStub struct {
@@ -33,6 +37,45 @@ func (r *customerResolverStub) ResolveCustomer(context.Context, string) (domain.
 	return r.customer, nil
 }
 
+// mustCalendarEvent builds a stored one hour event of eventType in the default calendar: an appointment of
+// Jane Doe or a holiday time block.
+func mustCalendarEvent(t *testing.T, eventType domain.CalendarEventType, id string, start time.Time, timezone string, now time.Time) *domain.CalendarEvent {
+	t.Helper()
+	eventRange, err := domain.NewTimeRange(start, start.Add(time.Hour), timezone, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var event domain.CalendarEvent
+	switch eventType {
+	case domain.CalendarEventTypeAppointment:
+		event, err = domain.NewAppointmentEvent(domain.AppointmentEventParams{
+			EventID:    id,
+			CalendarID: domain.DefaultCalendarID,
+			Range:      eventRange,
+			Title:      "Jane Doe",
+			Visibility: domain.VisibilityPrivate,
+			Customer:   domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+			Now:        now,
+		})
+	case domain.CalendarEventTypeTimeBlock:
+		event, err = domain.NewTimeBlockCalendarEvent(domain.TimeBlockEventParams{
+			EventID:    id,
+			CalendarID: domain.DefaultCalendarID,
+			Range:      eventRange,
+			Title:      "Holiday",
+			Reason:     "holiday",
+			Now:        now,
+		})
+	default:
+		t.Fatalf("unsupported event type %q", eventType)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	event.PullEvents()
+	return &event
+}
+
 func (r *repositoryStub) NextCalendarEventID() string {
 	value := r.ids[0]
 	r.ids = r.ids[1:]
@@ -46,6 +89,25 @@ func (r *repositoryStub) Tx(ctx context.Context, atomicFn func(context.Context)
 	return atomicFn(ctx)
 }
 
+func (r *repositoryStub) FindCalendar(_ context.Context, calendarID string) (*domain.Calendar, error) {
+	if r.calendars == nil {
+		return &domain.Calendar{ID: calendarID, Name: "Agenda"}, nil
+	}
+	calendar, ok := r.calendars[calendarID]
+	if !ok {
+		return nil, nil
+	}
+	return &calendar, nil
+}
+
+func (r *repositoryStub) LockCalendarEvents(_ context.Context, calendarID string) error {
+	if !r.inTx {
+		r.writesOutsideTx++
+	}
+	r.locks = append(r.locks, calendarID)
+	return nil
+}
+
 func (r *repositoryStub) FindCalendarEvent(context.Context, string) (*domain.CalendarEvent, error) {
 	return r.found, nil
 }
@@ -54,11 +116,8 @@ func (r *repositoryStub) FindCalendarEventView(_ context.Context, calendarEventI
 	if r.found == nil {
 		return nil, nil
 	}
-	reminder, ok := r.reminders[calendarEventID]
-	if !ok {
-		return &CalendarEventView{Event: *r.found}, nil
-	}
-	return &CalendarEventView{Event: *r.found, Reminder: &reminder}, nil
+	reminders := append([]domain.AppointmentReminder(nil), r.reminders[calendarEventID]...)
+	return &CalendarEventView{Event: *r.found, Reminders: reminders}, nil
 }
 
 func (r *repositoryStub) SearchCalendarEventViews(ctx context.Context, _ ListCalendarEventsQuery) ([]CalendarEventView, error) {
@@ -90,9 +149,15 @@ func (r *repositoryStub) SaveAppointmentReminderState(_ context.Context, calenda
 		r.writesOutsideTx++
 	}
 	if r.reminders == nil {
-		r.reminders = make(map[string]domain.AppointmentReminder)
+		r.reminders = make(map[string][]domain.AppointmentReminder)
+	}
+	for index, stored := range r.reminders[calendarEventID] {
+		if stored.Position == reminder.Position {
+			r.reminders[calendarEventID][index] = reminder
+			return nil
+		}
 	}
-	r.reminders[calendarEventID] = reminder
+	r.reminders[calendarEventID] = append(r.reminders[calendarEventID], reminder)
 	return nil
 }
 
@@ -115,10 +180,28 @@ func (r *repositoryStub) SaveAppointmentNotification(_ context.Context, notifica
 	return nil
 }
 
+func (r *repositoryStub) FindExpiredAppointmentNotifications(_ context.Context, now time.Time, limit int) ([]domain.AppointmentNotification, error) {
+	keys := make([]string, 0, len(r.notifications))
+	for key, notification := range r.notifications {
+		if notification.Status == domain.NotificationStatusPending && !notification.ExpiresAt.After(now) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var expired []domain.AppointmentNotification
+	for _, key := range keys {
+		if len(expired) == limit {
+			break
+		}
+		expired = append(expired, r.notifications[key])
+	}
+	return expired, nil
+}
+
 func TestUpdateReschedulesAndSavesUniformCalendarEvent(t *testing.T) {
 	repository := &repositoryStub{}
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
-	service := NewCalendarService(repository, nil, clockStub{now: now})
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
 	eventRange, err := domain.NewTimeRange(now.Add(time.Hour), now.Add(2*time.Hour), "Europe/Rome", false)
 	if err != nil {
 		t.Fatal(err)
@@ -137,7 +220,7 @@ func TestUpdateReschedulesAndSavesUniformCalendarEvent(t *testing.T) {
 	manualEvent.PullEvents()
 	repository.found = &manualEvent
 
-	rescheduled, err := service.Update(context.Background(), UpdateCalendarFieldsCommand{
+	rescheduled, _, err := service.Update(context.Background(), UpdateCalendarFieldsCommand{
 		CalendarEventID: "event-1",
 		Changes: CalendarEventChanges{TimeRange: &TimeRangeUpdate{
 			Start:    now.Add(3 * time.Hour),
@@ -159,7 +242,7 @@ func TestUpdateReschedulesAndSavesUniformCalendarEvent(t *testing.T) {
 func TestCancelEventLoadsAndSavesUniformCalendarEvent(t *testing.T) {
 	repository := &repositoryStub{}
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
-	service := NewCalendarService(repository, nil, clockStub{now: now})
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
 	eventRange, err := domain.NewTimeRange(now.Add(time.Hour), now.Add(2*time.Hour), "Europe/Rome", false)
 	if err != nil {
 		t.Fatal(err)
@@ -190,6 +273,93 @@ func TestCancelEventLoadsAndSavesUniformCalendarEvent(t *testing.T) {
 	}
 }
 
+func TestCancelEventRejectsStaleExpectedVersion(t *testing.T) {
+	repository := &repositoryStub{}
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	eventRange, err := domain.NewTimeRange(now.Add(time.Hour), now.Add(2*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timeBlock, err := domain.NewTimeBlockCalendarEvent(domain.TimeBlockEventParams{
+		EventID:    "event-1",
+		CalendarID: domain.DefaultCalendarID,
+		Range:      eventRange,
+		Title:      "Internal work",
+		Reason:     "closed",
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	timeBlock.Version = 3
+	repository.found = &timeBlock
+
+	staleVersion := int64(2)
+	_, err = service.CancelEvent(context.Background(), CancelEventCommand{
+		CalendarEventID: "event-1",
+		Reason:          domain.CancelReasonDeleted,
+		ExpectedVersion: &staleVersion,
+	})
+	if !errors.Is(err, ErrCalendarEventVersionConflict) {
+		t.Fatalf("CancelEvent() error = %v, want ErrCalendarEventVersionConflict", err)
+	}
+	if timeBlock.IsCanceled() || len(repository.saved) != 0 {
+		t.Fatalf("stale cancel changed the event: canceled=%v saved=%d", timeBlock.IsCanceled(), len(repository.saved))
+	}
+
+	currentVersion := int64(3)
+	if _, err := service.CancelEvent(context.Background(), CancelEventCommand{
+		CalendarEventID: "event-1",
+		Reason:          domain.CancelReasonDeleted,
+		ExpectedVersion: &currentVersion,
+	}); err != nil {
+		t.Fatalf("CancelEvent() error = %v", err)
+	}
+	if len(repository.saved) != 1 {
+		t.Fatalf("saved = %d, want 1", len(repository.saved))
+	}
+}
+
+func TestMarkNoShowRecordsTheOutcomeOfAStartedAppointment(t *testing.T) {
+	repository := &repositoryStub{}
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	repository.found = mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "appointment-1", now.Add(-2*time.Hour), "Europe/Rome", now.Add(-24*time.Hour))
+	repository.found.PullEvents()
+
+	event, err := service.MarkNoShow(context.Background(), RecordAttendanceCommand{CalendarEventID: "appointment-1"})
+	if err != nil {
+		t.Fatalf("MarkNoShow() error = %v", err)
+	}
+	attendance := event.Detail.(domain.Appointment).Attendance
+	if attendance == nil || attendance.Status != domain.AttendanceNoShow || len(repository.saved) != 1 {
+		t.Fatalf("attendance = %#v, saved = %d", attendance, len(repository.saved))
+	}
+	if events := event.PullEvents(); len(events) != 1 || events[0].Type != "CalendarEventNoShow" {
+		t.Fatalf("events = %#v, want CalendarEventNoShow", events)
+	}
+
+	repository.found = mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "appointment-1", now.Add(time.Hour), "Europe/Rome", now.Add(-24*time.Hour))
+	if _, err := service.MarkCompleted(context.Background(), RecordAttendanceCommand{CalendarEventID: "appointment-1"}); !errors.Is(err, domain.ErrInvalidAttendance) {
+		t.Fatalf("MarkCompleted() before start error = %v, want ErrInvalidAttendance", err)
+	}
+}
+
+func TestUpdateReturnsNotFoundForMissingCalendarEvent(t *testing.T) {
+	repository := &repositoryStub{}
+	service := NewCalendarService(repository, nil, clockStub{now: time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)}, ConflictPolicyAllow, ReminderPolicy{})
+	title := "New title"
+
+	_, _, err := service.Update(context.Background(), UpdateCalendarFieldsCommand{
+		CalendarEventID: "missing",
+		Changes:         CalendarEventChanges{Title: &title},
+	})
+	if !errors.Is(err, ErrCalendarEventNotFound) {
+		t.Fatalf("Update() error = %v, want ErrCalendarEventNotFound", err)
+	}
+}
+
 type clockStub struct {
 	now time.Time
 }
@@ -202,19 +372,19 @@ func TestCreateAppointmentBuildsAppointmentAggregate(t *testing.T) {
 	repository := &repositoryStub{ids: []string{"event-1"}}
 	customers := &customerResolverStub{customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}}
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
-	service := NewCalendarService(repository, customers, clockStub{now: now})
+	service := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
 
 	item, err := domain.NewServiceItem(nil, "Haircut", 0)
 	if err != nil {
 		t.Fatal(err)
 	}
-	appointmentEvent, err := service.Create(context.Background(), CreateAppointmentCommand{
+	appointmentEvent, _, err := service.Create(context.Background(), CreateAppointmentCommand{
 		CalendarID:   domain.DefaultCalendarID,
 		Start:        now.Add(time.Hour),
 		End:          now.Add(2 * time.Hour),
 		CustomerID:   "customer-1",
 		Services:     []domain.ServiceItem{item},
-		RemindBefore: 24 * time.Hour,
+		RemindBefore: []time.Duration{24 * time.Hour},
 	})
 	if err != nil {
 		t.Fatalf("Create() error = %v", err)
@@ -234,8 +404,11 @@ func TestCreateAppointmentBuildsAppointmentAggregate(t *testing.T) {
 	if repository.txCalls != 1 {
 		t.Fatalf("tx calls = %d, want 1", repository.txCalls)
 	}
-	reminder, ok := repository.reminders[appointmentEvent.ID]
-	if !ok || reminder.Status != domain.ReminderStatusPending || reminder.RemindBefore != 24*time.Hour {
+	reminders := repository.reminders[appointmentEvent.ID]
+	if len(reminders) != 1 {
+		t.Fatalf("saved reminders = %#v, want one", reminders)
+	}
+	if reminder := reminders[0]; reminder.Status != domain.ReminderStatusPending || reminder.RemindBefore != 24*time.Hour {
 		t.Fatalf("saved reminder = %#v, want pending 24h reminder", reminder)
 	}
 	if repository.writesOutsideTx != 0 {
@@ -243,18 +416,84 @@ func TestCreateAppointmentBuildsAppointmentAggregate(t *testing.T) {
 	}
 }
 
-func TestCreateAppointmentRejectsInvalidReminderBeforeWriting(t *testing.T) {
+func TestCreateAppointmentAppliesTheReminderPolicy(t *testing.T) {
 	repository := &repositoryStub{ids: []string{"event-1"}}
 	customers := &customerResolverStub{customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}}
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
-	service := NewCalendarService(repository, customers, clockStub{now: now})
+	policy := ReminderPolicy{RemindBefore: []time.Duration{2 * time.Hour, 48 * time.Hour}}
+	service := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyAllow, policy)
 
-	_, err := service.Create(context.Background(), CreateAppointmentCommand{
+	appointmentEvent, _, err := service.Create(context.Background(), CreateAppointmentCommand{
 		CalendarID: domain.DefaultCalendarID,
-		Start:      now.Add(time.Hour),
-		End:        now.Add(2 * time.Hour),
+		Start:      now.Add(72 * time.Hour),
+		End:        now.Add(73 * time.Hour),
 		CustomerID: "customer-1",
 	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	reminders := repository.reminders[appointmentEvent.ID]
+	if len(reminders) != 2 || reminders[0].RemindBefore != 48*time.Hour || reminders[1].RemindBefore != 2*time.Hour || reminders[1].Position != 1 {
+		t.Fatalf("saved reminders = %#v, want 48h then 2h", reminders)
+	}
+}
+
+func TestCreateAppointmentComputesTheEndFromTheServices(t *testing.T) {
+	repository := &repositoryStub{ids: []string{"event-1", "event-2"}}
+	customers := &customerResolverStub{customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}}
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	service := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	facial, err := domain.NewCatalogServiceItem("service-1", "Facial", 45*time.Minute, 15*time.Minute, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom, err := domain.NewServiceItem(nil, "Consultation", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appointmentEvent, _, err := service.Create(context.Background(), CreateAppointmentCommand{
+		CalendarID:   domain.DefaultCalendarID,
+		Start:        now.Add(time.Hour),
+		CustomerID:   "customer-1",
+		Services:     []domain.ServiceItem{facial, custom},
+		RemindBefore: []time.Duration{24 * time.Hour},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !appointmentEvent.Range.End.Equal(now.Add(2 * time.Hour)) {
+		t.Fatalf("end = %s, want the start plus the service duration and buffer", appointmentEvent.Range.End)
+	}
+	if detail := appointmentEvent.Detail.(domain.Appointment); detail.Services[0].Duration != 45*time.Minute || detail.Services[0].Buffer != 15*time.Minute {
+		t.Fatalf("services = %#v, want the catalog durations snapshotted", detail.Services)
+	}
+
+	_, _, err = service.Create(context.Background(), CreateAppointmentCommand{
+		CalendarID:   domain.DefaultCalendarID,
+		Start:        now.Add(time.Hour),
+		CustomerID:   "customer-1",
+		Services:     []domain.ServiceItem{custom},
+		RemindBefore: []time.Duration{24 * time.Hour},
+	})
+	if !errors.Is(err, domain.ErrInvalidTimeRange) {
+		t.Fatalf("Create(no duration) error = %v, want %v", err, domain.ErrInvalidTimeRange)
+	}
+}
+
+func TestCreateAppointmentRejectsInvalidReminderBeforeWriting(t *testing.T) {
+	repository := &repositoryStub{ids: []string{"event-1"}}
+	customers := &customerResolverStub{customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}}
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	service := NewCalendarService(repository, customers, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+
+	_, _, err := service.Create(context.Background(), CreateAppointmentCommand{
+		CalendarID:   domain.DefaultCalendarID,
+		Start:        now.Add(time.Hour),
+		End:          now.Add(2 * time.Hour),
+		CustomerID:   "customer-1",
+		RemindBefore: []time.Duration{0},
+	})
 	if !errors.Is(err, domain.ErrInvalidReminder) {
 		t.Fatalf("Create() error = %v, want %v", err, domain.ErrInvalidReminder)
 	}
@@ -266,9 +505,9 @@ func TestCreateAppointmentRejectsInvalidReminderBeforeWriting(t *testing.T) {
 func TestCreateManualEventBuildsManualDetail(t *testing.T) {
 	repository := &repositoryStub{ids: []string{"event-1"}}
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
-	service := NewCalendarService(repository, nil, clockStub{now: now})
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
 
-	manualEvent, err := service.Create(context.Background(), CreateManualEventCommand{
+	manualEvent, _, err := service.Create(context.Background(), CreateManualEventCommand{
 		CalendarID:  domain.DefaultCalendarID,
 		Start:       now.Add(time.Hour),
 		End:         now.Add(2 * time.Hour),
@@ -308,14 +547,14 @@ func TestUpdateDispatchesAppointmentChangesInOneTransaction(t *testing.T) {
 	}
 	event.PullEvents()
 	repository.found = &event
-	service := NewCalendarService(repository, nil, clockStub{now: now.Add(time.Hour)})
+	service := NewCalendarService(repository, nil, clockStub{now: now.Add(time.Hour)}, ConflictPolicyAllow, ReminderPolicy{})
 	newTitle := "New title"
 	item, err := domain.NewServiceItem(nil, "Haircut", 0)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	updated, err := service.Update(context.Background(), UpdateAppointmentCommand{
+	updated, _, err := service.Update(context.Background(), UpdateAppointmentCommand{
 		CalendarEventID: "event-1",
 		Changes:         CalendarEventChanges{Title: &newTitle},
 		Services:        []domain.ServiceItem{item},
@@ -332,6 +571,38 @@ func TestUpdateDispatchesAppointmentChangesInOneTransaction(t *testing.T) {
 	}
 }
 
+func TestUpdateAppointmentReplacesRemindersWithinPolicyBounds(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	event := newAppointmentLifecycleEvent(t, now.Add(72*time.Hour), now.Add(73*time.Hour), now)
+	reminders, err := domain.NewAppointmentReminders([]time.Duration{24 * time.Hour, 2 * time.Hour}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository := &repositoryStub{found: &event, reminders: map[string][]domain.AppointmentReminder{event.ID: reminders}}
+	policy := ReminderPolicy{MinRemindBefore: time.Hour, MaxRemindBefore: 72 * time.Hour}
+	service := NewCalendarService(repository, nil, clockStub{now: now.Add(time.Hour)}, ConflictPolicyAllow, policy)
+
+	_, _, err = service.Update(context.Background(), UpdateAppointmentCommand{CalendarEventID: event.ID, RemindBefore: []time.Duration{96 * time.Hour}})
+	if !errors.Is(err, domain.ErrInvalidReminder) || repository.txCalls != 0 {
+		t.Fatalf("Update(out of bounds) error = %v tx = %d, want %v before any write", err, repository.txCalls, domain.ErrInvalidReminder)
+	}
+
+	updated, _, err := service.Update(context.Background(), UpdateAppointmentCommand{CalendarEventID: event.ID, RemindBefore: []time.Duration{48 * time.Hour}})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	stored := repository.reminders[event.ID]
+	if stored[0].RemindBefore != 48*time.Hour || stored[0].Status != domain.ReminderStatusPending || stored[1].Status != domain.ReminderStatusDeleted {
+		t.Fatalf("stored reminders = %#v, want a pending 48h reminder and the 2h one deleted", stored)
+	}
+	if detail := updated.Detail.(domain.Appointment); len(detail.Services) != len(event.Detail.(domain.Appointment).Services) {
+		t.Fatalf("services = %#v, want them kept when not in the command", detail.Services)
+	}
+	if events := updated.PullEvents(); len(events) != 1 || events[0].Type != "CalendarEventRemindersChanged" {
+		t.Fatalf("lifecycle events = %#v, want CalendarEventRemindersChanged", events)
+	}
+}
+
 func TestUpdateRejectsMismatchedDetailBeforeChangingCommonFields(t *testing.T) {
 	repository := &repositoryStub{}
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
@@ -352,10 +623,10 @@ func TestUpdateRejectsMismatchedDetailBeforeChangingCommonFields(t *testing.T) {
 	}
 	event.PullEvents()
 	repository.found = &event
-	service := NewCalendarService(repository, nil, clockStub{now: now.Add(time.Hour)})
+	service := NewCalendarService(repository, nil, clockStub{now: now.Add(time.Hour)}, ConflictPolicyAllow, ReminderPolicy{})
 	newTitle := "Must not be applied"
 
-	_, err = service.Update(context.Background(), UpdateAppointmentCommand{
+	_, _, err = service.Update(context.Background(), UpdateAppointmentCommand{
 		CalendarEventID: "event-1",
 		Changes:         CalendarEventChanges{Title: &newTitle},
 	})
@@ -366,3 +637,113 @@ func TestUpdateRejectsMismatchedDetailBeforeChangingCommonFields(t *testing.T) {
 		t.Fatalf("mismatched update changed event: %#v", event)
 	}
 }
+
+func TestListCalendarEventPageFetchesOneExtraEventForTheNextCursor(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	first := mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-1", now.Add(time.Hour), "Europe/Rome", now)
+	second := mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-2", now.Add(time.Hour), "Europe/Rome", now)
+	repository := &pageRepositoryStub{views: []CalendarEventView{{Event: *first}, {Event: *second}}}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+
+	page, err := service.ListCalendarEventPage(context.Background(), ListCalendarEventsQuery{Text: "holiday", PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListCalendarEventPage() error = %v", err)
+	}
+
+	if repository.query.PageSize != 2 || repository.query.Text != "holiday" {
+		t.Fatalf("query = %#v, want one extra event and the text filter", repository.query)
+	}
+	if len(page.Views) != 1 || page.Views[0].Event.ID != first.ID {
+		t.Fatalf("views = %#v, want only the first event", page.Views)
+	}
+	if page.Next == nil || page.Next.ID != first.ID || !page.Next.Start.Equal(first.Range.Start) {
+		t.Fatalf("next = %#v, want a cursor past the first event", page.Next)
+	}
+}
+
+func TestListCalendarEventPagePagesThroughTheOccurrencesOfASeries(t *testing.T) {
+	now := time.Date(2026, 8, 4, 11, 0, 0, 0, time.UTC)
+	series := mustRecurringManualEvent(t, now, "FREQ=DAILY;COUNT=3")
+	oneOff := mustCalendarEvent(t, domain.CalendarEventTypeTimeBlock, "event-2", now.Add(time.Hour), "Europe/Rome", now)
+	repository := &pageRepositoryStub{views: []CalendarEventView{{Event: *series}, {Event: *oneOff}}}
+	service := NewCalendarService(repository, nil, clockStub{now: now}, ConflictPolicyAllow, ReminderPolicy{})
+	start, end := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC)
+	query := ListCalendarEventsQuery{Start: &start, End: &end, PageSize: 2}
+
+	first, err := service.ListCalendarEventPage(context.Background(), query)
+	if err != nil {
+		t.Fatalf("ListCalendarEventPage() error = %v", err)
+	}
+	if repository.query.PageSize != 0 || repository.query.After != nil {
+		t.Fatalf("query = %#v, want the whole window searched", repository.query)
+	}
+	query.After = first.Next
+	second, err := service.ListCalendarEventPage(context.Background(), query)
+	if err != nil {
+		t.Fatalf("ListCalendarEventPage() error = %v", err)
+	}
+
+	seriesStart := series.Range.Start
+	want := [][]CalendarEventCursor{
+		{{ID: "event-1", Start: seriesStart}, {ID: "event-1", Start: seriesStart.Add(24 * time.Hour)}},
+		{{ID: "event-2", Start: oneOff.Range.Start}, {ID: "event-1", Start: seriesStart.Add(48 * time.Hour)}},
+	}
+	for index, page := range []CalendarEventPage{first, second} {
+		if len(page.Views) != len(want[index]) {
+			t.Fatalf("page %d has %d views, want %d", index+1, len(page.Views), len(want[index]))
+		}
+		for position, view := range page.Views {
+			if view.Event.ID != want[index][position].ID || !view.Event.Range.Start.Equal(want[index][position].Start) {
+				t.Fatalf("page %d view %d = %s at %s, want %s at %s", index+1, position, view.Event.ID, view.Event.Range.Start, want[index][position].ID, want[index][position].Start)
+			}
+		}
+	}
+	if first.Next == nil || first.Next.ID != "event-1" || !first.Next.Start.Equal(seriesStart.Add(24*time.Hour)) {
+		t.Fatalf("first next = %#v, want a cursor past the second occurrence", first.Next)
+	}
+	if second.Next != nil {
+		t.Fatalf("second next = %#v, want the last page", second.Next)
+	}
+}
+
+func TestListCalendarEventPageRejectsNegativePageSize(t *testing.T) {
+	service := NewCalendarService(&pageRepositoryStub{}, nil, clockStub{}, ConflictPolicyAllow, ReminderPolicy{})
+
+	_, err := service.ListCalendarEventPage(context.Background(), ListCalendarEventsQuery{PageSize: -1})
+	if !errors.Is(err, ErrInvalidPageRequest) {
+		t.Fatalf("ListCalendarEventPage() error = %v, want ErrInvalidPageRequest", err)
+	}
+}
+
+func TestListCalendarEventPageRejectsWindowsLongerThanAMonth(t *testing.T) {
+	repository := &pageRepositoryStub{}
+	service := NewCalendarService(repository, nil, clockStub{}, ConflictPolicyAllow, ReminderPolicy{})
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := start.Add(31 * 24 * time.Hour)
+	pastMonthEnd := monthEnd.Add(time.Minute)
+
+	if _, err := service.ListCalendarEventPage(context.Background(), ListCalendarEventsQuery{Start: &start, End: &monthEnd, PageSize: 10}); err != nil {
+		t.Fatalf("ListCalendarEventPage() over 31 days error = %v", err)
+	}
+	_, err := service.ListCalendarEventPage(context.Background(), ListCalendarEventsQuery{Start: &start, End: &pastMonthEnd, PageSize: 10})
+	if !errors.Is(err, ErrInvalidPageRequest) {
+		t.Fatalf("ListCalendarEventPage() past 31 days error = %v, want ErrInvalidPageRequest", err)
+	}
+	if _, err := service.ListCalendarEventPage(context.Background(), ListCalendarEventsQuery{Start: &start, End: &pastMonthEnd}); err != nil {
+		t.Fatalf("unpaged ListCalendarEventPage() error = %v", err)
+	}
+}
+
+type pageRepositoryStub struct {
+	repositoryStub
+	views []CalendarEventView
+	query ListCalendarEventsQuery
+}
+
+func (r *pageRepositoryStub) SearchCalendarEventViews(_ context.Context, query ListCalendarEventsQuery) ([]CalendarEventView, error) {
+	r.query = query
+	if query.PageSize > 0 && len(r.views) > query.PageSize {
+		return r.views[:query.PageSize], nil
+	}
+	return r.views, nil
+}
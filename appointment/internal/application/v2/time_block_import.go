@@ -0,0 +1,228 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+// timeBlockImportNamespace derives the calendar event id of an imported time block from its calendar and UID,
+// so importing the same event again finds the time block created the first time.
+var timeBlockImportNamespace = uuid.MustParse("00fc9816-3f25-45be-88ee-c7b0e8fc72c0")
+
+const defaultImportedTimeBlockReason = "imported"
+
+// ImportedTimeBlock is an event read from an external calendar. Without a Timezone, Start, End and the
+// exception dates hold floating wall-clock times, stored as UTC, that are placed in the salon timezone.
+type ImportedTimeBlock struct {
+	UID            string
+	Start          time.Time
+	End            time.Time
+	Timezone       string
+	AllDay         bool
+	Title          string
+	Description    string
+	RecurrenceRule string
+	ExceptionDates []time.Time
+	Canceled       bool
+}
+
+type ImportTimeBlocksCommand struct {
+	CalendarID string
+	// Reason is stored on every imported time block; empty means "imported".
+	Reason     string
+	TimeBlocks []ImportedTimeBlock
+}
+
+type TimeBlockImportStatus string
+
+const (
+	TimeBlockImportCreated   TimeBlockImportStatus = "created"
+	TimeBlockImportUpdated   TimeBlockImportStatus = "updated"
+	TimeBlockImportUnchanged TimeBlockImportStatus = "unchanged"
+	TimeBlockImportCanceled  TimeBlockImportStatus = "canceled"
+	// TimeBlockImportSkipped marks canceled events that were never imported.
+	TimeBlockImportSkipped TimeBlockImportStatus = "skipped"
+)
+
+type TimeBlockImportResult struct {
+	UID             string
+	CalendarEventID string
+	Status          TimeBlockImportStatus
+	// Conflicts lists the appointments overlapping the imported time block.
+	Conflicts []CalendarEventConflict
+}
+
+type TimeBlockImportService struct {
+	repository Repository
+	conflicts  *ConflictDetector
+	location   *time.Location
+	clock      Clock
+}
+
+// NewTimeBlockImportService places floating imported times in location. Conflicts with appointments are
+// reported but never reject the import.
+func NewTimeBlockImportService(repository Repository, location *time.Location, clock Clock) *TimeBlockImportService {
+	return &TimeBlockImportService{
+		repository: repository,
+		conflicts:  NewConflictDetector(repository, ConflictPolicyWarn),
+		location:   location,
+		clock:      clock,
+	}
+}
+
+// ImportTimeBlocks creates or updates one time block per imported UID in a single transaction. Re-importing a
+// file updates the time blocks created before; canceled imported events cancel them.
+func (s *TimeBlockImportService) ImportTimeBlocks(ctx context.Context, command ImportTimeBlocksCommand) ([]TimeBlockImportResult, error) {
+	calendarID, err := domain.NormalizeCalendarID(command.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+	reason := command.Reason
+	if reason == "" {
+		reason = defaultImportedTimeBlockReason
+	}
+	now := s.clock.Now()
+	results := make([]TimeBlockImportResult, 0, len(command.TimeBlocks))
+	if err := s.repository.Tx(ctx, func(ctx context.Context) error {
+		if err := ensureCalendarAcceptsEvents(ctx, s.repository, calendarID); err != nil {
+			return err
+		}
+		for _, imported := range command.TimeBlocks {
+			result, err := s.importTimeBlock(ctx, calendarID, reason, imported, now)
+			if err != nil {
+				return fmt.Errorf("import %s: %w", imported.UID, err)
+			}
+			results = append(results, result)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *TimeBlockImportService) importTimeBlock(ctx context.Context, calendarID string, reason string, imported ImportedTimeBlock, now time.Time) (TimeBlockImportResult, error) {
+	if imported.UID == "" {
+		return TimeBlockImportResult{}, domain.ErrMissingRequiredData
+	}
+	result := TimeBlockImportResult{
+		UID:             imported.UID,
+		CalendarEventID: uuid.NewSHA1(timeBlockImportNamespace, []byte(calendarID+"/"+imported.UID)).String(),
+	}
+	desired, err := s.timeBlock(result.CalendarEventID, calendarID, reason, imported, now)
+	if err != nil {
+		return TimeBlockImportResult{}, err
+	}
+	existing, err := s.repository.FindCalendarEvent(ctx, result.CalendarEventID)
+	if err != nil {
+		return TimeBlockImportResult{}, err
+	}
+	switch {
+	case existing == nil && imported.Canceled:
+		result.Status = TimeBlockImportSkipped
+		return result, nil
+	case existing == nil:
+		result.Status = TimeBlockImportCreated
+		existing = &desired
+	case existing.Type != domain.CalendarEventTypeTimeBlock:
+		return TimeBlockImportResult{}, domain.ErrInvalidEventDetail
+	case existing.IsCanceled():
+		result.Status = TimeBlockImportCanceled
+		return result, nil
+	case imported.Canceled:
+		result.Status = TimeBlockImportCanceled
+		existing.Cancel(domain.CancelReasonDeleted, now)
+	case sameImportedTimeBlock(*existing, desired):
+		result.Status = TimeBlockImportUnchanged
+	default:
+		result.Status = TimeBlockImportUpdated
+		if err := applyCalendarEventChanges(existing, CalendarEventChanges{
+			TimeRange:   &TimeRangeUpdate{Start: desired.Range.Start, End: desired.Range.End, Timezone: desired.Range.Timezone, AllDay: desired.Range.AllDay},
+			Title:       &desired.Title,
+			Description: &desired.Description,
+			Recurrence:  &desired.Recurrence,
+		}, now); err != nil {
+			return TimeBlockImportResult{}, err
+		}
+		if err := existing.ChangeTimeBlockReason(reason, now); err != nil {
+			return TimeBlockImportResult{}, err
+		}
+	}
+	if result.Status != TimeBlockImportUnchanged {
+		if err := s.repository.SaveCalendarEvent(ctx, existing); err != nil {
+			return TimeBlockImportResult{}, err
+		}
+	}
+	result.Conflicts, err = s.conflicts.find(ctx, *existing)
+	if err != nil {
+		return TimeBlockImportResult{}, err
+	}
+	return result, nil
+}
+
+func (s *TimeBlockImportService) timeBlock(id string, calendarID string, reason string, imported ImportedTimeBlock, now time.Time) (domain.CalendarEvent, error) {
+	start, end, timezone, exceptionDates := imported.Start, imported.End, imported.Timezone, imported.ExceptionDates
+	if timezone == "" {
+		start, end, timezone = inLocation(start, s.location), inLocation(end, s.location), s.location.String()
+		exceptionDates = make([]time.Time, 0, len(imported.ExceptionDates))
+		for _, exceptionDate := range imported.ExceptionDates {
+			exceptionDates = append(exceptionDates, inLocation(exceptionDate, s.location))
+		}
+	}
+	eventRange, err := domain.NewTimeRange(start, end, timezone, imported.AllDay)
+	if err != nil {
+		return domain.CalendarEvent{}, err
+	}
+	var recurrence *domain.Recurrence
+	if imported.RecurrenceRule != "" {
+		parsed, err := domain.NewRecurrence(imported.RecurrenceRule, exceptionDates)
+		if err != nil {
+			return domain.CalendarEvent{}, err
+		}
+		recurrence = &parsed
+	}
+	return domain.NewTimeBlockCalendarEvent(domain.TimeBlockEventParams{
+		EventID:     id,
+		CalendarID:  calendarID,
+		Range:       eventRange,
+		Title:       imported.Title,
+		Description: imported.Description,
+		Reason:      reason,
+		Recurrence:  recurrence,
+		Now:         now,
+	})
+}
+
+// inLocation reads the wall clock of a floating time in location.
+func inLocation(floating time.Time, location *time.Location) time.Time {
+	return time.Date(floating.Year(), floating.Month(), floating.Day(), floating.Hour(), floating.Minute(), floating.Second(), floating.Nanosecond(), location)
+}
+
+func sameImportedTimeBlock(existing domain.CalendarEvent, desired domain.CalendarEvent) bool {
+	existingBlock, _ := existing.Detail.(domain.TimeBlock)
+	desiredBlock, _ := desired.Detail.(domain.TimeBlock)
+	return existing.Range.Equals(desired.Range) &&
+		existing.Title == desired.Title &&
+		existing.Description == desired.Description &&
+		existingBlock.Reason == desiredBlock.Reason &&
+		sameRecurrence(existing.Recurrence, desired.Recurrence)
+}
+
+func sameRecurrence(left *domain.Recurrence, right *domain.Recurrence) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+	if left.Rule.String() != right.Rule.String() || len(left.ExceptionDates) != len(right.ExceptionDates) {
+		return false
+	}
+	for index := range left.ExceptionDates {
+		if !left.ExceptionDates[index].Equal(right.ExceptionDates[index]) {
+			return false
+		}
+	}
+	return true
+}
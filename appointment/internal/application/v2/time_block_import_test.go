@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestImportTimeBlocksIsIdempotentByUID(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	rome, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository := &timeBlockImportRepositoryStub{events: map[string]*domain.CalendarEvent{}}
+	appointment := mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "appointment-1", time.Date(2026, 9, 1, 11, 0, 0, 0, rome), "Europe/Rome", now)
+	repository.events[appointment.ID] = appointment
+	service := NewTimeBlockImportService(repository, rome, clockStub{now: now})
+	imported := ImportedTimeBlock{
+		UID:   "holiday-1@example.com",
+		Start: time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC),
+		Title: "Dentist",
+	}
+	command := ImportTimeBlocksCommand{TimeBlocks: []ImportedTimeBlock{imported}}
+
+	results, err := service.ImportTimeBlocks(context.Background(), command)
+	if err != nil {
+		t.Fatalf("ImportTimeBlocks() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Status != TimeBlockImportCreated || len(results[0].Conflicts) != 1 || results[0].Conflicts[0].CalendarEventID != appointment.ID {
+		t.Fatalf("results = %#v, want a created time block conflicting with the appointment", results)
+	}
+	created := repository.events[results[0].CalendarEventID]
+	if created == nil || !created.Range.Start.Equal(time.Date(2026, 9, 1, 10, 0, 0, 0, rome)) || created.Range.Timezone != "Europe/Rome" {
+		t.Fatalf("created = %#v, want the floating time placed in Europe/Rome", created)
+	}
+	if block, _ := created.Detail.(domain.TimeBlock); block.Reason != defaultImportedTimeBlockReason {
+		t.Fatalf("reason = %q, want %q", block.Reason, defaultImportedTimeBlockReason)
+	}
+
+	saves := repository.saves
+	results, err = service.ImportTimeBlocks(context.Background(), command)
+	if err != nil || results[0].Status != TimeBlockImportUnchanged || repository.saves != saves {
+		t.Fatalf("re-import results = %#v, error = %v, saves = %d, want unchanged without writes", results, err, repository.saves-saves)
+	}
+
+	command.TimeBlocks[0].Title = "Dentist appointment"
+	results, err = service.ImportTimeBlocks(context.Background(), command)
+	if err != nil || results[0].Status != TimeBlockImportUpdated || repository.events[results[0].CalendarEventID].Title != "Dentist appointment" {
+		t.Fatalf("update results = %#v, error = %v", results, err)
+	}
+	if len(repository.events) != 2 {
+		t.Fatalf("events = %d, want the re-import to update the same time block", len(repository.events))
+	}
+
+	command.TimeBlocks[0].Canceled = true
+	results, err = service.ImportTimeBlocks(context.Background(), command)
+	if err != nil || results[0].Status != TimeBlockImportCanceled || !repository.events[results[0].CalendarEventID].IsCanceled() {
+		t.Fatalf("cancel results = %#v, error = %v", results, err)
+	}
+}
+
+func TestImportTimeBlocksSkipsCanceledEventsNeverImported(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	repository := &timeBlockImportRepositoryStub{events: map[string]*domain.CalendarEvent{}}
+	service := NewTimeBlockImportService(repository, time.UTC, clockStub{now: now})
+
+	results, err := service.ImportTimeBlocks(context.Background(), ImportTimeBlocksCommand{TimeBlocks: []ImportedTimeBlock{{
+		UID:      "holiday-1@example.com",
+		Start:    time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC),
+		AllDay:   true,
+		Canceled: true,
+	}}})
+	if err != nil {
+		t.Fatalf("ImportTimeBlocks() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Status != TimeBlockImportSkipped || repository.saves != 0 {
+		t.Fatalf("results = %#v, saves = %d", results, repository.saves)
+	}
+}
+
+type timeBlockImportRepositoryStub struct {
+	repositoryStub
+	events map[string]*domain.CalendarEvent
+	saves  int
+}
+
+func (r *timeBlockImportRepositoryStub) FindCalendarEvent(_ context.Context, calendarEventID string) (*domain.CalendarEvent, error) {
+	event, ok := r.events[calendarEventID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *event
+	return &copied, nil
+}
+
+func (r *timeBlockImportRepositoryStub) SaveCalendarEvent(_ context.Context, event *domain.CalendarEvent) error {
+	r.saves++
+	r.events[event.ID] = event
+	return nil
+}
+
+func (r *timeBlockImportRepositoryStub) SearchCalendarEventViews(context.Context, ListCalendarEventsQuery) ([]CalendarEventView, error) {
+	views := make([]CalendarEventView, 0, len(r.events))
+	for _, event := range r.events {
+		views = append(views, CalendarEventView{Event: *event})
+	}
+	return views, nil
+}
@@ -1,8 +1,123 @@
 package v2
 
-import domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
 
 type CalendarEventView struct {
-	Event    domain.CalendarEvent
-	Reminder *domain.AppointmentReminder
+	Event     domain.CalendarEvent
+	Reminders []domain.AppointmentReminder
+	// Notifications are the tracked customer notifications of an appointment, oldest first.
+	Notifications []domain.AppointmentNotification
+}
+
+// Reminder returns the reminder at position, or nil when the event has none there.
+func (view *CalendarEventView) Reminder(position int) *domain.AppointmentReminder {
+	for index := range view.Reminders {
+		if view.Reminders[index].Position == position {
+			return &view.Reminders[index]
+		}
+	}
+	return nil
+}
+
+const maxCalendarEventPageSize = 500
+
+// maxCalendarEventPageRange caps the time window of a paged listing, since every page of a window loads all
+// of its occurrences.
+const maxCalendarEventPageRange = 31 * 24 * time.Hour
+
+type CalendarEventPage struct {
+	Views []CalendarEventView
+	Next  *CalendarEventCursor
+}
+
+// newCalendarEventPage keeps the first pageSize views, with a cursor past the last one when views has more.
+// A pageSize below one keeps every view.
+func newCalendarEventPage(views []CalendarEventView, pageSize int) CalendarEventPage {
+	if pageSize < 1 || len(views) <= pageSize {
+		return CalendarEventPage{Views: views}
+	}
+	last := views[pageSize-1].Event
+	return CalendarEventPage{
+		Views: views[:pageSize],
+		Next:  &CalendarEventCursor{Start: last.Range.Start, End: last.Range.End, ID: last.ID},
+	}
+}
+
+// sortCalendarEventViews orders the views by start, end and id like the stored listing, from the latest
+// one when descending.
+func sortCalendarEventViews(views []CalendarEventView, descending bool) {
+	sort.SliceStable(views, func(i, j int) bool {
+		if descending {
+			return compareCalendarEventPosition(views[j].Event, calendarEventCursorOf(views[i].Event)) < 0
+		}
+		return compareCalendarEventPosition(views[i].Event, calendarEventCursorOf(views[j].Event)) < 0
+	})
+}
+
+// countCalendarEventViewsUpTo counts the leading views, sorted by sortCalendarEventViews, that do not come
+// after the cursor.
+func countCalendarEventViewsUpTo(views []CalendarEventView, cursor CalendarEventCursor, descending bool) int {
+	return sort.Search(len(views), func(index int) bool {
+		position := compareCalendarEventPosition(views[index].Event, cursor)
+		if descending {
+			return position < 0
+		}
+		return position > 0
+	})
+}
+
+func calendarEventCursorOf(event domain.CalendarEvent) CalendarEventCursor {
+	return CalendarEventCursor{Start: event.Range.Start, End: event.Range.End, ID: event.ID}
+}
+
+// compareCalendarEventPosition compares the start, end and id of event with the cursor.
+func compareCalendarEventPosition(event domain.CalendarEvent, cursor CalendarEventCursor) int {
+	if compared := event.Range.Start.Compare(cursor.Start); compared != 0 {
+		return compared
+	}
+	if compared := event.Range.End.Compare(cursor.End); compared != 0 {
+		return compared
+	}
+	return strings.Compare(event.ID, cursor.ID)
+}
+
+// searchCalendarEventOccurrences searches the events and expands the recurring ones inside the query time window.
+func searchCalendarEventOccurrences(ctx context.Context, events CalendarEventReadRepository, query ListCalendarEventsQuery) ([]CalendarEventView, error) {
+	views, err := events.SearchCalendarEventViews(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return expandCalendarEventOccurrences(views, query), nil
+}
+
+// expandCalendarEventOccurrences replaces each recurring event with its occurrences inside the query time
+// window, ordered by start in the query direction.
+func expandCalendarEventOccurrences(views []CalendarEventView, query ListCalendarEventsQuery) []CalendarEventView {
+	if query.Start == nil || query.End == nil {
+		return views
+	}
+	expanded := make([]CalendarEventView, 0, len(views))
+	recurring := false
+	for _, view := range views {
+		recurring = recurring || view.Event.IsRecurring()
+		for _, occurrence := range view.Event.Occurrences(*query.Start, *query.End) {
+			expanded = append(expanded, CalendarEventView{Event: occurrence, Reminders: view.Reminders, Notifications: view.Notifications})
+		}
+	}
+	if recurring {
+		sort.SliceStable(expanded, func(i, j int) bool {
+			if query.Descending {
+				return expanded[i].Event.Range.Start.After(expanded[j].Event.Range.Start)
+			}
+			return expanded[i].Event.Range.Start.Before(expanded[j].Event.Range.Start)
+		})
+	}
+	return expanded
 }
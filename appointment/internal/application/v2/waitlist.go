@@ -0,0 +1,247 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+var ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+
+type ListWaitlistEntriesQuery struct {
+	CalendarIDs []string
+	// Statuses filters the entries; empty means waiting and offered entries.
+	Statuses []domain.WaitlistEntryStatus
+}
+
+type WaitlistRepository interface {
+	CalendarReader
+	CalendarEventReadRepository
+	Tx(ctx context.Context, atomicFn func(context.Context) error) error
+	NextWaitlistEntryID() string
+	FindWaitlistEntry(ctx context.Context, entryID string) (*domain.WaitlistEntry, error)
+	// SearchWaitlistEntries returns the matching entries oldest first, which is the order offers follow.
+	SearchWaitlistEntries(ctx context.Context, query ListWaitlistEntriesQuery) ([]domain.WaitlistEntry, error)
+	SaveWaitlistEntry(ctx context.Context, entry domain.WaitlistEntry) error
+}
+
+type WaitlistOfferSender interface {
+	SendWaitlistOffer(ctx context.Context, entry domain.WaitlistEntry, idempotencyKey string) (string, error)
+}
+
+type WaitlistOfferScheduler interface {
+	ScheduleWaitlistOfferExpiry(ctx context.Context, entryID string, expiresAt time.Time) error
+}
+
+type AddWaitlistEntryCommand struct {
+	CalendarID string
+	CustomerID string
+	Services   []domain.ServiceItem
+	Start      time.Time
+	End        time.Time
+	Timezone   string
+}
+
+// WaitlistService keeps the customers waiting for a free slot and offers them the time freed by canceled
+// events. Offers go to one entry at a time, oldest first; when an offer expires the next entry gets it.
+type WaitlistService struct {
+	repository WaitlistRepository
+	customers  CustomerResolver
+	offers     WaitlistOfferSender
+	scheduler  WaitlistOfferScheduler
+	clock      Clock
+	offerTTL   time.Duration
+}
+
+func NewWaitlistService(repository WaitlistRepository, customers CustomerResolver, offers WaitlistOfferSender, scheduler WaitlistOfferScheduler, clock Clock, offerTTL time.Duration) *WaitlistService {
+	return &WaitlistService{
+		repository: repository,
+		customers:  customers,
+		offers:     offers,
+		scheduler:  scheduler,
+		clock:      clock,
+		offerTTL:   offerTTL,
+	}
+}
+
+func (s *WaitlistService) AddEntry(ctx context.Context, command AddWaitlistEntryCommand) (*domain.WaitlistEntry, error) {
+	window, err := domain.NewTimeRange(command.Start, command.End, command.Timezone, false)
+	if err != nil {
+		return nil, err
+	}
+	if s.customers == nil {
+		return nil, domain.ErrMissingRequiredData
+	}
+	customer, err := s.customers.ResolveCustomer(ctx, command.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := domain.NewWaitlistEntry(domain.WaitlistEntryParams{
+		EntryID:    s.repository.NextWaitlistEntryID(),
+		CalendarID: command.CalendarID,
+		Customer:   customer,
+		Services:   command.Services,
+		Window:     window,
+		Now:        s.clock.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.Tx(ctx, func(ctx context.Context) error {
+		if err := ensureCalendarAcceptsEvents(ctx, s.repository, entry.CalendarID); err != nil {
+			return err
+		}
+		return s.repository.SaveWaitlistEntry(ctx, entry)
+	}); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (s *WaitlistService) ListEntries(ctx context.Context, query ListWaitlistEntriesQuery) ([]domain.WaitlistEntry, error) {
+	if len(query.Statuses) == 0 {
+		query.Statuses = []domain.WaitlistEntryStatus{domain.WaitlistEntryWaiting, domain.WaitlistEntryOffered}
+	}
+	return s.repository.SearchWaitlistEntries(ctx, query)
+}
+
+// RemoveEntry takes the customer off the waitlist, for example once the offered slot is booked.
+func (s *WaitlistService) RemoveEntry(ctx context.Context, entryID string) error {
+	return s.repository.Tx(ctx, func(ctx context.Context) error {
+		entry, err := s.repository.FindWaitlistEntry(ctx, entryID)
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return ErrWaitlistEntryNotFound
+		}
+		entry.Remove(s.clock.Now())
+		return s.repository.SaveWaitlistEntry(ctx, *entry)
+	})
+}
+
+// Handle offers the time freed by a canceled calendar event; other lifecycle events are ignored.
+func (s *WaitlistService) Handle(ctx context.Context, eventType string, calendarEventID string) error {
+	if eventType != "CalendarEventCanceled" {
+		return nil
+	}
+	return s.repository.Tx(ctx, func(ctx context.Context) error {
+		return s.offerFreedTime(ctx, calendarEventID)
+	})
+}
+
+// ExpireOffer returns an entry whose offer expired to the queue and offers the same time to the next entry.
+func (s *WaitlistService) ExpireOffer(ctx context.Context, entryID string) error {
+	return s.repository.Tx(ctx, func(ctx context.Context) error {
+		entry, err := s.repository.FindWaitlistEntry(ctx, entryID)
+		if err != nil || entry == nil {
+			return err
+		}
+		if !entry.ExpireOffer(s.clock.Now()) {
+			return nil
+		}
+		if err := s.repository.SaveWaitlistEntry(ctx, *entry); err != nil {
+			return err
+		}
+		return s.offerFreedTime(ctx, entry.Offer.CalendarEventID)
+	})
+}
+
+// offerFreedTime offers the time of a canceled, non-recurring event to the oldest waiting entry it fits.
+// Nothing is offered while another entry holds an offer for the same event, and the time of the open offers
+// of other events counts as busy, so overlapping cancellations never offer the same time twice.
+func (s *WaitlistService) offerFreedTime(ctx context.Context, calendarEventID string) error {
+	view, err := s.repository.FindCalendarEventView(ctx, calendarEventID)
+	if err != nil || view == nil {
+		return err
+	}
+	event := view.Event
+	if !event.IsCanceled() || event.Recurrence != nil || !domain.CalendarEventTypeAppointment.ConflictsWith(event.Type) {
+		return nil
+	}
+	now := s.clock.Now()
+	if !event.Range.End.After(now) {
+		return nil
+	}
+	freed := event.Range
+	freed.Start = laterTime(freed.Start, now)
+	entries, err := s.repository.SearchWaitlistEntries(ctx, ListWaitlistEntriesQuery{
+		CalendarIDs: []string{event.CalendarID},
+		Statuses:    []domain.WaitlistEntryStatus{domain.WaitlistEntryWaiting, domain.WaitlistEntryOffered},
+	})
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Status == domain.WaitlistEntryOffered && entry.Offer.CalendarEventID == event.ID {
+			return nil
+		}
+	}
+	busy, err := appointmentBusyRanges(ctx, s.repository, event.CalendarID, freed.Start, freed.End)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if offer := entry.OpenOffer(now); offer != nil {
+			busy = append(busy, domain.TimeRange{Start: offer.Start, End: offer.End})
+		}
+	}
+	sort.Slice(busy, func(i, j int) bool { return busy[i].Start.Before(busy[j].Start) })
+	free := freeRanges(freed, busy)
+	for _, entry := range entries {
+		if entry.Status != domain.WaitlistEntryWaiting || entry.WasOffered(event.ID) {
+			continue
+		}
+		for _, gap := range free {
+			slot, ok := entry.Fit(gap)
+			if !ok {
+				continue
+			}
+			return s.offer(ctx, entry, event.ID, slot, now)
+		}
+	}
+	return nil
+}
+
+func (s *WaitlistService) offer(ctx context.Context, entry domain.WaitlistEntry, calendarEventID string, slot domain.TimeRange, now time.Time) error {
+	if err := entry.OfferSlot(calendarEventID, slot, s.offerTTL, now); err != nil {
+		return err
+	}
+	if err := s.repository.SaveWaitlistEntry(ctx, entry); err != nil {
+		return err
+	}
+	if _, err := s.offers.SendWaitlistOffer(ctx, entry, fmt.Sprintf("waitlist:%s:offer:%s", entry.ID, calendarEventID)); err != nil {
+		return err
+	}
+	return s.scheduler.ScheduleWaitlistOfferExpiry(ctx, entry.ID, entry.Offer.ExpiresAt)
+}
+
+// freeRanges returns the parts of window not covered by busy, which must be sorted by start.
+func freeRanges(window domain.TimeRange, busy []domain.TimeRange) []domain.TimeRange {
+	var free []domain.TimeRange
+	start := window.Start
+	for _, eventRange := range busy {
+		if !eventRange.Start.Before(window.End) {
+			break
+		}
+		if eventRange.Start.After(start) {
+			free = append(free, domain.TimeRange{Start: start, End: eventRange.Start, Timezone: window.Timezone})
+		}
+		start = laterTime(start, eventRange.End)
+	}
+	if window.End.After(start) {
+		free = append(free, domain.TimeRange{Start: start, End: window.End, Timezone: window.Timezone})
+	}
+	return free
+}
+
+func laterTime(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
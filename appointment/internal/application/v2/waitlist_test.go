@@ -0,0 +1,171 @@
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestWaitlistOffersFreedTimeFirstComeFirstServed(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	canceled := mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "appointment-1", now.Add(2*time.Hour), "Europe/Rome", now.Add(-24*time.Hour))
+	canceled.Cancel(domain.CancelReasonCustomer, now)
+	repository := &waitlistRepositoryStub{events: map[string]domain.CalendarEvent{canceled.ID: *canceled}}
+	repository.entries = []domain.WaitlistEntry{
+		mustWaitlistEntry(t, "entry-1", now.Add(6*time.Hour), now.Add(8*time.Hour), now.Add(-3*time.Hour)),
+		mustWaitlistEntry(t, "entry-2", now.Add(time.Hour), now.Add(4*time.Hour), now.Add(-2*time.Hour)),
+		mustWaitlistEntry(t, "entry-3", now.Add(time.Hour), now.Add(4*time.Hour), now.Add(-time.Hour)),
+	}
+	offers := &waitlistOfferSenderStub{}
+	scheduler := &waitlistOfferSchedulerStub{}
+	service := NewWaitlistService(repository, nil, offers, scheduler, clockStub{now: now}, 2*time.Hour)
+
+	if err := service.Handle(context.Background(), "CalendarEventCanceled", canceled.ID); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	offered := repository.entries[1]
+	if offered.Status != domain.WaitlistEntryOffered || !offered.Offer.Start.Equal(now.Add(2*time.Hour)) || !offered.Offer.End.Equal(now.Add(3*time.Hour)) {
+		t.Fatalf("entry-2 = %#v, want the freed hour offered to the oldest entry it fits", offered)
+	}
+	if len(offers.sent) != 1 || offers.sent[0] != "waitlist:entry-2:offer:"+canceled.ID {
+		t.Fatalf("sent offers = %v", offers.sent)
+	}
+	if len(scheduler.expiries) != 1 || !scheduler.expiries["entry-2"].Equal(now.Add(2*time.Hour)) {
+		t.Fatalf("scheduled expiries = %v", scheduler.expiries)
+	}
+
+	if err := service.Handle(context.Background(), "CalendarEventCanceled", canceled.ID); err != nil || len(offers.sent) != 1 {
+		t.Fatalf("redelivered Handle() error = %v, sent = %v, want no second offer", err, offers.sent)
+	}
+
+	later := NewWaitlistService(repository, nil, offers, scheduler, clockStub{now: now.Add(2 * time.Hour)}, 2*time.Hour)
+	if err := later.ExpireOffer(context.Background(), "entry-2"); err != nil {
+		t.Fatalf("ExpireOffer() error = %v", err)
+	}
+	if repository.entries[1].Status != domain.WaitlistEntryWaiting || repository.entries[2].Status != domain.WaitlistEntryOffered {
+		t.Fatalf("entries = %#v, want the offer moved to entry-3", repository.entries)
+	}
+}
+
+func TestWaitlistDoesNotOfferTimeHeldByAnOpenOffer(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	first := mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "appointment-1", now.Add(2*time.Hour), "Europe/Rome", now.Add(-24*time.Hour))
+	first.Cancel(domain.CancelReasonCustomer, now)
+	second := mustCalendarEvent(t, domain.CalendarEventTypeAppointment, "appointment-2", now.Add(2*time.Hour), "Europe/Rome", now.Add(-24*time.Hour))
+	second.Cancel(domain.CancelReasonCustomer, now)
+	repository := &waitlistRepositoryStub{events: map[string]domain.CalendarEvent{first.ID: *first, second.ID: *second}}
+	repository.entries = []domain.WaitlistEntry{
+		mustWaitlistEntry(t, "entry-1", now.Add(time.Hour), now.Add(4*time.Hour), now.Add(-2*time.Hour)),
+		mustWaitlistEntry(t, "entry-2", now.Add(time.Hour), now.Add(4*time.Hour), now.Add(-time.Hour)),
+	}
+	offers := &waitlistOfferSenderStub{}
+	service := NewWaitlistService(repository, nil, offers, &waitlistOfferSchedulerStub{}, clockStub{now: now}, 2*time.Hour)
+
+	if err := service.Handle(context.Background(), "CalendarEventCanceled", first.ID); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if err := service.Handle(context.Background(), "CalendarEventCanceled", second.ID); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if len(offers.sent) != 1 || repository.entries[1].Status != domain.WaitlistEntryWaiting {
+		t.Fatalf("sent offers = %v, entry-2 = %#v, want the time held by entry-1 not offered again", offers.sent, repository.entries[1])
+	}
+}
+
+func mustWaitlistEntry(t *testing.T, id string, start time.Time, end time.Time, now time.Time) domain.WaitlistEntry {
+	t.Helper()
+	window, err := domain.NewTimeRange(start, end, "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := domain.NewCatalogServiceItem("service-1", "Haircut", time.Hour, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := domain.NewWaitlistEntry(domain.WaitlistEntryParams{
+		EntryID:  id,
+		Customer: domain.CustomerRef{ID: "customer-" + id, DisplayName: "Jane Doe"},
+		Services: []domain.ServiceItem{service},
+		Window:   window,
+		Now:      now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entry
+}
+
+type waitlistRepositoryStub struct {
+	repositoryStub
+	events  map[string]domain.CalendarEvent
+	entries []domain.WaitlistEntry
+}
+
+func (r *waitlistRepositoryStub) FindCalendarEventView(_ context.Context, calendarEventID string) (*CalendarEventView, error) {
+	event, ok := r.events[calendarEventID]
+	if !ok {
+		return nil, nil
+	}
+	return &CalendarEventView{Event: event}, nil
+}
+
+func (r *waitlistRepositoryStub) SearchCalendarEventViews(context.Context, ListCalendarEventsQuery) ([]CalendarEventView, error) {
+	views := []CalendarEventView{}
+	for _, event := range r.events {
+		if !event.IsCanceled() {
+			views = append(views, CalendarEventView{Event: event})
+		}
+	}
+	return views, nil
+}
+
+func (r *waitlistRepositoryStub) NextWaitlistEntryID() string {
+	return "entry-new"
+}
+
+func (r *waitlistRepositoryStub) FindWaitlistEntry(_ context.Context, entryID string) (*domain.WaitlistEntry, error) {
+	for _, entry := range r.entries {
+		if entry.ID == entryID {
+			return &entry, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *waitlistRepositoryStub) SearchWaitlistEntries(context.Context, ListWaitlistEntriesQuery) ([]domain.WaitlistEntry, error) {
+	return append([]domain.WaitlistEntry(nil), r.entries...), nil
+}
+
+func (r *waitlistRepositoryStub) SaveWaitlistEntry(_ context.Context, entry domain.WaitlistEntry) error {
+	for index := range r.entries {
+		if r.entries[index].ID == entry.ID {
+			r.entries[index] = entry
+			return nil
+		}
+	}
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+type waitlistOfferSenderStub struct {
+	sent []string
+}
+
+func (s *waitlistOfferSenderStub) SendWaitlistOffer(_ context.Context, _ domain.WaitlistEntry, idempotencyKey string) (string, error) {
+	s.sent = append(s.sent, idempotencyKey)
+	return idempotencyKey, nil
+}
+
+type waitlistOfferSchedulerStub struct {
+	expiries map[string]time.Time
+}
+
+func (s *waitlistOfferSchedulerStub) ScheduleWaitlistOfferExpiry(_ context.Context, entryID string, expiresAt time.Time) error {
+	if s.expiries == nil {
+		s.expiries = map[string]time.Time{}
+	}
+	s.expiries[entryID] = expiresAt
+	return nil
+}
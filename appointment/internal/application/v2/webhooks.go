@@ -0,0 +1,244 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	// ErrWebhookDeliveryFailed reports an attempt the subscriber did not accept; the delivery is retried.
+	ErrWebhookDeliveryFailed = errors.New("webhook delivery failed")
+)
+
+const (
+	defaultWebhookDeliveriesLimit = 50
+	maxWebhookDeliveriesLimit     = 200
+)
+
+type WebhookRepository interface {
+	Tx(ctx context.Context, atomicFn func(context.Context) error) error
+	NextWebhookSubscriptionID() string
+	NextWebhookDeliveryID() string
+	FindWebhookSubscription(ctx context.Context, subscriptionID string) (*domain.WebhookSubscription, error)
+	// ListWebhookSubscriptions returns the subscriptions oldest first.
+	ListWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+	SaveWebhookSubscription(ctx context.Context, subscription domain.WebhookSubscription) error
+	// DeleteWebhookSubscription deletes the subscription with its deliveries and reports whether it existed.
+	DeleteWebhookSubscription(ctx context.Context, subscriptionID string) (bool, error)
+	FindWebhookDelivery(ctx context.Context, deliveryID string) (*domain.WebhookDelivery, error)
+	// ListWebhookDeliveries returns up to limit deliveries of the subscription, newest first.
+	ListWebhookDeliveries(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error)
+	SaveWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error
+}
+
+// WebhookSender posts a delivery to the subscription URL and returns the HTTP status of the response.
+type WebhookSender interface {
+	SendWebhook(ctx context.Context, subscription domain.WebhookSubscription, delivery domain.WebhookDelivery) (int, error)
+}
+
+type WebhookDeliveryScheduler interface {
+	ScheduleWebhookDelivery(ctx context.Context, deliveryID string) error
+}
+
+type CreateWebhookSubscriptionCommand struct {
+	URL        string
+	Secret     string
+	EventTypes []string
+}
+
+// UpdateWebhookSubscriptionCommand changes the set fields of a subscription.
+type UpdateWebhookSubscriptionCommand struct {
+	SubscriptionID string
+	URL            *string
+	Secret         *string
+	EventTypes     *[]string
+	Enabled        *bool
+}
+
+// WebhookService manages the webhook subscriptions and delivers them the calendar lifecycle events. Each
+// delivery is stored before it is sent, so the log keeps every attempt and its outcome.
+type WebhookService struct {
+	repository WebhookRepository
+	sender     WebhookSender
+	scheduler  WebhookDeliveryScheduler
+	clock      Clock
+}
+
+func NewWebhookService(repository WebhookRepository, sender WebhookSender, scheduler WebhookDeliveryScheduler, clock Clock) *WebhookService {
+	return &WebhookService{
+		repository: repository,
+		sender:     sender,
+		scheduler:  scheduler,
+		clock:      clock,
+	}
+}
+
+func (s *WebhookService) CreateSubscription(ctx context.Context, command CreateWebhookSubscriptionCommand) (*domain.WebhookSubscription, error) {
+	subscription, err := domain.NewWebhookSubscription(domain.WebhookSubscriptionParams{
+		SubscriptionID: s.repository.NextWebhookSubscriptionID(),
+		URL:            command.URL,
+		Secret:         command.Secret,
+		EventTypes:     command.EventTypes,
+		Now:            s.clock.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.SaveWebhookSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (s *WebhookService) GetSubscription(ctx context.Context, subscriptionID string) (*domain.WebhookSubscription, error) {
+	subscription, err := s.repository.FindWebhookSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, ErrWebhookSubscriptionNotFound
+	}
+	return subscription, nil
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return s.repository.ListWebhookSubscriptions(ctx)
+}
+
+func (s *WebhookService) UpdateSubscription(ctx context.Context, command UpdateWebhookSubscriptionCommand) (*domain.WebhookSubscription, error) {
+	var updated domain.WebhookSubscription
+	if err := s.repository.Tx(ctx, func(ctx context.Context) error {
+		subscription, err := s.GetSubscription(ctx, command.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		now := s.clock.Now()
+		if command.URL != nil {
+			if err := subscription.ChangeURL(*command.URL, now); err != nil {
+				return err
+			}
+		}
+		if command.Secret != nil {
+			if err := subscription.ChangeSecret(*command.Secret, now); err != nil {
+				return err
+			}
+		}
+		if command.EventTypes != nil {
+			if err := subscription.ChangeEventTypes(*command.EventTypes, now); err != nil {
+				return err
+			}
+		}
+		if command.Enabled != nil {
+			subscription.SetEnabled(*command.Enabled, now)
+		}
+		updated = *subscription
+		return s.repository.SaveWebhookSubscription(ctx, updated)
+	}); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteSubscription stops the deliveries to the subscription and drops its delivery log.
+func (s *WebhookService) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	deleted, err := s.repository.DeleteWebhookSubscription(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+// ListDeliveries returns the latest deliveries of a subscription, newest first. A zero limit lists the
+// default number of deliveries; larger limits are capped.
+func (s *WebhookService) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error) {
+	if limit < 0 {
+		return nil, ErrInvalidPageRequest
+	}
+	if limit == 0 {
+		limit = defaultWebhookDeliveriesLimit
+	}
+	limit = min(limit, maxWebhookDeliveriesLimit)
+	if _, err := s.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return s.repository.ListWebhookDeliveries(ctx, subscriptionID, limit)
+}
+
+// HandleCloudEvent stores a delivery of the lifecycle event for each enabled subscription accepting its type
+// and schedules the deliveries. The body is cloudEvent, the CloudEvent published when the calendar event was
+// saved, so subscribers can match it by id with the events of the public exchange.
+func (s *WebhookService) HandleCloudEvent(ctx context.Context, eventType string, calendarEventID string, cloudEvent []byte) error {
+	return s.repository.Tx(ctx, func(ctx context.Context) error {
+		subscriptions, err := s.repository.ListWebhookSubscriptions(ctx)
+		if err != nil {
+			return err
+		}
+		now := s.clock.Now()
+		for _, subscription := range subscriptions {
+			if !subscription.Accepts(eventType) {
+				continue
+			}
+			delivery, err := domain.NewWebhookDelivery(domain.WebhookDeliveryParams{
+				DeliveryID:      s.repository.NextWebhookDeliveryID(),
+				SubscriptionID:  subscription.ID,
+				EventType:       eventType,
+				CalendarEventID: calendarEventID,
+				Payload:         cloudEvent,
+				Now:             now,
+			})
+			if err != nil {
+				return err
+			}
+			if err := s.repository.SaveWebhookDelivery(ctx, delivery); err != nil {
+				return err
+			}
+			if err := s.scheduler.ScheduleWebhookDelivery(ctx, delivery.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Deliver posts a pending delivery and records the attempt. A response outside 2xx or a transport error
+// returns ErrWebhookDeliveryFailed so the job is retried; on the last attempt the delivery is marked failed.
+// Deliveries to a disabled subscription fail without being sent.
+func (s *WebhookService) Deliver(ctx context.Context, deliveryID string, lastAttempt bool) error {
+	delivery, err := s.repository.FindWebhookDelivery(ctx, deliveryID)
+	if err != nil || delivery == nil || delivery.Status != domain.WebhookDeliveryPending {
+		return err
+	}
+	subscription, err := s.repository.FindWebhookSubscription(ctx, delivery.SubscriptionID)
+	if err != nil || subscription == nil {
+		return err
+	}
+	if !subscription.Enabled {
+		delivery.RecordFailure(0, "webhook subscription is disabled", true, s.clock.Now())
+		return s.repository.SaveWebhookDelivery(ctx, *delivery)
+	}
+	status, sendErr := s.sender.SendWebhook(ctx, *subscription, *delivery)
+	now := s.clock.Now()
+	switch {
+	case sendErr != nil:
+		delivery.RecordFailure(status, sendErr.Error(), lastAttempt, now)
+	case status < http.StatusOK || status >= http.StatusMultipleChoices:
+		delivery.RecordFailure(status, fmt.Sprintf("unexpected response status %d", status), lastAttempt, now)
+	default:
+		delivery.RecordSuccess(status, now)
+	}
+	if err := s.repository.SaveWebhookDelivery(ctx, *delivery); err != nil {
+		return err
+	}
+	if delivery.Status != domain.WebhookDeliveryDelivered {
+		return fmt.Errorf("%w: %s", ErrWebhookDeliveryFailed, delivery.LastError)
+	}
+	return nil
+}
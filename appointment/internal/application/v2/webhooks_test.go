@@ -0,0 +1,169 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestWebhookHandleCloudEventStoresADeliveryForEachAcceptingSubscription(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	repository := &webhookRepositoryStub{}
+	repository.subscriptions = []domain.WebhookSubscription{
+		mustWebhookSubscription(t, "subscription-all", nil, now),
+		mustWebhookSubscription(t, "subscription-canceled", []string{"CalendarEventCanceled"}, now),
+		mustWebhookSubscription(t, "subscription-rescheduled", []string{"CalendarEventRescheduled"}, now),
+	}
+	repository.subscriptions[0].SetEnabled(false, now)
+	scheduler := &webhookDeliverySchedulerStub{}
+	service := NewWebhookService(repository, nil, scheduler, clockStub{now: now})
+	cloudEvent := []byte(`{"id":"cloud-event-1","type":"beaesthetic.appointment.v1.CalendarEventCanceled"}`)
+
+	if err := service.HandleCloudEvent(context.Background(), "CalendarEventCanceled", "appointment-1", cloudEvent); err != nil {
+		t.Fatalf("HandleCloudEvent() error = %v", err)
+	}
+	if len(repository.deliveries) != 1 {
+		t.Fatalf("deliveries = %#v, want one for the enabled subscription accepting cancellations", repository.deliveries)
+	}
+	delivery := repository.deliveries[0]
+	if delivery.SubscriptionID != "subscription-canceled" || delivery.Status != domain.WebhookDeliveryPending || delivery.CalendarEventID != "appointment-1" || string(delivery.Payload) != string(cloudEvent) {
+		t.Fatalf("delivery = %#v", delivery)
+	}
+	if len(scheduler.scheduled) != 1 || scheduler.scheduled[0] != delivery.ID {
+		t.Fatalf("scheduled deliveries = %v", scheduler.scheduled)
+	}
+}
+
+func TestWebhookDeliverRetriesUntilTheLastAttempt(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	repository := &webhookRepositoryStub{subscriptions: []domain.WebhookSubscription{mustWebhookSubscription(t, "subscription-1", nil, now)}}
+	delivery, err := domain.NewWebhookDelivery(domain.WebhookDeliveryParams{
+		DeliveryID:      "delivery-1",
+		SubscriptionID:  "subscription-1",
+		EventType:       "CalendarEventCreated",
+		CalendarEventID: "event-1",
+		Payload:         []byte(`{}`),
+		Now:             now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository.deliveries = []domain.WebhookDelivery{delivery}
+	sender := &webhookSenderStub{status: http.StatusServiceUnavailable}
+	service := NewWebhookService(repository, sender, &webhookDeliverySchedulerStub{}, clockStub{now: now})
+
+	if err := service.Deliver(context.Background(), "delivery-1", false); !errors.Is(err, ErrWebhookDeliveryFailed) {
+		t.Fatalf("Deliver() error = %v, want ErrWebhookDeliveryFailed", err)
+	}
+	if got := repository.deliveries[0]; got.Status != domain.WebhookDeliveryPending || got.Attempts != 1 || got.LastResponseStatus != http.StatusServiceUnavailable {
+		t.Fatalf("delivery = %#v, want a pending delivery with the failed attempt", got)
+	}
+
+	if err := service.Deliver(context.Background(), "delivery-1", true); !errors.Is(err, ErrWebhookDeliveryFailed) {
+		t.Fatalf("last Deliver() error = %v, want ErrWebhookDeliveryFailed", err)
+	}
+	if got := repository.deliveries[0]; got.Status != domain.WebhookDeliveryFailed || got.Attempts != 2 {
+		t.Fatalf("delivery = %#v, want failed after the last attempt", got)
+	}
+	if err := service.Deliver(context.Background(), "delivery-1", true); err != nil || sender.calls != 2 {
+		t.Fatalf("Deliver() of a failed delivery error = %v, calls = %d, want it skipped", err, sender.calls)
+	}
+}
+
+func mustWebhookSubscription(t *testing.T, id string, eventTypes []string, now time.Time) domain.WebhookSubscription {
+	t.Helper()
+	subscription, err := domain.NewWebhookSubscription(domain.WebhookSubscriptionParams{
+		SubscriptionID: id,
+		URL:            "https://example.com/hooks",
+		Secret:         "0123456789abcdef",
+		EventTypes:     eventTypes,
+		Now:            now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return subscription
+}
+
+type webhookRepositoryStub struct {
+	repositoryStub
+	subscriptions []domain.WebhookSubscription
+	deliveries    []domain.WebhookDelivery
+}
+
+func (r *webhookRepositoryStub) NextWebhookSubscriptionID() string {
+	return "subscription-new"
+}
+
+func (r *webhookRepositoryStub) NextWebhookDeliveryID() string {
+	return "delivery-" + string(rune('a'+len(r.deliveries)))
+}
+
+func (r *webhookRepositoryStub) FindWebhookSubscription(_ context.Context, subscriptionID string) (*domain.WebhookSubscription, error) {
+	for _, subscription := range r.subscriptions {
+		if subscription.ID == subscriptionID {
+			return &subscription, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *webhookRepositoryStub) ListWebhookSubscriptions(context.Context) ([]domain.WebhookSubscription, error) {
+	return append([]domain.WebhookSubscription(nil), r.subscriptions...), nil
+}
+
+func (r *webhookRepositoryStub) SaveWebhookSubscription(_ context.Context, subscription domain.WebhookSubscription) error {
+	r.subscriptions = append(r.subscriptions, subscription)
+	return nil
+}
+
+func (r *webhookRepositoryStub) DeleteWebhookSubscription(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func (r *webhookRepositoryStub) FindWebhookDelivery(_ context.Context, deliveryID string) (*domain.WebhookDelivery, error) {
+	for _, delivery := range r.deliveries {
+		if delivery.ID == deliveryID {
+			return &delivery, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *webhookRepositoryStub) ListWebhookDeliveries(context.Context, string, int) ([]domain.WebhookDelivery, error) {
+	return r.deliveries, nil
+}
+
+func (r *webhookRepositoryStub) SaveWebhookDelivery(_ context.Context, delivery domain.WebhookDelivery) error {
+	for index := range r.deliveries {
+		if r.deliveries[index].ID == delivery.ID {
+			r.deliveries[index] = delivery
+			return nil
+		}
+	}
+	r.deliveries = append(r.deliveries, delivery)
+	return nil
+}
+
+type webhookSenderStub struct {
+	status int
+	calls  int
+}
+
+func (s *webhookSenderStub) SendWebhook(context.Context, domain.WebhookSubscription, domain.WebhookDelivery) (int, error) {
+	s.calls++
+	return s.status, nil
+}
+
+type webhookDeliverySchedulerStub struct {
+	scheduled []string
+}
+
+func (s *webhookDeliverySchedulerStub) ScheduleWebhookDelivery(_ context.Context, deliveryID string) error {
+	s.scheduled = append(s.scheduled, deliveryID)
+	return nil
+}
@@ -17,9 +17,12 @@ type Config struct {
 	HTTP     HTTPConfig     `koanf:"http"`
 	Postgres PostgresConfig `koanf:"postgres"`
 	Remote   RemoteConfig   `koanf:"remote"`
+	Calendar CalendarConfig `koanf:"calendar"`
 	Reminder ReminderConfig `koanf:"reminder"`
 	River    RiverConfig    `koanf:"river"`
 	RabbitMQ RabbitMQConfig `koanf:"rabbitmq"`
+	Waitlist WaitlistConfig `koanf:"waitlist"`
+	Webhook  WebhookConfig  `koanf:"webhook"`
 }
 
 type AppConfig struct {
@@ -38,18 +41,56 @@ type RabbitMQConfig struct {
 	URL                               string `koanf:"url"`
 	AppointmentInternalJobQueue       string `koanf:"appointment_internal_job_queue"`
 	CustomerNotificationOutcomesQueue string `koanf:"customer_notification_outcomes_queue"`
+	CustomerChangesQueue              string `koanf:"customer_changes_queue"`
+}
+
+type CalendarConfig struct {
+	// ConflictPolicy is one of reject, warn or allow; empty means reject.
+	ConflictPolicy string `koanf:"conflict_policy"`
+	Timezone       string `koanf:"timezone"`
+	// OpeningHours holds entries such as "mon=09:00-13:00,14:00-19:00"; empty means always open.
+	OpeningHours []string      `koanf:"opening_hours"`
+	SlotInterval time.Duration `koanf:"slot_interval"`
+	// FeedSecret signs the tokens of the .ics subscription feeds; empty disables the feeds.
+	FeedSecret string `koanf:"feed_secret"`
 }
 
 type ReminderConfig struct {
-	TriggerBefore          time.Duration `koanf:"trigger_before"`
+	// RemindBefore holds the lead times of the reminders of an appointment, such as "48h 2h";
+	// empty means a single reminder TriggerBefore the start.
+	RemindBefore  []time.Duration `koanf:"remind_before"`
+	TriggerBefore time.Duration   `koanf:"trigger_before"`
+	// MinRemindBefore and MaxRemindBefore bound the lead time chosen for a single appointment; zero leaves it open.
+	MinRemindBefore        time.Duration `koanf:"min_remind_before"`
+	MaxRemindBefore        time.Duration `koanf:"max_remind_before"`
 	ImmediateSendThreshold time.Duration `koanf:"immediate_send_threshold"`
 	NoSendThreshold        time.Duration `koanf:"no_send_threshold"`
+	// SendWindow is the daily interval, such as "08:00-20:00" in the calendar timezone, in which
+	// reminders go out; QuietWeekdays ("sun") and Holidays ("2026-12-25") are skipped entirely.
+	SendWindow    string   `koanf:"send_window"`
+	QuietWeekdays []string `koanf:"quiet_weekdays"`
+	Holidays      []string `koanf:"holidays"`
+}
+
+type WaitlistConfig struct {
+	// OfferTTL is how long a waiting customer can take an offered slot before it goes to the next entry.
+	OfferTTL time.Duration `koanf:"offer_ttl"`
+}
+
+type WebhookConfig struct {
+	// MaxAttempts bounds the attempts of a delivery, retried with a backoff from one minute up to six hours.
+	MaxAttempts int           `koanf:"max_attempts"`
+	Timeout     time.Duration `koanf:"timeout"`
 }
 
 type RiverConfig struct {
 	Queue       string `koanf:"queue"`
 	Workers     int    `koanf:"workers"`
 	MaxAttempts int    `koanf:"max_attempts"`
+	// NotificationExpiryInterval is how often pending notifications past their expiry are expired.
+	NotificationExpiryInterval time.Duration `koanf:"notification_expiry_interval"`
+	// ReminderReconciliationInterval is how often scheduled reminders are compared with their jobs.
+	ReminderReconciliationInterval time.Duration `koanf:"reminder_reconciliation_interval"`
 }
 
 func Load(envFile string) (Config, error) {
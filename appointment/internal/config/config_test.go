@@ -10,6 +10,14 @@ import (
 func TestLoadEnvironment(t *testing.T) {
 	t.Setenv("ENV_POSTGRES_DSN", "postgres://test")
 	t.Setenv("ENV_REMINDER_TRIGGER__BEFORE", "2h")
+	t.Setenv("ENV_CALENDAR_CONFLICT__POLICY", "warn")
+	t.Setenv("ENV_CALENDAR_OPENING__HOURS", "mon=09:00-13:00,14:00-19:00 sat=09:00-13:00")
+	t.Setenv("ENV_WAITLIST_OFFER__TTL", "90m")
+	t.Setenv("ENV_WEBHOOK_MAX__ATTEMPTS", "5")
+	t.Setenv("ENV_REMINDER_REMIND__BEFORE", "48h 2h")
+	t.Setenv("ENV_REMINDER_MAX__REMIND__BEFORE", "168h")
+	t.Setenv("ENV_REMINDER_SEND__WINDOW", "08:00-20:00")
+	t.Setenv("ENV_REMINDER_QUIET__WEEKDAYS", "sun")
 	cfg, err := Load("")
 	if err != nil {
 		t.Fatal(err)
@@ -20,6 +28,27 @@ func TestLoadEnvironment(t *testing.T) {
 	if cfg.Reminder.TriggerBefore != 2*time.Hour {
 		t.Fatalf("trigger=%s", cfg.Reminder.TriggerBefore)
 	}
+	if cfg.Calendar.ConflictPolicy != "warn" {
+		t.Fatalf("conflict policy=%q", cfg.Calendar.ConflictPolicy)
+	}
+	if len(cfg.Calendar.OpeningHours) != 2 || cfg.Calendar.OpeningHours[1] != "sat=09:00-13:00" {
+		t.Fatalf("opening hours=%#v", cfg.Calendar.OpeningHours)
+	}
+	if cfg.Waitlist.OfferTTL != 90*time.Minute {
+		t.Fatalf("waitlist offer ttl=%s", cfg.Waitlist.OfferTTL)
+	}
+	if cfg.Webhook.MaxAttempts != 5 {
+		t.Fatalf("webhook max attempts=%d", cfg.Webhook.MaxAttempts)
+	}
+	if len(cfg.Reminder.RemindBefore) != 2 || cfg.Reminder.RemindBefore[0] != 48*time.Hour || cfg.Reminder.RemindBefore[1] != 2*time.Hour {
+		t.Fatalf("remind before=%v", cfg.Reminder.RemindBefore)
+	}
+	if cfg.Reminder.MaxRemindBefore != 168*time.Hour {
+		t.Fatalf("max remind before=%s", cfg.Reminder.MaxRemindBefore)
+	}
+	if cfg.Reminder.SendWindow != "08:00-20:00" || len(cfg.Reminder.QuietWeekdays) != 1 || cfg.Reminder.QuietWeekdays[0] != "sun" {
+		t.Fatalf("send window=%q quiet weekdays=%#v", cfg.Reminder.SendWindow, cfg.Reminder.QuietWeekdays)
+	}
 }
 
 func TestLoadEnvFile(t *testing.T) {
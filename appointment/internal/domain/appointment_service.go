@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type AppointmentServiceRef struct {
 	Name string
 }
@@ -9,4 +11,8 @@ type AppointmentService struct {
 	Name  string
 	Tags  []string
 	Color *string
+	// Duration is the default length of a booking; zero means unknown.
+	Duration time.Duration
+	// Buffer is the cleanup time kept free after the service.
+	Buffer time.Duration
 }
@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrMissingRequiredAgendaData = errors.New("id and attendee are required")
 	ErrInvalidTimeSpan           = errors.New("end must be after start")
+	ErrInvalidServiceDuration    = errors.New("service duration and buffer must not be negative")
 )
@@ -14,9 +14,13 @@ func NewCustomerRef(id string, displayName string) (CustomerRef, error) {
 	return CustomerRef{ID: id, DisplayName: displayName}, nil
 }
 
+// ServiceItem snapshots the service booked by an appointment. Duration and Buffer are copied from the catalog
+// service when the appointment is booked, so later catalog changes do not alter existing appointments.
 type ServiceItem struct {
 	ServiceID   *string
 	ServiceName string
+	Duration    time.Duration
+	Buffer      time.Duration
 	Position    int
 }
 
@@ -27,11 +31,60 @@ func NewServiceItem(serviceID *string, serviceName string, position int) (Servic
 	return ServiceItem{ServiceID: serviceID, ServiceName: serviceName, Position: position}, nil
 }
 
+func NewCatalogServiceItem(serviceID string, serviceName string, duration time.Duration, buffer time.Duration, position int) (ServiceItem, error) {
+	if serviceID == "" {
+		return ServiceItem{}, ErrMissingRequiredData
+	}
+	if duration < 0 || buffer < 0 {
+		return ServiceItem{}, ErrInvalidEventDetail
+	}
+	item, err := NewServiceItem(&serviceID, serviceName, position)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+	item.Duration = duration
+	item.Buffer = buffer
+	return item, nil
+}
+
+// ServicesDuration is the time needed to perform the services one after the other, buffers included.
+func ServicesDuration(services []ServiceItem) time.Duration {
+	var total time.Duration
+	for _, service := range services {
+		total += service.Duration + service.Buffer
+	}
+	return total
+}
+
+// AttendanceStatus is the outcome of an appointment once its start time has passed.
+type AttendanceStatus string
+
+const (
+	AttendanceCompleted AttendanceStatus = "completed"
+	AttendanceNoShow    AttendanceStatus = "no_show"
+)
+
+func ParseAttendanceStatus(value string) (AttendanceStatus, error) {
+	status := AttendanceStatus(value)
+	switch status {
+	case AttendanceCompleted, AttendanceNoShow:
+		return status, nil
+	default:
+		return "", ErrInvalidAttendance
+	}
+}
+
+type AppointmentAttendance struct {
+	Status     AttendanceStatus
+	RecordedAt time.Time
+}
+
 type Appointment struct {
-	Customer  CustomerRef
-	Services  []ServiceItem
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Customer   CustomerRef
+	Services   []ServiceItem
+	Attendance *AppointmentAttendance
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 func NewAppointment(customer CustomerRef, services []ServiceItem, now time.Time) (Appointment, error) {
@@ -46,15 +99,16 @@ func NewAppointment(customer CustomerRef, services []ServiceItem, now time.Time)
 	}, nil
 }
 
-func ReconstituteAppointment(customer CustomerRef, services []ServiceItem, createdAt time.Time, updatedAt time.Time) (Appointment, error) {
+func ReconstituteAppointment(customer CustomerRef, services []ServiceItem, attendance *AppointmentAttendance, createdAt time.Time, updatedAt time.Time) (Appointment, error) {
 	if customer.ID == "" {
 		return Appointment{}, ErrMissingRequiredData
 	}
 	return Appointment{
-		Customer:  customer,
-		Services:  normalizeServicePositions(services),
-		CreatedAt: createdAt.UTC(),
-		UpdatedAt: updatedAt.UTC(),
+		Customer:   customer,
+		Services:   normalizeServicePositions(services),
+		Attendance: attendance,
+		CreatedAt:  createdAt.UTC(),
+		UpdatedAt:  updatedAt.UTC(),
 	}, nil
 }
 
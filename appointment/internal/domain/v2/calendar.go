@@ -0,0 +1,96 @@
+package v2
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var calendarColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Calendar is an agenda of its own inside the salon, such as an operator, a room or a solarium bed.
+type Calendar struct {
+	ID         string
+	Name       string
+	Color      *string
+	ArchivedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type CalendarParams struct {
+	ID    string
+	Name  string
+	Color *string
+	Now   time.Time
+}
+
+func NewCalendar(params CalendarParams) (Calendar, error) {
+	id, err := NormalizeCalendarID(params.ID)
+	if err != nil {
+		return Calendar{}, err
+	}
+	calendar := Calendar{ID: id, CreatedAt: params.Now.UTC(), UpdatedAt: params.Now.UTC()}
+	if err := calendar.Rename(params.Name, params.Now); err != nil {
+		return Calendar{}, err
+	}
+	if params.Color != nil {
+		if err := calendar.ChangeColor(*params.Color, params.Now); err != nil {
+			return Calendar{}, err
+		}
+	}
+	return calendar, nil
+}
+
+func (calendar *Calendar) Rename(name string, now time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidCalendar
+	}
+	calendar.Name = name
+	calendar.UpdatedAt = now.UTC()
+	return nil
+}
+
+// ChangeColor sets the #RRGGBB color of the calendar; an empty color removes it.
+func (calendar *Calendar) ChangeColor(color string, now time.Time) error {
+	color = strings.TrimSpace(color)
+	switch {
+	case color == "":
+		calendar.Color = nil
+	case calendarColorPattern.MatchString(color):
+		color = strings.ToUpper(color)
+		calendar.Color = &color
+	default:
+		return ErrInvalidCalendar
+	}
+	calendar.UpdatedAt = now.UTC()
+	return nil
+}
+
+// Archive hides the calendar from new bookings while keeping its events. The default calendar backs requests
+// without a calendar id, so it cannot be archived.
+func (calendar *Calendar) Archive(now time.Time) error {
+	if calendar.ID == DefaultCalendarID {
+		return ErrInvalidCalendar
+	}
+	if calendar.IsArchived() {
+		return nil
+	}
+	archivedAt := now.UTC()
+	calendar.ArchivedAt = &archivedAt
+	calendar.UpdatedAt = archivedAt
+	return nil
+}
+
+func (calendar Calendar) IsArchived() bool {
+	return calendar.ArchivedAt != nil
+}
+
+// AcceptEvents fails with ErrCalendarArchived once the calendar is archived.
+func (calendar Calendar) AcceptEvents() error {
+	if calendar.IsArchived() {
+		return ErrCalendarArchived
+	}
+	return nil
+}
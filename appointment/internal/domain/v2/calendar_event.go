@@ -3,6 +3,8 @@ package v2
 import (
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const DefaultCalendarID = "d2a36e25-4824-4167-a062-a5af96f97703"
@@ -21,6 +23,7 @@ type CalendarEvent struct {
 	Visibility   Visibility
 	Detail       EventDetail
 	Cancellation *CalendarEventCancellation
+	Recurrence   *Recurrence
 	Version      int64
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
@@ -57,6 +60,7 @@ type ManualEventParams struct {
 	Title            string
 	Description      string
 	Location         *string
+	Recurrence       *Recurrence
 	Now              time.Time
 }
 
@@ -65,7 +69,11 @@ func NewManualCalendarEvent(params ManualEventParams) (CalendarEvent, error) {
 	if err != nil {
 		return CalendarEvent{}, err
 	}
-	return newCalendarEvent(params.EventID, params.CalendarID, params.Range, params.EventTitle, params.EventDescription, params.Visibility, detail, params.Now)
+	event, err := newCalendarEvent(params.EventID, params.CalendarID, params.Range, params.EventTitle, params.EventDescription, params.Visibility, detail, params.Now)
+	if err != nil {
+		return CalendarEvent{}, err
+	}
+	return event, event.ChangeRecurrence(params.Recurrence, params.Now)
 }
 
 type TimeBlockEventParams struct {
@@ -76,6 +84,7 @@ type TimeBlockEventParams struct {
 	Description string
 	Visibility  Visibility
 	Reason      string
+	Recurrence  *Recurrence
 	Now         time.Time
 }
 
@@ -84,7 +93,11 @@ func NewTimeBlockCalendarEvent(params TimeBlockEventParams) (CalendarEvent, erro
 	if err != nil {
 		return CalendarEvent{}, err
 	}
-	return newCalendarEvent(params.EventID, params.CalendarID, params.Range, params.Title, params.Description, params.Visibility, detail, params.Now)
+	event, err := newCalendarEvent(params.EventID, params.CalendarID, params.Range, params.Title, params.Description, params.Visibility, detail, params.Now)
+	if err != nil {
+		return CalendarEvent{}, err
+	}
+	return event, event.ChangeRecurrence(params.Recurrence, params.Now)
 }
 
 func ReconstituteCalendarEvent(event CalendarEvent) (CalendarEvent, error) {
@@ -105,6 +118,9 @@ func ReconstituteCalendarEvent(event CalendarEvent) (CalendarEvent, error) {
 	if !event.Visibility.Valid() {
 		return CalendarEvent{}, ErrInvalidVisibility
 	}
+	if event.Recurrence != nil && event.Type == CalendarEventTypeAppointment {
+		return CalendarEvent{}, ErrInvalidRecurrence
+	}
 	return event, nil
 }
 
@@ -143,21 +159,30 @@ func newCalendarEvent(id string, calendarID string, eventRange TimeRange, title
 	return event, nil
 }
 
+// NormalizeCalendarID returns the canonical lower-case form of a calendar UUID; an empty id selects the
+// default calendar.
 func NormalizeCalendarID(calendarID string) (string, error) {
 	calendarID = strings.TrimSpace(calendarID)
-	if calendarID == "" || strings.EqualFold(calendarID, DefaultCalendarID) {
+	if calendarID == "" {
 		return DefaultCalendarID, nil
 	}
-	return "", ErrInvalidCalendarID
+	parsed, err := uuid.Parse(calendarID)
+	if err != nil {
+		return "", ErrInvalidCalendarID
+	}
+	return parsed.String(), nil
 }
 
 func (event *CalendarEvent) Reschedule(eventRange TimeRange, now time.Time) {
 	if event.Range.Equals(eventRange) {
 		return
 	}
+	rescheduled := CalendarEventRescheduled(event.ID)
+	previous := event.Range
+	rescheduled.PreviousRange = &previous
 	event.Range = eventRange
 	event.UpdatedAt = now.UTC()
-	event.record(CalendarEventRescheduled(event.ID))
+	event.record(rescheduled)
 }
 
 func (event *CalendarEvent) ChangeTitle(title string, now time.Time) {
@@ -193,6 +218,17 @@ func (event *CalendarEvent) ReplaceAppointmentServices(services []ServiceItem, n
 	return nil
 }
 
+// ChangeReminders records that the reminders of an appointment were replaced. The reminders are stored
+// outside the aggregate; the recorded event lets them be scheduled again.
+func (event *CalendarEvent) ChangeReminders(now time.Time) error {
+	if _, ok := event.Detail.(Appointment); !ok {
+		return ErrInvalidEventDetail
+	}
+	event.UpdatedAt = now.UTC()
+	event.record(CalendarEventRemindersChanged(event.ID))
+	return nil
+}
+
 func (event *CalendarEvent) ChangeManualDetails(title string, description string, location *string, now time.Time) error {
 	manualEvent, ok := event.Detail.(ManualEvent)
 	if !ok {
@@ -233,10 +269,142 @@ func (event *CalendarEvent) Cancel(reason CancelReason, now time.Time) {
 	event.record(CalendarEventCanceled(event.ID))
 }
 
+// MarkCompleted records that the customer showed up. See recordAttendance for the rules shared with MarkNoShow.
+func (event *CalendarEvent) MarkCompleted(now time.Time) error {
+	return event.recordAttendance(AttendanceCompleted, now)
+}
+
+func (event *CalendarEvent) MarkNoShow(now time.Time) error {
+	return event.recordAttendance(AttendanceNoShow, now)
+}
+
+// recordAttendance sets the outcome of an appointment that has started and was not canceled. Recording the
+// current outcome again is a no-op, while recording the other one corrects it.
+func (event *CalendarEvent) recordAttendance(status AttendanceStatus, now time.Time) error {
+	appointment, ok := event.Detail.(Appointment)
+	if !ok {
+		return ErrInvalidEventDetail
+	}
+	if event.IsCanceled() || now.Before(event.Range.Start) {
+		return ErrInvalidAttendance
+	}
+	if appointment.Attendance != nil && appointment.Attendance.Status == status {
+		return nil
+	}
+	appointment.Attendance = &AppointmentAttendance{Status: status, RecordedAt: now.UTC()}
+	appointment.UpdatedAt = now.UTC()
+	event.Detail = appointment
+	event.UpdatedAt = now.UTC()
+	if status == AttendanceCompleted {
+		event.record(CalendarEventCompleted(event.ID))
+	} else {
+		event.record(CalendarEventNoShow(event.ID))
+	}
+	return nil
+}
+
+// ChangeRecurrence repeats the event from its current time range; a nil recurrence makes it a one-off event.
+// Appointments cannot recur.
+func (event *CalendarEvent) ChangeRecurrence(recurrence *Recurrence, now time.Time) error {
+	if recurrence != nil {
+		if event.Type == CalendarEventTypeAppointment {
+			return ErrInvalidRecurrence
+		}
+		if err := recurrence.Validate(event.Range); err != nil {
+			return err
+		}
+	}
+	event.Recurrence = recurrence
+	event.UpdatedAt = now.UTC()
+	return nil
+}
+
+func (event CalendarEvent) IsRecurring() bool {
+	return event.Recurrence != nil
+}
+
+// Occurrences returns a copy of the event for each occurrence overlapping [from, to), with the occurrence
+// time range. A one-off event is returned as is when it overlaps the window.
+func (event CalendarEvent) Occurrences(from time.Time, to time.Time) []CalendarEvent {
+	if event.Recurrence == nil {
+		if event.Range.Start.Before(to) && event.Range.End.After(from) {
+			return []CalendarEvent{event}
+		}
+		return nil
+	}
+	var occurrences []CalendarEvent
+	for _, occurrenceRange := range event.Recurrence.Occurrences(event.Range, from, to) {
+		occurrence := event
+		occurrence.Range = occurrenceRange
+		occurrence.events = nil
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences
+}
+
+// HasOccurrence reports whether the series has an occurrence starting at start.
+func (event CalendarEvent) HasOccurrence(start time.Time) bool {
+	return event.Recurrence != nil && event.Recurrence.Includes(event.Range, start)
+}
+
+// ExcludeOccurrence removes a single occurrence from the series by adding an exception date.
+func (event *CalendarEvent) ExcludeOccurrence(start time.Time, now time.Time) error {
+	if event.Recurrence == nil {
+		return ErrInvalidOccurrence
+	}
+	recurrence, err := event.Recurrence.Exclude(event.Range, start)
+	if err != nil {
+		return err
+	}
+	event.Recurrence = &recurrence
+	event.UpdatedAt = now.UTC()
+	return nil
+}
+
+// EndSeriesBefore stops the series right before the occurrence starting at start and returns the recurrence
+// of the occurrences it removed, which starts at that occurrence.
+func (event *CalendarEvent) EndSeriesBefore(start time.Time, now time.Time) (Recurrence, error) {
+	if event.Recurrence == nil {
+		return Recurrence{}, ErrInvalidOccurrence
+	}
+	before, after, err := event.Recurrence.Split(event.Range, start)
+	if err != nil {
+		return Recurrence{}, err
+	}
+	event.Recurrence = &before
+	event.UpdatedAt = now.UTC()
+	return after, nil
+}
+
+// DetachOccurrence creates a new event with the same content as the series, starting at the occurrence
+// that begins at start and repeating with recurrence, if any.
+func (event CalendarEvent) DetachOccurrence(id string, start time.Time, recurrence *Recurrence, now time.Time) (CalendarEvent, error) {
+	if event.Recurrence == nil || !event.Recurrence.Includes(event.Range, start) {
+		return CalendarEvent{}, ErrInvalidOccurrence
+	}
+	occurrenceRange := event.Range
+	occurrenceRange.Start = start.UTC()
+	occurrenceRange.End = start.UTC().Add(event.Range.End.Sub(event.Range.Start))
+	detached, err := newCalendarEvent(id, event.CalendarID, occurrenceRange, event.Title, event.Description, event.Visibility, event.Detail, now)
+	if err != nil {
+		return CalendarEvent{}, err
+	}
+	return detached, detached.ChangeRecurrence(recurrence, now)
+}
+
 func (event CalendarEvent) IsCanceled() bool {
 	return event.Cancellation != nil
 }
 
+// ConflictsWith reports whether another active event of the same calendar overlaps this one in a way
+// the agenda does not allow.
+func (event CalendarEvent) ConflictsWith(other CalendarEvent) bool {
+	if event.ID == other.ID || event.CalendarID != other.CalendarID || event.IsCanceled() || other.IsCanceled() {
+		return false
+	}
+	return event.Type.ConflictsWith(other.Type) && event.Range.Overlaps(other.Range)
+}
+
 func (event *CalendarEvent) PullEvents() []LifecycleEvent {
 	pulled := event.events
 	event.events = nil
@@ -53,6 +53,20 @@ func (eventRange TimeRange) Equals(other TimeRange) bool {
 		eventRange.AllDay == other.AllDay
 }
 
+// Overlaps reports whether the two ranges share time; ranges that only touch at an edge do not overlap.
+func (eventRange TimeRange) Overlaps(other TimeRange) bool {
+	return eventRange.Start.Before(other.End) && other.Start.Before(eventRange.End)
+}
+
+// ConflictsWith reports whether events of the two types may not share time.
+// Appointments clash with appointments and time blocks; manual events never block the agenda.
+func (eventType CalendarEventType) ConflictsWith(other CalendarEventType) bool {
+	if eventType == CalendarEventTypeManual || other == CalendarEventTypeManual {
+		return false
+	}
+	return eventType == CalendarEventTypeAppointment || other == CalendarEventTypeAppointment
+}
+
 func (eventType CalendarEventType) Valid() bool {
 	switch eventType {
 	case CalendarEventTypeAppointment, CalendarEventTypeManual, CalendarEventTypeTimeBlock:
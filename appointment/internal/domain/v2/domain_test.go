@@ -17,8 +17,43 @@ func TestNormalizeCalendarID(t *testing.T) {
 		}
 	}
 
-	if _, err := NormalizeCalendarID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"); !errors.Is(err, ErrInvalidCalendarID) {
-		t.Fatalf("NormalizeCalendarID(other) error = %v, want ErrInvalidCalendarID", err)
+	got, err := NormalizeCalendarID(" AAAAAAAA-AAAA-AAAA-AAAA-AAAAAAAAAAAA ")
+	if err != nil || got != "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa" {
+		t.Fatalf("NormalizeCalendarID(other) = %q, %v, want the lower-case id", got, err)
+	}
+
+	if _, err := NormalizeCalendarID("operator-1"); !errors.Is(err, ErrInvalidCalendarID) {
+		t.Fatalf("NormalizeCalendarID(operator-1) error = %v, want ErrInvalidCalendarID", err)
+	}
+}
+
+func TestCalendarArchiveStopsNewEvents(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	color := "#ff8800"
+	calendar, err := NewCalendar(CalendarParams{ID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", Name: " Room 1 ", Color: &color, Now: now})
+	if err != nil {
+		t.Fatalf("NewCalendar() error = %v", err)
+	}
+	if calendar.Name != "Room 1" || calendar.Color == nil || *calendar.Color != "#FF8800" || calendar.AcceptEvents() != nil {
+		t.Fatalf("calendar = %#v, want an active calendar with a normalized name and color", calendar)
+	}
+	if err := calendar.ChangeColor("orange", now); !errors.Is(err, ErrInvalidCalendar) {
+		t.Fatalf("ChangeColor(orange) error = %v, want ErrInvalidCalendar", err)
+	}
+
+	if err := calendar.Archive(now.Add(time.Hour)); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+	if !errors.Is(calendar.AcceptEvents(), ErrCalendarArchived) {
+		t.Fatalf("AcceptEvents() error = %v, want ErrCalendarArchived", calendar.AcceptEvents())
+	}
+
+	defaultCalendar, err := NewCalendar(CalendarParams{Name: "Agenda", Now: now})
+	if err != nil {
+		t.Fatalf("NewCalendar(default) error = %v", err)
+	}
+	if err := defaultCalendar.Archive(now); !errors.Is(err, ErrInvalidCalendar) {
+		t.Fatalf("Archive(default) error = %v, want ErrInvalidCalendar", err)
 	}
 }
 
@@ -130,8 +165,8 @@ func TestCalendarEventEmitsLifecycleEvents(t *testing.T) {
 	}
 	event.Reschedule(newRange, now)
 	events = event.PullEvents()
-	if len(events) != 1 || events[0].Type != "CalendarEventRescheduled" {
-		t.Fatalf("rescheduled events = %#v", events)
+	if len(events) != 1 || events[0].Type != "CalendarEventRescheduled" || events[0].PreviousRange == nil || !events[0].PreviousRange.Equals(eventRange) {
+		t.Fatalf("rescheduled events = %#v, want one with the previous range", events)
 	}
 }
 
@@ -168,6 +203,321 @@ func TestCalendarEventRescheduleDoesNothingWhenTimeRangeIsUnchanged(t *testing.T
 	}
 }
 
+func TestAppointmentAttendanceIsRecordedOnlyAfterItStarts(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	eventRange, err := NewTimeRange(now.Add(time.Hour), now.Add(2*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatalf("NewTimeRange() error = %v", err)
+	}
+	event, err := NewAppointmentEvent(AppointmentEventParams{
+		EventID:    "event-1",
+		CalendarID: DefaultCalendarID,
+		Range:      eventRange,
+		Title:      "Jane Doe",
+		Customer:   CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatalf("NewAppointmentEvent() error = %v", err)
+	}
+	event.PullEvents()
+
+	if err := event.MarkNoShow(now); !errors.Is(err, ErrInvalidAttendance) {
+		t.Fatalf("MarkNoShow() before start error = %v, want ErrInvalidAttendance", err)
+	}
+	started := now.Add(90 * time.Minute)
+	if err := event.MarkNoShow(started); err != nil {
+		t.Fatalf("MarkNoShow() error = %v", err)
+	}
+	if err := event.MarkNoShow(started.Add(time.Minute)); err != nil {
+		t.Fatalf("MarkNoShow() again error = %v", err)
+	}
+	if err := event.MarkCompleted(started.Add(2 * time.Minute)); err != nil {
+		t.Fatalf("MarkCompleted() error = %v", err)
+	}
+	events := event.PullEvents()
+	if len(events) != 2 || events[0].Type != "CalendarEventNoShow" || events[1].Type != "CalendarEventCompleted" {
+		t.Fatalf("attendance events = %#v", events)
+	}
+	attendance := event.Detail.(Appointment).Attendance
+	if attendance == nil || attendance.Status != AttendanceCompleted || !attendance.RecordedAt.Equal(started.Add(2*time.Minute)) {
+		t.Fatalf("attendance = %#v, want the corrected completed outcome", attendance)
+	}
+
+	event.Cancel(CancelReasonDeleted, started)
+	if err := event.MarkNoShow(started); !errors.Is(err, ErrInvalidAttendance) {
+		t.Fatalf("MarkNoShow() on canceled error = %v, want ErrInvalidAttendance", err)
+	}
+}
+
+func TestCalendarEventConflictsOnlyWithOverlappingBlockingEvents(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	eventRange, err := NewTimeRange(now, now.Add(time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatalf("NewTimeRange() error = %v", err)
+	}
+	adjacentRange, err := NewTimeRange(now.Add(time.Hour), now.Add(2*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatalf("NewTimeRange() error = %v", err)
+	}
+	customer, err := NewCustomerRef("customer-1", "Jane Doe")
+	if err != nil {
+		t.Fatalf("NewCustomerRef() error = %v", err)
+	}
+	appointment, err := NewAppointmentEvent(AppointmentEventParams{EventID: "appointment-1", CalendarID: DefaultCalendarID, Range: eventRange, Customer: customer, Now: now})
+	if err != nil {
+		t.Fatalf("NewAppointmentEvent() error = %v", err)
+	}
+	timeBlock, err := NewTimeBlockCalendarEvent(TimeBlockEventParams{EventID: "block-1", CalendarID: DefaultCalendarID, Range: eventRange, Reason: "holiday", Now: now})
+	if err != nil {
+		t.Fatalf("NewTimeBlockCalendarEvent() error = %v", err)
+	}
+	manual, err := NewManualCalendarEvent(ManualEventParams{EventID: "manual-1", CalendarID: DefaultCalendarID, Range: eventRange, Title: "Call supplier", Now: now})
+	if err != nil {
+		t.Fatalf("NewManualCalendarEvent() error = %v", err)
+	}
+	otherBlock := timeBlock
+	otherBlock.ID = "block-2"
+	adjacent := timeBlock
+	adjacent.ID = "block-3"
+	adjacent.Range = adjacentRange
+	canceled := timeBlock
+	canceled.ID = "block-4"
+	canceled.Cancel(CancelReasonDeleted, now)
+
+	if !appointment.ConflictsWith(timeBlock) || !timeBlock.ConflictsWith(appointment) {
+		t.Fatal("appointment and time block overlap should conflict")
+	}
+	if appointment.ConflictsWith(appointment) {
+		t.Fatal("an event must not conflict with itself")
+	}
+	if appointment.ConflictsWith(manual) || timeBlock.ConflictsWith(otherBlock) {
+		t.Fatal("manual events and time block pairs must not conflict")
+	}
+	if appointment.ConflictsWith(adjacent) || appointment.ConflictsWith(canceled) {
+		t.Fatal("adjacent and canceled events must not conflict")
+	}
+}
+
+func TestOpeningHoursWindowsFollowLocalWallClock(t *testing.T) {
+	hours, err := ParseOpeningHours("Europe/Rome", []string{"mon=14:00-19:00,09:00-13:00", "sat=09:00-13:00"})
+	if err != nil {
+		t.Fatalf("ParseOpeningHours() error = %v", err)
+	}
+	rome, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2026-10-25 is the Sunday when Europe/Rome leaves daylight saving time.
+	saturday := time.Date(2026, 10, 24, 0, 0, 0, 0, rome)
+	windows := hours.Windows(saturday, saturday.AddDate(0, 0, 3))
+
+	want := []time.Time{
+		time.Date(2026, 10, 24, 9, 0, 0, 0, rome),
+		time.Date(2026, 10, 26, 9, 0, 0, 0, rome),
+		time.Date(2026, 10, 26, 14, 0, 0, 0, rome),
+	}
+	if len(windows) != len(want) {
+		t.Fatalf("windows = %#v", windows)
+	}
+	for index, window := range windows {
+		if !window.Start.Equal(want[index]) {
+			t.Fatalf("window %d starts at %s, want %s", index, window.Start, want[index])
+		}
+	}
+}
+
+func TestParseOpeningHoursRejectsInvalidEntries(t *testing.T) {
+	for _, entry := range []string{"funday=09:00-10:00", "mon=10:00-09:00", "mon=09:00-12:00,11:00-13:00", "mon=9"} {
+		if _, err := ParseOpeningHours("UTC", []string{entry}); !errors.Is(err, ErrInvalidOpeningHours) {
+			t.Errorf("ParseOpeningHours(%q) error = %v, want ErrInvalidOpeningHours", entry, err)
+		}
+	}
+}
+
+func TestReminderSendWindowSkipsQuietHoursAndHolidays(t *testing.T) {
+	window, err := ParseReminderSendWindow("Europe/Rome", "08:00-20:00", []string{"sun"}, []string{"2026-12-25"})
+	if err != nil {
+		t.Fatalf("ParseReminderSendWindow() error = %v", err)
+	}
+	rome, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, 12, 20, 12, 0, 0, 0, rome)
+	// 2026-12-25 is a Friday holiday, so a reminder due on it moves to Thursday evening.
+	latest, ok := window.LatestAllowed(time.Date(2026, 12, 25, 9, 0, 0, 0, rome), now)
+	if !ok || !latest.Equal(time.Date(2026, 12, 24, 20, 0, 0, 0, rome)) {
+		t.Fatalf("LatestAllowed() = %s, %v", latest.In(rome), ok)
+	}
+	if _, ok := window.LatestAllowed(time.Date(2026, 12, 20, 18, 0, 0, 0, rome), now); ok {
+		t.Fatal("LatestAllowed() must not find an instant on an excluded weekday")
+	}
+	earliest, ok := window.EarliestAllowed(time.Date(2026, 12, 20, 18, 0, 0, 0, rome), time.Date(2026, 12, 22, 0, 0, 0, 0, rome))
+	if !ok || !earliest.Equal(time.Date(2026, 12, 21, 8, 0, 0, 0, rome)) {
+		t.Fatalf("EarliestAllowed() = %s, %v", earliest.In(rome), ok)
+	}
+	for _, invalid := range []struct{ daily, weekday, holiday string }{{daily: "8-20"}, {weekday: "funday"}, {holiday: "25/12"}, {daily: "20:00-08:00"}} {
+		if _, err := ParseReminderSendWindow("UTC", invalid.daily, []string{invalid.weekday}, []string{invalid.holiday}); !errors.Is(err, ErrInvalidSendWindow) {
+			t.Errorf("ParseReminderSendWindow(%+v) error = %v, want ErrInvalidSendWindow", invalid, err)
+		}
+	}
+}
+
+func TestRecurringTimeBlockExpandsOccurrencesOnLocalWallClock(t *testing.T) {
+	now := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	eventRange, err := NewTimeRange(time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC), time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recurrence, err := NewRecurrence("RRULE:FREQ=WEEKLY;BYDAY=WE,MO", []time.Time{time.Date(2026, 10, 21, 7, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := NewTimeBlockCalendarEvent(TimeBlockEventParams{
+		EventID:    "event-1",
+		CalendarID: DefaultCalendarID,
+		Range:      eventRange,
+		Reason:     "staff meeting",
+		Recurrence: &recurrence,
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := event.Recurrence.Rule.String(); got != "FREQ=WEEKLY;BYDAY=MO,WE" {
+		t.Fatalf("rule = %q", got)
+	}
+
+	occurrences := event.Occurrences(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))
+
+	want := []time.Time{
+		time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 28, 8, 0, 0, 0, time.UTC),
+	}
+	if len(occurrences) != len(want) {
+		t.Fatalf("occurrences = %#v, want %d", occurrences, len(want))
+	}
+	for index, occurrence := range occurrences {
+		if occurrence.ID != "event-1" || !occurrence.Range.Start.Equal(want[index]) || occurrence.Range.End.Sub(occurrence.Range.Start) != time.Hour {
+			t.Fatalf("occurrence %d = %#v, want start %s", index, occurrence.Range, want[index])
+		}
+	}
+}
+
+func TestCalendarEventSplitsSeriesAtOccurrence(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 8, 3, 10, 0, 0, 0, time.UTC)
+	eventRange, err := NewTimeRange(start, start.Add(time.Hour), "UTC", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recurrence, err := NewRecurrence("FREQ=DAILY;COUNT=5", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	series, err := NewManualCalendarEvent(ManualEventParams{
+		EventID:    "event-1",
+		CalendarID: DefaultCalendarID,
+		Range:      eventRange,
+		Title:      "Team sync",
+		Recurrence: &recurrence,
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	splitAt := start.Add(48 * time.Hour)
+	original := series
+
+	following, err := series.EndSeriesBefore(splitAt, now)
+	if err != nil {
+		t.Fatalf("EndSeriesBefore() error = %v", err)
+	}
+	detached, err := original.DetachOccurrence("event-2", splitAt, &following, now)
+	if err != nil {
+		t.Fatalf("DetachOccurrence() error = %v", err)
+	}
+
+	if got := len(series.Occurrences(start, start.Add(30*24*time.Hour))); got != 2 {
+		t.Fatalf("series occurrences = %d, want 2", got)
+	}
+	if detached.ID != "event-2" || !detached.Range.Start.Equal(splitAt) || detached.Recurrence.Rule.Count != 3 {
+		t.Fatalf("detached = %#v, recurrence = %#v", detached.Range, detached.Recurrence)
+	}
+	if series.HasOccurrence(splitAt) || !detached.HasOccurrence(splitAt.Add(48*time.Hour)) {
+		t.Fatal("occurrences were not moved to the detached series")
+	}
+	if err := series.ExcludeOccurrence(start, now); err != nil {
+		t.Fatalf("ExcludeOccurrence() error = %v", err)
+	}
+	if series.HasOccurrence(start) {
+		t.Fatal("excluded occurrence is still part of the series")
+	}
+}
+
+func TestRecurrenceRejectsUnsupportedRulesAndAppointments(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"FREQ=HOURLY",
+		"FREQ=MONTHLY;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;COUNT=2;UNTIL=20261231",
+		"FREQ=DAILY;BYMONTH=1",
+		"FREQ=DAILY;INTERVAL=0",
+	} {
+		if _, err := ParseRecurrenceRule(rule); !errors.Is(err, ErrInvalidRecurrence) {
+			t.Errorf("ParseRecurrenceRule(%q) error = %v, want ErrInvalidRecurrence", rule, err)
+		}
+	}
+
+	eventRange, err := NewTimeRange(time.Date(2026, 8, 3, 10, 0, 0, 0, time.UTC), time.Date(2026, 8, 3, 11, 0, 0, 0, time.UTC), "UTC", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recurrence, err := NewRecurrence("FREQ=WEEKLY;BYDAY=TU", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewTimeBlockCalendarEvent(TimeBlockEventParams{EventID: "event-1", Range: eventRange, Reason: "closed", Recurrence: &recurrence}); !errors.Is(err, ErrInvalidRecurrence) {
+		t.Fatalf("time block starting outside BYDAY error = %v, want ErrInvalidRecurrence", err)
+	}
+	appointment, err := NewAppointmentEvent(AppointmentEventParams{
+		EventID:  "event-2",
+		Range:    eventRange,
+		Customer: CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	daily, err := NewRecurrence("FREQ=DAILY", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := appointment.ChangeRecurrence(&daily, time.Now()); !errors.Is(err, ErrInvalidRecurrence) {
+		t.Fatalf("appointment ChangeRecurrence() error = %v, want ErrInvalidRecurrence", err)
+	}
+}
+
+func TestMonthlyRecurrenceSkipsMonthsWithoutTheDay(t *testing.T) {
+	start := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	first, err := NewTimeRange(start, start.Add(time.Hour), "UTC", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recurrence, err := NewRecurrence("FREQ=MONTHLY;COUNT=3", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	end := recurrence.End(first)
+
+	if end == nil || !end.Equal(time.Date(2026, 5, 31, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("End() = %v, want 2026-05-31T10:00:00Z", end)
+	}
+}
+
 func TestAppointmentReminderHasIndependentLifecycle(t *testing.T) {
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
 	reminder, err := NewAppointmentReminder(time.Hour, now)
@@ -184,6 +534,20 @@ func TestAppointmentReminderHasIndependentLifecycle(t *testing.T) {
 	}
 }
 
+func TestAppointmentRemindersAreOrderedByLeadTime(t *testing.T) {
+	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
+	reminders, err := NewAppointmentReminders([]time.Duration{2 * time.Hour, 48 * time.Hour}, now)
+	if err != nil {
+		t.Fatalf("NewAppointmentReminders() error = %v", err)
+	}
+	if len(reminders) != 2 || reminders[0].RemindBefore != 48*time.Hour || reminders[1].Position != 1 || reminders[1].RemindBefore != 2*time.Hour {
+		t.Fatalf("reminders = %#v, want 48h at position 0 and 2h at position 1", reminders)
+	}
+	if _, err := NewAppointmentReminders([]time.Duration{time.Hour, time.Hour}, now); err != ErrInvalidReminder {
+		t.Fatalf("duplicate lead times error = %v, want %v", err, ErrInvalidReminder)
+	}
+}
+
 func TestAppointmentNotificationMapsKindToType(t *testing.T) {
 	now := time.Date(2026, 7, 26, 10, 0, 0, 0, time.UTC)
 	recipient, err := NewCustomerNotificationRecipient("customer-1")
@@ -225,3 +589,96 @@ func TestReminderCannotBeScheduledInThePast(t *testing.T) {
 		t.Fatalf("Schedule() error = %v, want %v", err, ErrInvalidReminder)
 	}
 }
+
+func TestWaitlistEntryFitsServicesInsideFreedTime(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	window, err := NewTimeRange(now.Add(2*time.Hour), now.Add(6*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatalf("NewTimeRange() error = %v", err)
+	}
+	service, err := NewCatalogServiceItem("service-1", "Haircut", 45*time.Minute, 15*time.Minute, 0)
+	if err != nil {
+		t.Fatalf("NewCatalogServiceItem() error = %v", err)
+	}
+	entry, err := NewWaitlistEntry(WaitlistEntryParams{
+		EntryID:  "entry-1",
+		Customer: CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+		Services: []ServiceItem{service},
+		Window:   window,
+		Now:      now,
+	})
+	if err != nil {
+		t.Fatalf("NewWaitlistEntry() error = %v", err)
+	}
+	if entry.CalendarID != DefaultCalendarID || entry.Status != WaitlistEntryWaiting {
+		t.Fatalf("entry = %#v", entry)
+	}
+
+	if _, ok := entry.Fit(TimeRange{Start: now.Add(time.Hour), End: now.Add(150 * time.Minute)}); ok {
+		t.Fatal("Fit() accepted 30 minutes inside the window for a one hour service")
+	}
+	slot, ok := entry.Fit(TimeRange{Start: now.Add(time.Hour), End: now.Add(4 * time.Hour)})
+	if !ok || !slot.Start.Equal(now.Add(2*time.Hour)) || !slot.End.Equal(now.Add(3*time.Hour)) {
+		t.Fatalf("Fit() = %#v, %v, want the first hour of the window", slot, ok)
+	}
+
+	if err := entry.OfferSlot("event-1", slot, time.Hour, now); err != nil {
+		t.Fatalf("OfferSlot() error = %v", err)
+	}
+	if err := entry.OfferSlot("event-2", slot, time.Hour, now); !errors.Is(err, ErrInvalidWaitlistEntry) {
+		t.Fatalf("OfferSlot() on offered entry error = %v, want ErrInvalidWaitlistEntry", err)
+	}
+	if entry.ExpireOffer(now.Add(59 * time.Minute)) {
+		t.Fatal("ExpireOffer() expired an offer before its expiry")
+	}
+	if !entry.ExpireOffer(now.Add(time.Hour)) || entry.Status != WaitlistEntryWaiting || !entry.WasOffered("event-1") {
+		t.Fatalf("expired entry = %#v, want waiting with the last offer kept", entry)
+	}
+	if err := entry.OfferSlot("event-2", slot, time.Hour, now.Add(time.Hour)); err != nil {
+		t.Fatalf("OfferSlot() error = %v", err)
+	}
+	if entry.OpenOffer(now.Add(time.Hour)) == nil || entry.OpenOffer(now.Add(2*time.Hour)) != nil {
+		t.Fatal("OpenOffer() does not follow the offer expiry")
+	}
+	if !entry.WasOffered("event-1") || !entry.WasOffered("event-2") || entry.WasOffered("event-3") {
+		t.Fatalf("offered events = %v, want event-1 and event-2", entry.OfferedCalendarEventIDs)
+	}
+}
+
+func TestWebhookSubscriptionValidatesItsEndpointAndFilter(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	params := WebhookSubscriptionParams{
+		SubscriptionID: "subscription-1",
+		URL:            "https://example.com/hooks",
+		Secret:         "0123456789abcdef",
+		EventTypes:     []string{"CalendarEventCanceled", "CalendarEventCanceled"},
+		Now:            now,
+	}
+	for name, change := range map[string]func(*WebhookSubscriptionParams){
+		"relative url": func(p *WebhookSubscriptionParams) { p.URL = "/hooks" },
+		"ftp url":      func(p *WebhookSubscriptionParams) { p.URL = "ftp://example.com/hooks" },
+		"short secret": func(p *WebhookSubscriptionParams) { p.Secret = "secret" },
+		"unknown type": func(p *WebhookSubscriptionParams) { p.EventTypes = []string{"CalendarEventDeleted"} },
+	} {
+		invalid := params
+		change(&invalid)
+		if _, err := NewWebhookSubscription(invalid); !errors.Is(err, ErrInvalidWebhook) {
+			t.Fatalf("%s: NewWebhookSubscription() error = %v, want ErrInvalidWebhook", name, err)
+		}
+	}
+
+	subscription, err := NewWebhookSubscription(params)
+	if err != nil {
+		t.Fatalf("NewWebhookSubscription() error = %v", err)
+	}
+	if !subscription.Enabled || len(subscription.EventTypes) != 1 {
+		t.Fatalf("subscription = %#v, want enabled with the filter deduplicated", subscription)
+	}
+	if !subscription.Accepts("CalendarEventCanceled") || subscription.Accepts("CalendarEventCreated") {
+		t.Fatal("Accepts() ignored the event type filter")
+	}
+	subscription.SetEnabled(false, now)
+	if subscription.Accepts("CalendarEventCanceled") {
+		t.Fatal("disabled subscription accepts events")
+	}
+}
@@ -3,12 +3,21 @@ package v2
 import "errors"
 
 var (
-	ErrMissingRequiredData = errors.New("missing required data")
-	ErrInvalidTimeRange    = errors.New("end must be after start")
-	ErrInvalidCalendarID   = errors.New("invalid calendar id")
-	ErrInvalidEventType    = errors.New("invalid agenda event type")
-	ErrInvalidEventDetail  = errors.New("invalid agenda event detail")
-	ErrInvalidVisibility   = errors.New("invalid agenda event visibility")
-	ErrInvalidReminder     = errors.New("invalid appointment reminder")
-	ErrInvalidNotification = errors.New("invalid appointment notification")
+	ErrMissingRequiredData  = errors.New("missing required data")
+	ErrInvalidTimeRange     = errors.New("end must be after start")
+	ErrInvalidCalendarID    = errors.New("invalid calendar id")
+	ErrInvalidCalendar      = errors.New("invalid calendar")
+	ErrCalendarArchived     = errors.New("calendar is archived")
+	ErrInvalidEventType     = errors.New("invalid agenda event type")
+	ErrInvalidEventDetail   = errors.New("invalid agenda event detail")
+	ErrInvalidVisibility    = errors.New("invalid agenda event visibility")
+	ErrInvalidReminder      = errors.New("invalid appointment reminder")
+	ErrInvalidNotification  = errors.New("invalid appointment notification")
+	ErrInvalidOpeningHours  = errors.New("invalid opening hours")
+	ErrInvalidRecurrence    = errors.New("invalid calendar event recurrence")
+	ErrInvalidOccurrence    = errors.New("invalid calendar event occurrence")
+	ErrInvalidAttendance    = errors.New("invalid appointment attendance")
+	ErrInvalidWaitlistEntry = errors.New("invalid waitlist entry")
+	ErrInvalidSendWindow    = errors.New("invalid reminder send window")
+	ErrInvalidWebhook       = errors.New("invalid webhook subscription")
 )
@@ -3,6 +3,19 @@ package v2
 type LifecycleEvent struct {
 	Type            string `json:"type"`
 	CalendarEventID string `json:"calendarEventId"`
+	// PreviousRange is the time range a reschedule replaced. It is published with the public lifecycle
+	// events only, so it is left out of the internal job payload.
+	PreviousRange *TimeRange `json:"-"`
+}
+
+// LifecycleEventTypes lists the types of the lifecycle events recorded by calendar events.
+var LifecycleEventTypes = []string{
+	"CalendarEventCreated",
+	"CalendarEventRescheduled",
+	"CalendarEventCanceled",
+	"CalendarEventCompleted",
+	"CalendarEventNoShow",
+	"CalendarEventRemindersChanged",
 }
 
 func CalendarEventCreated(calendarEventID string) LifecycleEvent {
@@ -16,3 +29,16 @@ func CalendarEventRescheduled(calendarEventID string) LifecycleEvent {
 func CalendarEventCanceled(calendarEventID string) LifecycleEvent {
 	return LifecycleEvent{Type: "CalendarEventCanceled", CalendarEventID: calendarEventID}
 }
+
+func CalendarEventCompleted(calendarEventID string) LifecycleEvent {
+	return LifecycleEvent{Type: "CalendarEventCompleted", CalendarEventID: calendarEventID}
+}
+
+func CalendarEventNoShow(calendarEventID string) LifecycleEvent {
+	return LifecycleEvent{Type: "CalendarEventNoShow", CalendarEventID: calendarEventID}
+}
+
+// CalendarEventRemindersChanged is recorded when the reminder lead times of an appointment are replaced.
+func CalendarEventRemindersChanged(calendarEventID string) LifecycleEvent {
+	return LifecycleEvent{Type: "CalendarEventRemindersChanged", CalendarEventID: calendarEventID}
+}
@@ -8,6 +8,7 @@ const (
 	NotificationKindConfirmation NotificationKind = "confirmation"
 	NotificationKindRescheduled  NotificationKind = "rescheduled"
 	NotificationKindReminder     NotificationKind = "reminder"
+	NotificationKindCanceled     NotificationKind = "canceled"
 )
 
 type NotificationType string
@@ -16,6 +17,9 @@ const (
 	NotificationTypeAppointmentConfirmation NotificationType = "appointment_confirmation"
 	NotificationTypeAppointmentRescheduled  NotificationType = "appointment_rescheduled"
 	NotificationTypeAppointmentReminder     NotificationType = "appointment_reminder"
+	NotificationTypeAppointmentCanceled     NotificationType = "appointment_canceled"
+	// NotificationTypeWaitlistOffer is sent to waitlisted customers and is not tracked as an appointment notification.
+	NotificationTypeWaitlistOffer NotificationType = "waitlist_offer"
 )
 
 type NotificationStatus string
@@ -65,11 +69,13 @@ type AppointmentNotification struct {
 	Status          NotificationStatus
 	Recipient       NotificationRecipient
 	IdempotencyKey  *string
-	FailureReason   *string
-	FailureMessage  *string
-	CreatedAt       time.Time
-	CompletedAt     *time.Time
-	ExpiresAt       time.Time
+	// ReminderPosition links a reminder notification to the reminder it was sent for.
+	ReminderPosition *int
+	FailureReason    *string
+	FailureMessage   *string
+	CreatedAt        time.Time
+	CompletedAt      *time.Time
+	ExpiresAt        time.Time
 }
 
 func NewAppointmentNotification(correlationKey string, calendarEventID string, kind NotificationKind, recipient NotificationRecipient, idempotencyKey *string, createdAt time.Time, expiresAt time.Time) (AppointmentNotification, error) {
@@ -147,6 +153,8 @@ func notificationTypeForKind(kind NotificationKind) (NotificationType, bool) {
 		return NotificationTypeAppointmentRescheduled, true
 	case NotificationKindReminder:
 		return NotificationTypeAppointmentReminder, true
+	case NotificationKindCanceled:
+		return NotificationTypeAppointmentCanceled, true
 	default:
 		return "", false
 	}
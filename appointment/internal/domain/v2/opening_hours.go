@@ -0,0 +1,145 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DailyInterval is an opening interval expressed as minutes after local midnight.
+type DailyInterval struct {
+	StartMinute int
+	EndMinute   int
+}
+
+// OpeningHours lists, for each weekday, the intervals in which the calendar accepts bookings.
+// Opening hours without any configured day leave the calendar always open.
+type OpeningHours struct {
+	location *time.Location
+	days     map[time.Weekday][]DailyInterval
+}
+
+// OpenWindow is an absolute interval in which the calendar is open.
+type OpenWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func NewOpeningHours(timezone string, days map[time.Weekday][]DailyInterval) (OpeningHours, error) {
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return OpeningHours{}, fmt.Errorf("%w: %v", ErrInvalidOpeningHours, err)
+	}
+	normalized := make(map[time.Weekday][]DailyInterval, len(days))
+	for weekday, intervals := range days {
+		sorted := append([]DailyInterval(nil), intervals...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].StartMinute < sorted[j].StartMinute })
+		for index, interval := range sorted {
+			if interval.StartMinute < 0 || interval.EndMinute > 24*60 || interval.EndMinute <= interval.StartMinute {
+				return OpeningHours{}, fmt.Errorf("%w: %s interval out of range", ErrInvalidOpeningHours, weekday)
+			}
+			if index > 0 && interval.StartMinute < sorted[index-1].EndMinute {
+				return OpeningHours{}, fmt.Errorf("%w: %s intervals overlap", ErrInvalidOpeningHours, weekday)
+			}
+		}
+		normalized[weekday] = sorted
+	}
+	return OpeningHours{location: location, days: normalized}, nil
+}
+
+// ParseOpeningHours reads entries such as "mon=09:00-13:00,14:00-19:00"; weekdays without an entry are closed.
+func ParseOpeningHours(timezone string, entries []string) (OpeningHours, error) {
+	days := make(map[time.Weekday][]DailyInterval)
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		day, rawIntervals, ok := strings.Cut(entry, "=")
+		weekday, known := weekdayNames[strings.ToLower(strings.TrimSpace(day))]
+		if !ok || !known {
+			return OpeningHours{}, fmt.Errorf("%w: %q", ErrInvalidOpeningHours, entry)
+		}
+		for _, rawInterval := range strings.Split(rawIntervals, ",") {
+			rawStart, rawEnd, ok := strings.Cut(strings.TrimSpace(rawInterval), "-")
+			if !ok {
+				return OpeningHours{}, fmt.Errorf("%w: %q", ErrInvalidOpeningHours, entry)
+			}
+			start, err := parseMinuteOfDay(rawStart)
+			if err != nil {
+				return OpeningHours{}, err
+			}
+			end, err := parseMinuteOfDay(rawEnd)
+			if err != nil {
+				return OpeningHours{}, err
+			}
+			days[weekday] = append(days[weekday], DailyInterval{StartMinute: start, EndMinute: end})
+		}
+	}
+	return NewOpeningHours(timezone, days)
+}
+
+// Location is the timezone the opening hours, and the slot grid of the calendar, are expressed in.
+func (hours OpeningHours) Location() *time.Location {
+	if hours.location == nil {
+		return time.UTC
+	}
+	return hours.location
+}
+
+// Windows returns the open windows clipped to [start, end), in chronological order.
+func (hours OpeningHours) Windows(start time.Time, end time.Time) []OpenWindow {
+	if !end.After(start) {
+		return nil
+	}
+	if len(hours.days) == 0 {
+		return []OpenWindow{{Start: start, End: end}}
+	}
+	location := hours.Location()
+	var windows []OpenWindow
+	localStart := start.In(location)
+	day := time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, location)
+	for day.Before(end) {
+		for _, interval := range hours.days[day.Weekday()] {
+			windowStart := time.Date(day.Year(), day.Month(), day.Day(), 0, interval.StartMinute, 0, 0, location)
+			windowEnd := time.Date(day.Year(), day.Month(), day.Day(), 0, interval.EndMinute, 0, 0, location)
+			if windowStart.Before(start) {
+				windowStart = start
+			}
+			if windowEnd.After(end) {
+				windowEnd = end
+			}
+			if windowEnd.After(windowStart) {
+				windows = append(windows, OpenWindow{Start: windowStart.UTC(), End: windowEnd.UTC()})
+			}
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, location)
+	}
+	return windows
+}
+
+func parseMinuteOfDay(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid time %q", ErrInvalidOpeningHours, value)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
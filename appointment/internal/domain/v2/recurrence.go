@@ -0,0 +1,364 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRecurrencePeriods bounds the expansion of rules whose periods rarely produce an occurrence,
+// such as a yearly rule on February 29th.
+const maxRecurrencePeriods = 100000
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "MONTHLY"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "YEARLY"
+)
+
+// RecurrenceRule is the supported subset of an RFC 5545 RRULE: FREQ, INTERVAL, COUNT, UNTIL and,
+// for weekly rules, BYDAY without ordinals. Weeks start on Monday.
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency
+	Interval  int
+	Count     int
+	Until     *time.Time
+	ByDay     []time.Weekday
+}
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseRecurrenceRule reads a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;UNTIL=20261231T235959Z".
+// A leading "RRULE:" is accepted; a date-only UNTIL covers the whole UTC day.
+func ParseRecurrenceRule(value string) (RecurrenceRule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	rule := RecurrenceRule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(value, ";") {
+		key, rawValue, ok := strings.Cut(strings.TrimSpace(part), "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		rawValue = strings.ToUpper(strings.TrimSpace(rawValue))
+		if !ok || rawValue == "" || seen[key] {
+			return RecurrenceRule{}, fmt.Errorf("%w: %q", ErrInvalidRecurrence, part)
+		}
+		seen[key] = true
+		switch key {
+		case "FREQ":
+			rule.Frequency = RecurrenceFrequency(rawValue)
+		case "INTERVAL":
+			interval, err := strconv.Atoi(rawValue)
+			if err != nil || interval < 1 {
+				return RecurrenceRule{}, fmt.Errorf("%w: invalid INTERVAL %q", ErrInvalidRecurrence, rawValue)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(rawValue)
+			if err != nil || count < 1 {
+				return RecurrenceRule{}, fmt.Errorf("%w: invalid COUNT %q", ErrInvalidRecurrence, rawValue)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseRecurrenceUntil(rawValue)
+			if err != nil {
+				return RecurrenceRule{}, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, code := range strings.Split(rawValue, ",") {
+				weekday, known := recurrenceWeekdays[strings.TrimSpace(code)]
+				if !known {
+					return RecurrenceRule{}, fmt.Errorf("%w: unsupported BYDAY %q", ErrInvalidRecurrence, code)
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		case "WKST":
+			if rawValue != "MO" {
+				return RecurrenceRule{}, fmt.Errorf("%w: only WKST=MO is supported", ErrInvalidRecurrence)
+			}
+		default:
+			return RecurrenceRule{}, fmt.Errorf("%w: unsupported %s", ErrInvalidRecurrence, key)
+		}
+	}
+	if err := rule.validate(); err != nil {
+		return RecurrenceRule{}, err
+	}
+	rule.ByDay = sortedWeekdays(rule.ByDay)
+	return rule, nil
+}
+
+func (rule RecurrenceRule) validate() error {
+	switch rule.Frequency {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly, RecurrenceFrequencyYearly:
+	default:
+		return fmt.Errorf("%w: unsupported FREQ %q", ErrInvalidRecurrence, rule.Frequency)
+	}
+	if rule.Interval < 1 || rule.Count < 0 {
+		return ErrInvalidRecurrence
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRecurrence)
+	}
+	if len(rule.ByDay) > 0 && rule.Frequency != RecurrenceFrequencyWeekly {
+		return fmt.Errorf("%w: BYDAY is supported only for WEEKLY rules", ErrInvalidRecurrence)
+	}
+	return nil
+}
+
+// String renders the rule in its canonical RRULE form, without the "RRULE:" prefix.
+func (rule RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(rule.Frequency)}
+	if rule.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(rule.Interval))
+	}
+	if rule.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(rule.Count))
+	}
+	if rule.Until != nil {
+		parts = append(parts, "UNTIL="+rule.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(rule.ByDay) > 0 {
+		codes := make([]string, 0, len(rule.ByDay))
+		for _, weekday := range rule.ByDay {
+			codes = append(codes, strings.ToUpper(weekday.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Recurrence repeats a calendar event from its first occurrence, which is the event time range.
+// Exception dates are the start instants of the occurrences removed from the series.
+type Recurrence struct {
+	Rule           RecurrenceRule
+	ExceptionDates []time.Time
+}
+
+func NewRecurrence(rule string, exceptionDates []time.Time) (Recurrence, error) {
+	parsed, err := ParseRecurrenceRule(rule)
+	if err != nil {
+		return Recurrence{}, err
+	}
+	return Recurrence{Rule: parsed, ExceptionDates: normalizedExceptionDates(exceptionDates)}, nil
+}
+
+// Validate checks that the rule generates first as its first occurrence.
+func (recurrence Recurrence) Validate(first TimeRange) error {
+	if err := recurrence.Rule.validate(); err != nil {
+		return err
+	}
+	valid := false
+	recurrence.each(first, first.Start.Add(time.Nanosecond), func(_ int, occurrence TimeRange) bool {
+		valid = occurrence.Start.Equal(first.Start)
+		return false
+	})
+	if !valid {
+		return fmt.Errorf("%w: the event start does not match the rule", ErrInvalidRecurrence)
+	}
+	return nil
+}
+
+// Occurrences lists the occurrences overlapping [from, to), skipping exception dates.
+func (recurrence Recurrence) Occurrences(first TimeRange, from time.Time, to time.Time) []TimeRange {
+	var occurrences []TimeRange
+	recurrence.each(first, to, func(_ int, occurrence TimeRange) bool {
+		if occurrence.End.After(from) && !recurrence.isException(occurrence.Start) {
+			occurrences = append(occurrences, occurrence)
+		}
+		return true
+	})
+	return occurrences
+}
+
+// Includes reports whether start is the start of an occurrence that was not removed from the series.
+func (recurrence Recurrence) Includes(first TimeRange, start time.Time) bool {
+	return recurrence.indexOf(first, start) >= 0 && !recurrence.isException(start)
+}
+
+// End returns the end of the last occurrence, or nil when the series never ends.
+func (recurrence Recurrence) End(first TimeRange) *time.Time {
+	if recurrence.Rule.Count == 0 && recurrence.Rule.Until == nil {
+		return nil
+	}
+	end := first.End
+	recurrence.each(first, time.Time{}, func(_ int, occurrence TimeRange) bool {
+		end = occurrence.End
+		return true
+	})
+	return &end
+}
+
+// Exclude removes the occurrence starting at start from the series.
+func (recurrence Recurrence) Exclude(first TimeRange, start time.Time) (Recurrence, error) {
+	if !recurrence.Includes(first, start) {
+		return Recurrence{}, ErrInvalidOccurrence
+	}
+	recurrence.ExceptionDates = normalizedExceptionDates(append(append([]time.Time(nil), recurrence.ExceptionDates...), start))
+	return recurrence, nil
+}
+
+// Split divides the series at the occurrence starting at start: the first recurrence ends right before it,
+// the second one repeats the same rule from it, keeping the remaining COUNT and exception dates.
+func (recurrence Recurrence) Split(first TimeRange, start time.Time) (Recurrence, Recurrence, error) {
+	index := recurrence.indexOf(first, start)
+	if index <= 0 {
+		return Recurrence{}, Recurrence{}, ErrInvalidOccurrence
+	}
+	before := Recurrence{Rule: recurrence.Rule}
+	after := Recurrence{Rule: recurrence.Rule}
+	until := start.UTC().Add(-time.Second)
+	before.Rule.Count = 0
+	before.Rule.Until = &until
+	if recurrence.Rule.Count > 0 {
+		after.Rule.Count = recurrence.Rule.Count - index
+	}
+	for _, exceptionDate := range recurrence.ExceptionDates {
+		if exceptionDate.Before(start) {
+			before.ExceptionDates = append(before.ExceptionDates, exceptionDate)
+		} else {
+			after.ExceptionDates = append(after.ExceptionDates, exceptionDate)
+		}
+	}
+	return before, after, nil
+}
+
+func (recurrence Recurrence) indexOf(first TimeRange, start time.Time) int {
+	found := -1
+	recurrence.each(first, start.Add(time.Nanosecond), func(index int, occurrence TimeRange) bool {
+		if occurrence.Start.Equal(start) {
+			found = index
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func (recurrence Recurrence) isException(start time.Time) bool {
+	for _, exceptionDate := range recurrence.ExceptionDates {
+		if exceptionDate.Equal(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// each generates the occurrences in order, with their index in the series, until the rule ends,
+// limit is reached or yield returns false. A zero limit relies on COUNT or UNTIL to stop.
+func (recurrence Recurrence) each(first TimeRange, limit time.Time, yield func(int, TimeRange) bool) {
+	rule := recurrence.Rule
+	location, err := time.LoadLocation(first.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	local := first.Start.In(location)
+	duration := first.End.Sub(first.Start)
+	weekStart := local.Day() - (int(local.Weekday())+6)%7
+	index := 0
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		var base time.Time
+		var candidates []time.Time
+		switch rule.Frequency {
+		case RecurrenceFrequencyDaily:
+			base = wallClock(local, 0, 0, period*rule.Interval)
+			candidates = []time.Time{base}
+		case RecurrenceFrequencyWeekly:
+			base = wallClock(local, 0, 0, weekStart-local.Day()+7*period*rule.Interval)
+			if len(rule.ByDay) == 0 {
+				candidates = []time.Time{wallClock(local, 0, 0, 7*period*rule.Interval)}
+			}
+			for _, weekday := range rule.ByDay {
+				candidates = append(candidates, wallClock(base, 0, 0, (int(weekday)+6)%7))
+			}
+		case RecurrenceFrequencyMonthly:
+			base = wallClock(local, 0, period*rule.Interval, 0)
+			if base.Day() == local.Day() {
+				candidates = []time.Time{base}
+			}
+		case RecurrenceFrequencyYearly:
+			base = wallClock(local, period*rule.Interval, 0, 0)
+			if base.Day() == local.Day() {
+				candidates = []time.Time{base}
+			}
+		default:
+			return
+		}
+		if !limit.IsZero() && !base.Before(limit) {
+			return
+		}
+		for _, candidate := range candidates {
+			if candidate.Before(local) {
+				continue
+			}
+			if rule.Until != nil && candidate.After(*rule.Until) {
+				return
+			}
+			if rule.Count > 0 && index >= rule.Count {
+				return
+			}
+			if !limit.IsZero() && !candidate.Before(limit) {
+				return
+			}
+			start := candidate.UTC()
+			if !yield(index, TimeRange{Start: start, End: start.Add(duration), Timezone: first.Timezone, AllDay: first.AllDay}) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// wallClock moves local by the given calendar units keeping its wall clock time.
+func wallClock(local time.Time, years int, months int, days int) time.Time {
+	return time.Date(local.Year()+years, local.Month()+time.Month(months), local.Day()+days, local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), local.Location())
+}
+
+func parseRecurrenceUntil(value string) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+	if day, err := time.Parse("20060102", value); err == nil {
+		return day.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid UNTIL %q", ErrInvalidRecurrence, value)
+}
+
+func normalizedExceptionDates(dates []time.Time) []time.Time {
+	normalized := make([]time.Time, 0, len(dates))
+	for _, date := range dates {
+		normalized = append(normalized, date.UTC())
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Before(normalized[j]) })
+	out := normalized[:0]
+	for index, date := range normalized {
+		if index == 0 || !date.Equal(normalized[index-1]) {
+			out = append(out, date)
+		}
+	}
+	return out
+}
+
+func sortedWeekdays(weekdays []time.Weekday) []time.Weekday {
+	seen := map[time.Weekday]bool{}
+	out := make([]time.Weekday, 0, len(weekdays))
+	for _, weekday := range weekdays {
+		if !seen[weekday] {
+			seen[weekday] = true
+			out = append(out, weekday)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return (int(out[i])+6)%7 < (int(out[j])+6)%7 })
+	return out
+}
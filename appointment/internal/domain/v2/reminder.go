@@ -1,6 +1,9 @@
 package v2
 
-import "time"
+import (
+	"sort"
+	"time"
+)
 
 type ReminderStatus string
 
@@ -14,7 +17,11 @@ const (
 	ReminderStatusDeleted       ReminderStatus = "deleted"
 )
 
+// AppointmentReminder is one of the reminders of an appointment. Position
+// identifies it within the appointment: reminders are ordered from the
+// earliest to the latest send time, so position 0 has the longest lead.
 type AppointmentReminder struct {
+	Position        int
 	Status          ReminderStatus
 	RemindBefore    time.Duration
 	ScheduledAt     *time.Time
@@ -36,8 +43,28 @@ func NewAppointmentReminder(remindBefore time.Duration, now time.Time) (Appointm
 	}, nil
 }
 
+// NewAppointmentReminders builds the ordered reminder set of an appointment
+// from its lead times. Lead times must be positive and distinct.
+func NewAppointmentReminders(remindBefore []time.Duration, now time.Time) ([]AppointmentReminder, error) {
+	leads := append([]time.Duration(nil), remindBefore...)
+	sort.Slice(leads, func(i, j int) bool { return leads[i] > leads[j] })
+	reminders := make([]AppointmentReminder, 0, len(leads))
+	for position, lead := range leads {
+		if position > 0 && lead == leads[position-1] {
+			return nil, ErrInvalidReminder
+		}
+		reminder, err := NewAppointmentReminder(lead, now)
+		if err != nil {
+			return nil, err
+		}
+		reminder.Position = position
+		reminders = append(reminders, reminder)
+	}
+	return reminders, nil
+}
+
 func ReconstituteAppointmentReminder(reminder AppointmentReminder) (AppointmentReminder, error) {
-	if !reminder.Status.Valid() || reminder.RemindBefore <= 0 {
+	if !reminder.Status.Valid() || reminder.RemindBefore <= 0 || reminder.Position < 0 {
 		return AppointmentReminder{}, ErrInvalidReminder
 	}
 	reminder.ScheduledAt = utcTimePointer(reminder.ScheduledAt)
@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReminderSendWindow restricts the instants at which reminders may go out: a daily
+// interval on the calendar wall clock, minus excluded weekdays and holidays.
+// The zero value allows any instant.
+type ReminderSendWindow struct {
+	hours    OpeningHours
+	holidays map[string]bool
+}
+
+// NewReminderSendWindow allows daily in every weekday except the excluded ones, and
+// on no holiday. Holidays are local dates formatted as 2006-01-02.
+func NewReminderSendWindow(timezone string, daily DailyInterval, excluded []time.Weekday, holidays []string) (ReminderSendWindow, error) {
+	days := make(map[time.Weekday][]DailyInterval, 7)
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		days[weekday] = []DailyInterval{daily}
+	}
+	for _, weekday := range excluded {
+		delete(days, weekday)
+	}
+	if len(days) == 0 {
+		return ReminderSendWindow{}, fmt.Errorf("%w: every weekday is excluded", ErrInvalidSendWindow)
+	}
+	hours, err := NewOpeningHours(timezone, days)
+	if err != nil {
+		return ReminderSendWindow{}, fmt.Errorf("%w: %v", ErrInvalidSendWindow, err)
+	}
+	excludedDates := make(map[string]bool, len(holidays))
+	for _, holiday := range holidays {
+		date, err := time.Parse(time.DateOnly, strings.TrimSpace(holiday))
+		if err != nil {
+			return ReminderSendWindow{}, fmt.Errorf("%w: invalid holiday %q", ErrInvalidSendWindow, holiday)
+		}
+		excludedDates[date.Format(time.DateOnly)] = true
+	}
+	return ReminderSendWindow{hours: hours, holidays: excludedDates}, nil
+}
+
+// ParseReminderSendWindow reads a daily interval such as "08:00-20:00", excluded
+// weekdays such as "sun" and holidays such as "2026-12-25". An empty interval
+// allows the whole day.
+func ParseReminderSendWindow(timezone string, daily string, excludedWeekdays []string, holidays []string) (ReminderSendWindow, error) {
+	interval := DailyInterval{StartMinute: 0, EndMinute: 24 * 60}
+	if daily = strings.TrimSpace(daily); daily != "" {
+		rawStart, rawEnd, ok := strings.Cut(daily, "-")
+		if !ok {
+			return ReminderSendWindow{}, fmt.Errorf("%w: %q", ErrInvalidSendWindow, daily)
+		}
+		start, err := parseMinuteOfDay(rawStart)
+		if err != nil {
+			return ReminderSendWindow{}, fmt.Errorf("%w: %q", ErrInvalidSendWindow, daily)
+		}
+		end, err := parseMinuteOfDay(rawEnd)
+		if err != nil {
+			return ReminderSendWindow{}, fmt.Errorf("%w: %q", ErrInvalidSendWindow, daily)
+		}
+		interval = DailyInterval{StartMinute: start, EndMinute: end}
+	}
+	var excluded []time.Weekday
+	for _, day := range excludedWeekdays {
+		if day = strings.TrimSpace(day); day == "" {
+			continue
+		}
+		weekday, ok := weekdayNames[strings.ToLower(day)]
+		if !ok {
+			return ReminderSendWindow{}, fmt.Errorf("%w: unknown weekday %q", ErrInvalidSendWindow, day)
+		}
+		excluded = append(excluded, weekday)
+	}
+	var dates []string
+	for _, holiday := range holidays {
+		if holiday = strings.TrimSpace(holiday); holiday != "" {
+			dates = append(dates, holiday)
+		}
+	}
+	return NewReminderSendWindow(timezone, interval, excluded, dates)
+}
+
+// LatestAllowed returns the closest allowed instant not after at and not before notBefore.
+// The end of a daily interval is allowed, so a reminder due after hours moves to its closing time.
+func (window ReminderSendWindow) LatestAllowed(at time.Time, notBefore time.Time) (time.Time, bool) {
+	if at.Before(notBefore) {
+		return time.Time{}, false
+	}
+	windows := window.openWindows(notBefore, at.Add(time.Nanosecond))
+	if len(windows) == 0 {
+		return time.Time{}, false
+	}
+	latest := windows[len(windows)-1].End
+	if latest.After(at) {
+		latest = at
+	}
+	return latest.UTC(), true
+}
+
+// EarliestAllowed returns the first allowed instant not before at and not after notAfter.
+func (window ReminderSendWindow) EarliestAllowed(at time.Time, notAfter time.Time) (time.Time, bool) {
+	windows := window.openWindows(at, notAfter.Add(time.Nanosecond))
+	if len(windows) == 0 {
+		return time.Time{}, false
+	}
+	return windows[0].Start.UTC(), true
+}
+
+// openWindows returns the allowed intervals inside [start, end) that do not fall on a holiday.
+func (window ReminderSendWindow) openWindows(start time.Time, end time.Time) []OpenWindow {
+	var open []OpenWindow
+	for _, candidate := range window.hours.Windows(start, end) {
+		if !window.holidays[candidate.Start.In(window.location()).Format(time.DateOnly)] {
+			open = append(open, candidate)
+		}
+	}
+	return open
+}
+
+func (window ReminderSendWindow) location() *time.Location {
+	if window.hours.location == nil {
+		return time.UTC
+	}
+	return window.hours.location
+}
@@ -0,0 +1,181 @@
+package v2
+
+import (
+	"slices"
+	"time"
+)
+
+type WaitlistEntryStatus string
+
+const (
+	WaitlistEntryWaiting WaitlistEntryStatus = "waiting"
+	WaitlistEntryOffered WaitlistEntryStatus = "offered"
+	WaitlistEntryRemoved WaitlistEntryStatus = "removed"
+)
+
+func (status WaitlistEntryStatus) Valid() bool {
+	switch status {
+	case WaitlistEntryWaiting, WaitlistEntryOffered, WaitlistEntryRemoved:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitlistOffer proposes to a waiting customer the time freed by a canceled calendar event.
+type WaitlistOffer struct {
+	CalendarEventID string
+	Start           time.Time
+	End             time.Time
+	OfferedAt       time.Time
+	ExpiresAt       time.Time
+}
+
+// WaitlistEntry is a customer waiting for time to free up in a calendar within Window. An entry keeps its
+// last offer after it expires, and OfferedCalendarEventIDs remembers every canceled event whose time it was
+// offered, so the same freed time is not offered twice to the same customer.
+type WaitlistEntry struct {
+	ID                      string
+	CalendarID              string
+	Customer                CustomerRef
+	Services                []ServiceItem
+	Window                  TimeRange
+	Status                  WaitlistEntryStatus
+	Offer                   *WaitlistOffer
+	OfferedCalendarEventIDs []string
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+}
+
+type WaitlistEntryParams struct {
+	EntryID    string
+	CalendarID string
+	Customer   CustomerRef
+	Services   []ServiceItem
+	Window     TimeRange
+	Now        time.Time
+}
+
+func NewWaitlistEntry(params WaitlistEntryParams) (WaitlistEntry, error) {
+	if params.EntryID == "" || params.Customer.ID == "" {
+		return WaitlistEntry{}, ErrMissingRequiredData
+	}
+	calendarID, err := NormalizeCalendarID(params.CalendarID)
+	if err != nil {
+		return WaitlistEntry{}, err
+	}
+	if !params.Window.End.After(params.Window.Start) || !params.Window.End.After(params.Now) {
+		return WaitlistEntry{}, ErrInvalidWaitlistEntry
+	}
+	if ServicesDuration(params.Services) > params.Window.End.Sub(params.Window.Start) {
+		return WaitlistEntry{}, ErrInvalidWaitlistEntry
+	}
+	return WaitlistEntry{
+		ID:         params.EntryID,
+		CalendarID: calendarID,
+		Customer:   params.Customer,
+		Services:   normalizeServicePositions(params.Services),
+		Window:     params.Window,
+		Status:     WaitlistEntryWaiting,
+		CreatedAt:  params.Now.UTC(),
+		UpdatedAt:  params.Now.UTC(),
+	}, nil
+}
+
+func ReconstituteWaitlistEntry(entry WaitlistEntry) (WaitlistEntry, error) {
+	if entry.ID == "" || entry.Customer.ID == "" || !entry.Status.Valid() {
+		return WaitlistEntry{}, ErrInvalidWaitlistEntry
+	}
+	if entry.Status == WaitlistEntryOffered && entry.Offer == nil {
+		return WaitlistEntry{}, ErrInvalidWaitlistEntry
+	}
+	calendarID, err := NormalizeCalendarID(entry.CalendarID)
+	if err != nil {
+		return WaitlistEntry{}, err
+	}
+	entry.CalendarID = calendarID
+	entry.Services = normalizeServicePositions(entry.Services)
+	return entry, nil
+}
+
+// Fit returns the earliest part of the free range that lies inside the entry window and is long enough for
+// the wanted services. Entries without service durations accept any overlap with their window.
+func (entry WaitlistEntry) Fit(free TimeRange) (TimeRange, bool) {
+	start := laterOf(entry.Window.Start, free.Start)
+	end := earlierOf(entry.Window.End, free.End)
+	if !end.After(start) {
+		return TimeRange{}, false
+	}
+	if duration := ServicesDuration(entry.Services); duration > 0 {
+		if start.Add(duration).After(end) {
+			return TimeRange{}, false
+		}
+		end = start.Add(duration)
+	}
+	return TimeRange{Start: start, End: end, Timezone: entry.Window.Timezone}, true
+}
+
+// WasOffered reports whether the time freed by the calendar event was ever offered to the entry.
+func (entry WaitlistEntry) WasOffered(calendarEventID string) bool {
+	return slices.Contains(entry.OfferedCalendarEventIDs, calendarEventID)
+}
+
+// OpenOffer returns the offer the entry holds at now, or nil when it holds none or the offer expired.
+func (entry WaitlistEntry) OpenOffer(now time.Time) *WaitlistOffer {
+	if entry.Status != WaitlistEntryOffered || entry.Offer == nil || !now.Before(entry.Offer.ExpiresAt) {
+		return nil
+	}
+	return entry.Offer
+}
+
+func (entry *WaitlistEntry) OfferSlot(calendarEventID string, slot TimeRange, ttl time.Duration, now time.Time) error {
+	if entry.Status != WaitlistEntryWaiting || calendarEventID == "" || ttl <= 0 {
+		return ErrInvalidWaitlistEntry
+	}
+	entry.Offer = &WaitlistOffer{
+		CalendarEventID: calendarEventID,
+		Start:           slot.Start.UTC(),
+		End:             slot.End.UTC(),
+		OfferedAt:       now.UTC(),
+		ExpiresAt:       now.Add(ttl).UTC(),
+	}
+	if !entry.WasOffered(calendarEventID) {
+		entry.OfferedCalendarEventIDs = append(entry.OfferedCalendarEventIDs, calendarEventID)
+	}
+	entry.Status = WaitlistEntryOffered
+	entry.UpdatedAt = now.UTC()
+	return nil
+}
+
+// ExpireOffer puts an entry whose offer is past its expiry back in the queue. It reports false when the entry
+// holds no offer or the offer is still valid.
+func (entry *WaitlistEntry) ExpireOffer(now time.Time) bool {
+	if entry.Status != WaitlistEntryOffered || now.Before(entry.Offer.ExpiresAt) {
+		return false
+	}
+	entry.Status = WaitlistEntryWaiting
+	entry.UpdatedAt = now.UTC()
+	return true
+}
+
+func (entry *WaitlistEntry) Remove(now time.Time) {
+	if entry.Status == WaitlistEntryRemoved {
+		return
+	}
+	entry.Status = WaitlistEntryRemoved
+	entry.UpdatedAt = now.UTC()
+}
+
+func laterOf(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlierOf(a time.Time, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,187 @@
+package v2
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MinWebhookSecretLength is the shortest secret accepted to sign webhook deliveries.
+const MinWebhookSecretLength = 16
+
+// WebhookSubscription receives the lifecycle events of the calendar events over HTTP. The deliveries are
+// signed with Secret; EventTypes restricts the delivered lifecycle events, empty means every type.
+type WebhookSubscription struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes []string
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type WebhookSubscriptionParams struct {
+	SubscriptionID string
+	URL            string
+	Secret         string
+	EventTypes     []string
+	Now            time.Time
+}
+
+func NewWebhookSubscription(params WebhookSubscriptionParams) (WebhookSubscription, error) {
+	if params.SubscriptionID == "" {
+		return WebhookSubscription{}, ErrMissingRequiredData
+	}
+	subscription := WebhookSubscription{
+		ID:        params.SubscriptionID,
+		Enabled:   true,
+		CreatedAt: params.Now.UTC(),
+		UpdatedAt: params.Now.UTC(),
+	}
+	if err := subscription.ChangeURL(params.URL, params.Now); err != nil {
+		return WebhookSubscription{}, err
+	}
+	if err := subscription.ChangeSecret(params.Secret, params.Now); err != nil {
+		return WebhookSubscription{}, err
+	}
+	if err := subscription.ChangeEventTypes(params.EventTypes, params.Now); err != nil {
+		return WebhookSubscription{}, err
+	}
+	return subscription, nil
+}
+
+// ChangeURL points the subscription to an absolute http or https URL.
+func (subscription *WebhookSubscription) ChangeURL(rawURL string, now time.Time) error {
+	rawURL = strings.TrimSpace(rawURL)
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidWebhook
+	}
+	subscription.URL = rawURL
+	subscription.UpdatedAt = now.UTC()
+	return nil
+}
+
+func (subscription *WebhookSubscription) ChangeSecret(secret string, now time.Time) error {
+	if len(secret) < MinWebhookSecretLength {
+		return ErrInvalidWebhook
+	}
+	subscription.Secret = secret
+	subscription.UpdatedAt = now.UTC()
+	return nil
+}
+
+func (subscription *WebhookSubscription) ChangeEventTypes(eventTypes []string, now time.Time) error {
+	filter := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !slices.Contains(LifecycleEventTypes, eventType) {
+			return ErrInvalidWebhook
+		}
+		if !slices.Contains(filter, eventType) {
+			filter = append(filter, eventType)
+		}
+	}
+	subscription.EventTypes = filter
+	subscription.UpdatedAt = now.UTC()
+	return nil
+}
+
+func (subscription *WebhookSubscription) SetEnabled(enabled bool, now time.Time) {
+	if subscription.Enabled == enabled {
+		return
+	}
+	subscription.Enabled = enabled
+	subscription.UpdatedAt = now.UTC()
+}
+
+// Accepts reports whether a lifecycle event of eventType is delivered to the subscription.
+func (subscription WebhookSubscription) Accepts(eventType string) bool {
+	return subscription.Enabled && (len(subscription.EventTypes) == 0 || slices.Contains(subscription.EventTypes, eventType))
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+func (status WebhookDeliveryStatus) Valid() bool {
+	switch status {
+	case WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookDelivery is a lifecycle event sent to a subscription and the outcome of its attempts. The payload
+// is encoded once, so every attempt posts the same body.
+type WebhookDelivery struct {
+	ID              string
+	SubscriptionID  string
+	EventType       string
+	CalendarEventID string
+	Payload         []byte
+	Status          WebhookDeliveryStatus
+	Attempts        int
+	// LastResponseStatus is the HTTP status of the last attempt; zero when no response arrived.
+	LastResponseStatus int
+	LastError          string
+	CreatedAt          time.Time
+	LastAttemptAt      *time.Time
+	DeliveredAt        *time.Time
+}
+
+type WebhookDeliveryParams struct {
+	DeliveryID      string
+	SubscriptionID  string
+	EventType       string
+	CalendarEventID string
+	Payload         []byte
+	Now             time.Time
+}
+
+func NewWebhookDelivery(params WebhookDeliveryParams) (WebhookDelivery, error) {
+	if params.DeliveryID == "" || params.SubscriptionID == "" || params.CalendarEventID == "" || len(params.Payload) == 0 {
+		return WebhookDelivery{}, ErrMissingRequiredData
+	}
+	if !slices.Contains(LifecycleEventTypes, params.EventType) {
+		return WebhookDelivery{}, ErrInvalidWebhook
+	}
+	return WebhookDelivery{
+		ID:              params.DeliveryID,
+		SubscriptionID:  params.SubscriptionID,
+		EventType:       params.EventType,
+		CalendarEventID: params.CalendarEventID,
+		Payload:         params.Payload,
+		Status:          WebhookDeliveryPending,
+		CreatedAt:       params.Now.UTC(),
+	}, nil
+}
+
+func (delivery *WebhookDelivery) RecordSuccess(responseStatus int, now time.Time) {
+	delivery.recordAttempt(responseStatus, "", now)
+	delivery.Status = WebhookDeliveryDelivered
+	deliveredAt := now.UTC()
+	delivery.DeliveredAt = &deliveredAt
+}
+
+// RecordFailure records a failed attempt. The delivery stays pending for a retry unless final is set.
+func (delivery *WebhookDelivery) RecordFailure(responseStatus int, message string, final bool, now time.Time) {
+	delivery.recordAttempt(responseStatus, message, now)
+	if final {
+		delivery.Status = WebhookDeliveryFailed
+	}
+}
+
+func (delivery *WebhookDelivery) recordAttempt(responseStatus int, message string, now time.Time) {
+	attemptedAt := now.UTC()
+	delivery.Attempts++
+	delivery.LastResponseStatus = responseStatus
+	delivery.LastError = message
+	delivery.LastAttemptAt = &attemptedAt
+}
@@ -0,0 +1,155 @@
+// Package cloudevents encodes the calendar lifecycle events as structured CloudEvents 1.0, the format
+// published on the public exchange and posted to the webhooks.
+package cloudevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ContentType is the media type of a structured CloudEvent.
+const ContentType = "application/cloudevents+json"
+
+const (
+	specVersion             = "1.0"
+	calendarLifecycleSource = "/beaesthetic/appointment"
+	calendarLifecycleType   = "beaesthetic.appointment.v1."
+)
+
+// event is the structured JSON form of a CloudEvent.
+type event struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewCalendarLifecycleEvent encodes lifecycle as the CloudEvent id, whose data is the
+// CalendarEventLifecycleEvent describing calendarEvent as it was saved. It also returns the event time.
+func NewCalendarLifecycleEvent(id string, calendarEvent domainv2.CalendarEvent, lifecycle domainv2.LifecycleEvent) ([]byte, time.Time, error) {
+	data := calendarLifecycleEventProto(calendarEvent, lifecycle)
+	encoded, err := protojson.Marshal(data)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("marshal calendar lifecycle event data: %w", err)
+	}
+	occurredAt := data.GetOccurredAt().AsTime()
+	payload, err := json.Marshal(event{
+		SpecVersion:     specVersion,
+		ID:              id,
+		Source:          calendarLifecycleSource,
+		Type:            calendarLifecycleType + lifecycle.Type,
+		Subject:         lifecycle.CalendarEventID,
+		Time:            occurredAt,
+		DataContentType: "application/json",
+		Data:            encoded,
+	})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("marshal calendar lifecycle cloud event: %w", err)
+	}
+	return payload, occurredAt, nil
+}
+
+// calendarLifecycleEventProto describes event with the state calendarEvent was saved with.
+func calendarLifecycleEventProto(calendarEvent domainv2.CalendarEvent, event domainv2.LifecycleEvent) *appointmentcontracts.CalendarEventLifecycleEvent {
+	occurredAt := calendarEvent.UpdatedAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	lifecycle := &appointmentcontracts.CalendarEventLifecycleEvent{
+		LifecycleType:   calendarLifecycleTypeProto(event.Type),
+		CalendarEventId: event.CalendarEventID,
+		CalendarId:      calendarEvent.CalendarID,
+		EventType:       calendarEventTypeProto(calendarEvent.Type),
+		TimeRange:       timeRangeProto(calendarEvent.Range),
+		Version:         calendarEvent.Version,
+		OccurredAt:      timestamppb.New(occurredAt.UTC()),
+	}
+	if event.PreviousRange != nil {
+		lifecycle.PreviousTimeRange = timeRangeProto(*event.PreviousRange)
+	}
+	if appointment, ok := calendarEvent.Detail.(domainv2.Appointment); ok {
+		lifecycle.Customer = &appointmentcontracts.CustomerRef{
+			CustomerId:  appointment.Customer.ID,
+			DisplayName: appointment.Customer.DisplayName,
+		}
+		lifecycle.Services = make([]*appointmentcontracts.AppointmentServiceItem, 0, len(appointment.Services))
+		for _, item := range appointment.Services {
+			service := &appointmentcontracts.AppointmentServiceItem{
+				ServiceName:     item.ServiceName,
+				Position:        int32(item.Position),
+				DurationMinutes: int32(item.Duration / time.Minute),
+				BufferMinutes:   int32(item.Buffer / time.Minute),
+			}
+			if item.ServiceID != nil {
+				service.ServiceId = *item.ServiceID
+			}
+			lifecycle.Services = append(lifecycle.Services, service)
+		}
+	}
+	if calendarEvent.Cancellation != nil {
+		lifecycle.CancelReason = cancelReasonProto(calendarEvent.Cancellation.Reason)
+	}
+	return lifecycle
+}
+
+func calendarLifecycleTypeProto(value string) appointmentcontracts.CalendarEventLifecycleType {
+	switch value {
+	case "CalendarEventCreated":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_CREATED
+	case "CalendarEventRescheduled":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_RESCHEDULED
+	case "CalendarEventCanceled":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_CANCELED
+	case "CalendarEventCompleted":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_COMPLETED
+	case "CalendarEventNoShow":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_NO_SHOW
+	case "CalendarEventRemindersChanged":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_REMINDERS_CHANGED
+	default:
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_UNSPECIFIED
+	}
+}
+
+func calendarEventTypeProto(value domainv2.CalendarEventType) appointmentcontracts.CalendarEventType {
+	switch value {
+	case domainv2.CalendarEventTypeAppointment:
+		return appointmentcontracts.CalendarEventType_CALENDAR_EVENT_TYPE_APPOINTMENT
+	case domainv2.CalendarEventTypeManual:
+		return appointmentcontracts.CalendarEventType_CALENDAR_EVENT_TYPE_MANUAL
+	case domainv2.CalendarEventTypeTimeBlock:
+		return appointmentcontracts.CalendarEventType_CALENDAR_EVENT_TYPE_TIME_BLOCK
+	default:
+		return appointmentcontracts.CalendarEventType_CALENDAR_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func cancelReasonProto(value domainv2.CancelReason) appointmentcontracts.CancelReason {
+	switch value {
+	case domainv2.CancelReasonCustomer:
+		return appointmentcontracts.CancelReason_CANCEL_REASON_CUSTOMER_CANCEL
+	case domainv2.CancelReasonDeleted:
+		return appointmentcontracts.CancelReason_CANCEL_REASON_DELETED
+	default:
+		return appointmentcontracts.CancelReason_CANCEL_REASON_UNSPECIFIED
+	}
+}
+
+func timeRangeProto(eventRange domainv2.TimeRange) *appointmentcontracts.TimeRange {
+	return &appointmentcontracts.TimeRange{
+		StartAt:  timestamppb.New(eventRange.Start),
+		EndAt:    timestamppb.New(eventRange.End),
+		Timezone: eventRange.Timezone,
+		AllDay:   eventRange.AllDay,
+	}
+}
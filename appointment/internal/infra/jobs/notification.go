@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+const ExpireAppointmentNotificationsKind = "appointment.expire_notifications"
+
+const expireAppointmentNotificationsBatchSize = 100
+
+type ExpireAppointmentNotificationsArgs struct{}
+
+func (ExpireAppointmentNotificationsArgs) Kind() string {
+	return ExpireAppointmentNotificationsKind
+}
+
+type ExpireAppointmentNotificationsWorker struct {
+	river.WorkerDefaults[ExpireAppointmentNotificationsArgs]
+
+	notifications StaleNotificationExpirer
+	log           *zap.Logger
+}
+
+type StaleNotificationExpirer interface {
+	ExpireStaleNotifications(ctx context.Context, batchSize int) (int, error)
+}
+
+func NewExpireAppointmentNotificationsWorker(notifications StaleNotificationExpirer, log *zap.Logger) *ExpireAppointmentNotificationsWorker {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &ExpireAppointmentNotificationsWorker{
+		notifications: notifications,
+		log:           log.Named("river_expire_appointment_notifications"),
+	}
+}
+
+// Work expires the overdue notifications and logs a warning with their count, so an
+// outcome consumer that stops receiving outcomes can be alerted on.
+func (w *ExpireAppointmentNotificationsWorker) Work(ctx context.Context, job *river.Job[ExpireAppointmentNotificationsArgs]) error {
+	expired, err := w.notifications.ExpireStaleNotifications(ctx, expireAppointmentNotificationsBatchSize)
+	if expired > 0 {
+		w.log.Warn("expired appointment notifications without outcome", zap.Int("expired_notifications", expired))
+	}
+	return err
+}
+
+// NewExpireAppointmentNotificationsPeriodicJob enqueues the expiry on queue every interval;
+// River runs periodic jobs on the elected leader only.
+func NewExpireAppointmentNotificationsPeriodicJob(interval time.Duration, queue string) *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(interval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return ExpireAppointmentNotificationsArgs{}, &river.InsertOpts{Queue: queue}
+		},
+		&river.PeriodicJobOpts{ID: ExpireAppointmentNotificationsKind, RunOnStart: true},
+	)
+}
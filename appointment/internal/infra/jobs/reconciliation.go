@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+const ReconcileAppointmentRemindersKind = "appointment.reconcile_reminders"
+
+type ReconcileAppointmentRemindersArgs struct{}
+
+func (ReconcileAppointmentRemindersArgs) Kind() string {
+	return ReconcileAppointmentRemindersKind
+}
+
+type ReconcileAppointmentRemindersWorker struct {
+	river.WorkerDefaults[ReconcileAppointmentRemindersArgs]
+
+	reminders ReminderReconciler
+	log       *zap.Logger
+}
+
+type ReminderReconciler interface {
+	ReconcileReminders(ctx context.Context) (applicationv2.ReminderDrift, error)
+}
+
+func NewReconcileAppointmentRemindersWorker(reminders ReminderReconciler, log *zap.Logger) *ReconcileAppointmentRemindersWorker {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &ReconcileAppointmentRemindersWorker{
+		reminders: reminders,
+		log:       log.Named("river_reconcile_appointment_reminders"),
+	}
+}
+
+// Work repairs the drift between reminders and their jobs and logs a warning when
+// there was any, so lost or hand-cancelled jobs can be alerted on.
+func (w *ReconcileAppointmentRemindersWorker) Work(ctx context.Context, job *river.Job[ReconcileAppointmentRemindersArgs]) error {
+	drift, err := w.reminders.ReconcileReminders(ctx)
+	if err != nil {
+		return err
+	}
+	if drift.Total() > 0 {
+		w.log.Warn("reconciled appointment reminder jobs",
+			zap.Int("missing_jobs", drift.Missing),
+			zap.Int("stale_jobs", drift.Stale),
+			zap.Int("orphaned_jobs", drift.Orphaned),
+		)
+	}
+	return nil
+}
+
+// NewReconcileAppointmentRemindersPeriodicJob enqueues the reconciliation on queue every interval.
+func NewReconcileAppointmentRemindersPeriodicJob(interval time.Duration, queue string) *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(interval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return ReconcileAppointmentRemindersArgs{}, &river.InsertOpts{Queue: queue}
+		},
+		&river.PeriodicJobOpts{ID: ReconcileAppointmentRemindersKind, RunOnStart: true},
+	)
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"time"
 
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain"
 	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
 	"go.uber.org/zap"
 )
 
@@ -16,6 +18,8 @@ const SendAppointmentReminderKind = "appointment.send_reminder"
 type SendAppointmentReminderArgs struct {
 	EventID         string    `json:"eventId"`
 	ExpectedStartAt time.Time `json:"expectedStartAt"`
+	// Position is the reminder position; jobs enqueued before reminders had positions decode to 0.
+	Position int `json:"position"`
 }
 
 func (SendAppointmentReminderArgs) Kind() string {
@@ -29,7 +33,7 @@ type SendAppointmentReminderWorker struct {
 }
 
 type DueReminderSender interface {
-	SendDueReminder(ctx context.Context, calendarEventID string, expectedStartAt *time.Time) error
+	SendDueReminder(ctx context.Context, calendarEventID string, position int, expectedStartAt *time.Time) error
 }
 
 func NewSendAppointmentReminderWorker(reminders DueReminderSender) *SendAppointmentReminderWorker {
@@ -37,12 +41,13 @@ func NewSendAppointmentReminderWorker(reminders DueReminderSender) *SendAppointm
 }
 
 func (w *SendAppointmentReminderWorker) Work(ctx context.Context, job *river.Job[SendAppointmentReminderArgs]) error {
-	return w.reminders.SendDueReminder(ctx, job.Args.EventID, &job.Args.ExpectedStartAt)
+	return w.reminders.SendDueReminder(ctx, job.Args.EventID, job.Args.Position, &job.Args.ExpectedStartAt)
 }
 
 type JobInserter interface {
 	Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) error
 	CancelByKey(ctx context.Context, kind string, queue string, key string) error
+	ListActive(ctx context.Context, kind string, queue string) ([]*rivertype.JobRow, error)
 }
 
 type ReminderScheduler struct {
@@ -65,38 +70,67 @@ func NewReminderScheduler(inserter JobInserter, queue string, maxAttempts int, l
 }
 
 func (s *ReminderScheduler) ScheduleReminder(ctx context.Context, agendaEvent *domain.AgendaEvent, sendAt time.Time) error {
-	return s.ScheduleCalendarReminder(ctx, agendaEvent.ID, agendaEvent.Start, sendAt)
+	return s.ScheduleCalendarReminder(ctx, agendaEvent.ID, 0, agendaEvent.Start, sendAt)
 }
 
-func (s *ReminderScheduler) ScheduleCalendarReminder(ctx context.Context, calendarEventID string, expectedStartAt time.Time, sendAt time.Time) error {
-	key := appointmentReminderKey(calendarEventID)
+func (s *ReminderScheduler) ScheduleCalendarReminder(ctx context.Context, calendarEventID string, position int, expectedStartAt time.Time, sendAt time.Time) error {
+	key := appointmentReminderKey(calendarEventID, position)
 	if err := s.inserter.CancelByKey(ctx, SendAppointmentReminderKind, s.queue, key); err != nil {
 		return err
 	}
 	return s.inserter.Insert(ctx, SendAppointmentReminderArgs{
 		EventID:         calendarEventID,
 		ExpectedStartAt: expectedStartAt.UTC(),
+		Position:        position,
 	}, &river.InsertOpts{
 		Queue:       s.queue,
 		ScheduledAt: sendAt.UTC(),
 		MaxAttempts: s.maxAttempts,
-		Metadata:    appointmentReminderMetadata(key),
+		Metadata:    idempotencyKeyMetadata(key),
 	})
 }
 
 func (s *ReminderScheduler) UnscheduleReminder(ctx context.Context, agendaEvent *domain.AgendaEvent) error {
-	return s.UnscheduleCalendarReminder(ctx, agendaEvent.ID)
+	return s.UnscheduleCalendarReminder(ctx, agendaEvent.ID, 0)
 }
 
-func (s *ReminderScheduler) UnscheduleCalendarReminder(ctx context.Context, calendarEventID string) error {
-	return s.inserter.CancelByKey(ctx, SendAppointmentReminderKind, s.queue, appointmentReminderKey(calendarEventID))
+func (s *ReminderScheduler) UnscheduleCalendarReminder(ctx context.Context, calendarEventID string, position int) error {
+	return s.inserter.CancelByKey(ctx, SendAppointmentReminderKind, s.queue, appointmentReminderKey(calendarEventID, position))
 }
 
-func appointmentReminderKey(eventID string) string {
-	return fmt.Sprintf("appointment:%s:reminder", eventID)
+// ListCalendarReminderJobs returns the reminder jobs that have not finished,
+// with the reminder they deliver decoded from their arguments.
+func (s *ReminderScheduler) ListCalendarReminderJobs(ctx context.Context) ([]applicationv2.ScheduledCalendarReminder, error) {
+	rows, err := s.inserter.ListActive(ctx, SendAppointmentReminderKind, s.queue)
+	if err != nil {
+		return nil, err
+	}
+	reminders := make([]applicationv2.ScheduledCalendarReminder, 0, len(rows))
+	for _, row := range rows {
+		var args SendAppointmentReminderArgs
+		if err := json.Unmarshal(row.EncodedArgs, &args); err != nil {
+			return nil, fmt.Errorf("decode reminder job %d: %w", row.ID, err)
+		}
+		reminders = append(reminders, applicationv2.ScheduledCalendarReminder{
+			CalendarEventID: args.EventID,
+			Position:        args.Position,
+			ExpectedStartAt: args.ExpectedStartAt.UTC(),
+			SendAt:          row.ScheduledAt.UTC(),
+		})
+	}
+	return reminders, nil
+}
+
+// appointmentReminderKey keeps the single-reminder key for position 0, so
+// rescheduling replaces jobs enqueued before reminders had positions.
+func appointmentReminderKey(eventID string, position int) string {
+	if position == 0 {
+		return fmt.Sprintf("appointment:%s:reminder", eventID)
+	}
+	return fmt.Sprintf("appointment:%s:reminder:%d", eventID, position)
 }
 
-func appointmentReminderMetadata(key string) []byte {
+func idempotencyKeyMetadata(key string) []byte {
 	metadata, _ := json.Marshal(map[string]string{"idempotencyKey": key})
 	return metadata
 }
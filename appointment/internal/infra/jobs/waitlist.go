@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+const ExpireWaitlistOfferKind = "appointment.expire_waitlist_offer"
+
+type ExpireWaitlistOfferArgs struct {
+	EntryID   string    `json:"entryId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (ExpireWaitlistOfferArgs) Kind() string {
+	return ExpireWaitlistOfferKind
+}
+
+type ExpireWaitlistOfferWorker struct {
+	river.WorkerDefaults[ExpireWaitlistOfferArgs]
+
+	waitlist WaitlistOfferExpirer
+}
+
+type WaitlistOfferExpirer interface {
+	ExpireOffer(ctx context.Context, entryID string) error
+}
+
+func NewExpireWaitlistOfferWorker(waitlist WaitlistOfferExpirer) *ExpireWaitlistOfferWorker {
+	return &ExpireWaitlistOfferWorker{waitlist: waitlist}
+}
+
+func (w *ExpireWaitlistOfferWorker) Work(ctx context.Context, job *river.Job[ExpireWaitlistOfferArgs]) error {
+	return w.waitlist.ExpireOffer(ctx, job.Args.EntryID)
+}
+
+type WaitlistOfferScheduler struct {
+	inserter    JobInserter
+	queue       string
+	maxAttempts int
+	log         *zap.Logger
+}
+
+func NewWaitlistOfferScheduler(inserter JobInserter, queue string, maxAttempts int, log *zap.Logger) *WaitlistOfferScheduler {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &WaitlistOfferScheduler{
+		inserter:    inserter,
+		queue:       queue,
+		maxAttempts: maxAttempts,
+		log:         log.Named("river_waitlist_offer_scheduler"),
+	}
+}
+
+func (s *WaitlistOfferScheduler) ScheduleWaitlistOfferExpiry(ctx context.Context, entryID string, expiresAt time.Time) error {
+	key := waitlistOfferExpiryKey(entryID)
+	if err := s.inserter.CancelByKey(ctx, ExpireWaitlistOfferKind, s.queue, key); err != nil {
+		return err
+	}
+	return s.inserter.Insert(ctx, ExpireWaitlistOfferArgs{
+		EntryID:   entryID,
+		ExpiresAt: expiresAt.UTC(),
+	}, &river.InsertOpts{
+		Queue:       s.queue,
+		ScheduledAt: expiresAt.UTC(),
+		MaxAttempts: s.maxAttempts,
+		Metadata:    idempotencyKeyMetadata(key),
+	})
+}
+
+func waitlistOfferExpiryKey(entryID string) string {
+	return fmt.Sprintf("waitlist:%s:offer_expiry", entryID)
+}
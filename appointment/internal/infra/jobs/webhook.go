@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+const DeliverWebhookKind = "appointment.deliver_webhook"
+
+const (
+	webhookFirstRetryDelay = time.Minute
+	webhookMaxRetryDelay   = 6 * time.Hour
+)
+
+type DeliverWebhookArgs struct {
+	DeliveryID string `json:"deliveryId"`
+}
+
+func (DeliverWebhookArgs) Kind() string {
+	return DeliverWebhookKind
+}
+
+type DeliverWebhookWorker struct {
+	river.WorkerDefaults[DeliverWebhookArgs]
+
+	webhooks WebhookDeliverer
+}
+
+type WebhookDeliverer interface {
+	Deliver(ctx context.Context, deliveryID string, lastAttempt bool) error
+}
+
+func NewDeliverWebhookWorker(webhooks WebhookDeliverer) *DeliverWebhookWorker {
+	return &DeliverWebhookWorker{webhooks: webhooks}
+}
+
+func (w *DeliverWebhookWorker) Work(ctx context.Context, job *river.Job[DeliverWebhookArgs]) error {
+	return w.webhooks.Deliver(ctx, job.Args.DeliveryID, job.Attempt >= job.MaxAttempts)
+}
+
+// NextRetry retries a failed delivery after a minute, doubling the delay on each attempt up to six hours.
+func (w *DeliverWebhookWorker) NextRetry(job *river.Job[DeliverWebhookArgs]) time.Time {
+	return time.Now().Add(webhookRetryDelay(job.Attempt))
+}
+
+func webhookRetryDelay(attempt int) time.Duration {
+	delay := webhookFirstRetryDelay
+	for i := 1; i < attempt && delay < webhookMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookMaxRetryDelay)
+}
+
+type WebhookDeliveryScheduler struct {
+	inserter    JobInserter
+	queue       string
+	maxAttempts int
+	log         *zap.Logger
+}
+
+func NewWebhookDeliveryScheduler(inserter JobInserter, queue string, maxAttempts int, log *zap.Logger) *WebhookDeliveryScheduler {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &WebhookDeliveryScheduler{
+		inserter:    inserter,
+		queue:       queue,
+		maxAttempts: maxAttempts,
+		log:         log.Named("river_webhook_delivery_scheduler"),
+	}
+}
+
+func (s *WebhookDeliveryScheduler) ScheduleWebhookDelivery(ctx context.Context, deliveryID string) error {
+	return s.inserter.Insert(ctx, DeliverWebhookArgs{DeliveryID: deliveryID}, &river.InsertOpts{
+		Queue:       s.queue,
+		MaxAttempts: s.maxAttempts,
+		Metadata:    idempotencyKeyMetadata(webhookDeliveryKey(deliveryID)),
+	})
+}
+
+func webhookDeliveryKey(deliveryID string) string {
+	return fmt.Sprintf("webhook:%s:delivery", deliveryID)
+}
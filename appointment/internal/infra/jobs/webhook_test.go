@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebhookRetryDelayDoublesUpToSixHours(t *testing.T) {
+	for _, test := range []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Minute},
+		{attempt: 2, want: 2 * time.Minute},
+		{attempt: 3, want: 4 * time.Minute},
+		{attempt: 9, want: 256 * time.Minute},
+		{attempt: 10, want: 6 * time.Hour},
+		{attempt: 25, want: 6 * time.Hour},
+	} {
+		if got := webhookRetryDelay(test.attempt); got != test.want {
+			t.Errorf("webhookRetryDelay(%d) = %s, want %s", test.attempt, got, test.want)
+		}
+	}
+}
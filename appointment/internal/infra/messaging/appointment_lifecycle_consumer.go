@@ -3,6 +3,7 @@ package messaging
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	outboxamqp "github.com/petretiandrea/outbox-go/pkg/outbox/amqp"
@@ -16,16 +17,37 @@ type LifecycleEventHandler interface {
 	Handle(ctx context.Context, eventType string, calendarEventID string) error
 }
 
+// LifecycleEventHandlers hands every lifecycle event to each handler in order. A failing handler does not
+// stop the following ones; their errors are joined.
+type LifecycleEventHandlers []LifecycleEventHandler
+
+func (handlers LifecycleEventHandlers) Handle(ctx context.Context, eventType string, calendarEventID string) error {
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler.Handle(ctx, eventType, calendarEventID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CloudEventHandler receives the CloudEvent published for a lifecycle event, as it was encoded when the
+// calendar event was saved.
+type CloudEventHandler interface {
+	HandleCloudEvent(ctx context.Context, eventType string, calendarEventID string, cloudEvent []byte) error
+}
+
 type AppointmentLifecycleConsumer struct {
-	handler LifecycleEventHandler
-	log     *zap.Logger
+	handler     LifecycleEventHandler
+	cloudEvents CloudEventHandler
+	log         *zap.Logger
 }
 
-func NewAppointmentLifecycleConsumer(handler LifecycleEventHandler, log *zap.Logger) *AppointmentLifecycleConsumer {
+func NewAppointmentLifecycleConsumer(handler LifecycleEventHandler, cloudEvents CloudEventHandler, log *zap.Logger) *AppointmentLifecycleConsumer {
 	if log == nil {
 		log = zap.NewNop()
 	}
-	return &AppointmentLifecycleConsumer{handler: handler, log: log.Named("appointment_lifecycle_consumer")}
+	return &AppointmentLifecycleConsumer{handler: handler, cloudEvents: cloudEvents, log: log.Named("appointment_lifecycle_consumer")}
 }
 
 func (consumer *AppointmentLifecycleConsumer) Process(ctx context.Context, delivery amqp.Delivery) error {
@@ -40,7 +62,11 @@ func (consumer *AppointmentLifecycleConsumer) Process(ctx context.Context, deliv
 	}
 
 	consumer.log.Info("received appointment lifecycle event", zap.String("event_id", eventID), zap.String("type", event.Type))
-	if err := consumer.handler.Handle(ctx, event.Type, eventID); err != nil {
+	err = consumer.handler.Handle(ctx, event.Type, eventID)
+	if len(event.CloudEvent) > 0 {
+		err = errors.Join(err, consumer.cloudEvents.HandleCloudEvent(ctx, event.Type, eventID, event.CloudEvent))
+	}
+	if err != nil {
 		consumer.log.Error("failed to handle appointment lifecycle event", zap.String("event_id", eventID), zap.String("type", event.Type), zap.Error(err))
 		return nil
 	}
@@ -61,6 +87,7 @@ func appointmentLifecycleEventFromDelivery(delivery amqp.Delivery) (appointmentL
 }
 
 type appointmentLifecycleEvent struct {
-	Type            string `json:"type"`
-	CalendarEventID string `json:"calendarEventId"`
+	Type            string          `json:"type"`
+	CalendarEventID string          `json:"calendarEventId"`
+	CloudEvent      json.RawMessage `json:"cloudEvent"`
 }
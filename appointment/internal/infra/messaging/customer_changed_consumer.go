@@ -0,0 +1,46 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	customercontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/customer"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// CustomerRenamer applies the display name of a customer to their appointments and returns the renamed ones.
+type CustomerRenamer interface {
+	RenameCustomer(ctx context.Context, customerID string, displayName string) ([]string, error)
+}
+
+type CustomerChangedConsumer struct {
+	customers CustomerRenamer
+	log       *zap.Logger
+}
+
+func NewCustomerChangedConsumer(customers CustomerRenamer, log *zap.Logger) *CustomerChangedConsumer {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &CustomerChangedConsumer{customers: customers, log: log.Named("customer_changed_consumer")}
+}
+
+func (consumer *CustomerChangedConsumer) Process(ctx context.Context, delivery amqp.Delivery) error {
+	var event customercontracts.CustomerChanged
+	if err := protojson.Unmarshal(delivery.Body, &event); err != nil {
+		return fmt.Errorf("parse customer changed event: %w", err)
+	}
+	if event.GetCustomerId() == "" {
+		consumer.log.Warn("customer changed message does not contain customerId")
+		return nil
+	}
+	renamed, err := consumer.customers.RenameCustomer(ctx, event.GetCustomerId(), event.GetDisplayName())
+	if err != nil {
+		consumer.log.Error("failed to rename appointment customer", zap.String("customer_id", event.GetCustomerId()), zap.Error(err))
+		return err
+	}
+	consumer.log.Info("renamed appointment customer", zap.String("customer_id", event.GetCustomerId()), zap.Int("appointments", len(renamed)))
+	return nil
+}
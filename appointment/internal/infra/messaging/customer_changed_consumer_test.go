@@ -0,0 +1,71 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	customercontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/customer"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestCustomerChangedConsumerRenamesTheCustomer(t *testing.T) {
+	customers := &customerRenamerStub{}
+	consumer := NewCustomerChangedConsumer(customers, nil)
+
+	if err := consumer.Process(context.Background(), customerChangedDelivery(t)); err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if customers.customerID != "customer-1" || customers.displayName != "Ada Lovelace" {
+		t.Fatalf("renamed %q to %q, want customer-1 renamed to Ada Lovelace", customers.customerID, customers.displayName)
+	}
+}
+
+func TestCustomerChangedConsumerReturnsTheRenameError(t *testing.T) {
+	renameErr := errors.New("database unavailable")
+	consumer := NewCustomerChangedConsumer(&customerRenamerStub{err: renameErr}, nil)
+
+	if err := consumer.Process(context.Background(), customerChangedDelivery(t)); !errors.Is(err, renameErr) {
+		t.Fatalf("Process() error = %v, want the rename error so the message is nacked", err)
+	}
+}
+
+func TestCustomerChangedConsumerSkipsEventsWithoutCustomer(t *testing.T) {
+	consumer := NewCustomerChangedConsumer(nil, nil)
+
+	if err := consumer.Process(context.Background(), amqp.Delivery{Body: []byte(`{"displayName":"Ada Lovelace"}`)}); err != nil {
+		t.Fatalf("Process() error = %v, want the event skipped", err)
+	}
+	if err := consumer.Process(context.Background(), amqp.Delivery{Body: []byte(`not json`)}); err == nil {
+		t.Fatal("Process() error = nil, want a parse error")
+	}
+}
+
+func customerChangedDelivery(t *testing.T) amqp.Delivery {
+	t.Helper()
+	payload, err := protojson.Marshal(&customercontracts.CustomerChanged{
+		CustomerId:  "customer-1",
+		Name:        "Ada",
+		Surname:     "Lovelace",
+		DisplayName: "Ada Lovelace",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return amqp.Delivery{Body: payload}
+}
+
+type customerRenamerStub struct {
+	customerID  string
+	displayName string
+	err         error
+}
+
+func (s *customerRenamerStub) RenameCustomer(_ context.Context, customerID string, displayName string) ([]string, error) {
+	s.customerID, s.displayName = customerID, displayName
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []string{"appointment-1"}, nil
+}
@@ -35,11 +35,15 @@ func (sender *CustomerNotificationSender) SendCalendarNotification(ctx context.C
 	if !ok {
 		return "", fmt.Errorf("calendar event %s is not an appointment", event.ID)
 	}
-	body, err := structpb.NewStruct(map[string]any{
+	values := map[string]any{
 		"eventId": event.ID,
 		"startAt": event.Range.Start.UTC().Format(time.RFC3339),
 		"endAt":   event.Range.End.UTC().Format(time.RFC3339),
-	})
+	}
+	if event.Cancellation != nil {
+		values["cancelReason"] = string(event.Cancellation.Reason)
+	}
+	body, err := structpb.NewStruct(values)
 	if err != nil {
 		return "", fmt.Errorf("build customer notification body: %w", err)
 	}
@@ -52,6 +56,29 @@ func (sender *CustomerNotificationSender) SendCalendarNotification(ctx context.C
 	})
 }
 
+func (sender *CustomerNotificationSender) SendWaitlistOffer(ctx context.Context, entry domainv2.WaitlistEntry, idempotencyKey string) (string, error) {
+	if entry.Offer == nil {
+		return "", fmt.Errorf("waitlist entry %s has no offer", entry.ID)
+	}
+	body, err := structpb.NewStruct(map[string]any{
+		"waitlistEntryId": entry.ID,
+		"calendarId":      entry.CalendarID,
+		"startAt":         entry.Offer.Start.UTC().Format(time.RFC3339),
+		"endAt":           entry.Offer.End.UTC().Format(time.RFC3339),
+		"expiresAt":       entry.Offer.ExpiresAt.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return "", fmt.Errorf("build customer notification body: %w", err)
+	}
+	return sender.publishCustomerNotification(ctx, &notification.CustomerNotificationRequested{
+		IdempotencyKey:      idempotencyKey,
+		CustomerIds:         []string{entry.Customer.ID},
+		NotificationChannel: notification.NotificationChannel_NOTIFICATION_CHANNEL_SMS,
+		NotificationType:    string(domainv2.NotificationTypeWaitlistOffer),
+		Body:                body,
+	})
+}
+
 func (sender *CustomerNotificationSender) SendAppointmentConfirmation(ctx context.Context, agendaEvent *domain.AgendaEvent) (string, error) {
 	return sender.sendAppointmentNotification(ctx, agendaEvent, application.NotificationTypeAppointmentConfirmation)
 }
@@ -96,6 +96,67 @@ func TestCustomerNotificationSenderPublishesV2CalendarNotification(t *testing.T)
 	}
 }
 
+func TestCustomerNotificationSenderIncludesCancelReason(t *testing.T) {
+	publisher := &publisherStub{}
+	sender := NewCustomerNotificationSender(publisher)
+	now := time.Date(2026, time.July, 4, 13, 30, 0, 0, time.UTC)
+	eventRange, err := domainv2.NewTimeRange(now, now.Add(time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	customer, err := domainv2.NewCustomerRef("customer-1", "Jane Doe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := domainv2.NewAppointmentEvent(domainv2.AppointmentEventParams{EventID: "event-1", CalendarID: domainv2.DefaultCalendarID, Range: eventRange, Customer: customer, Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event.Cancel(domainv2.CancelReasonDeleted, now)
+
+	if _, err := sender.SendCalendarNotification(context.Background(), event, domainv2.NotificationTypeAppointmentCanceled, "request-1"); err != nil {
+		t.Fatalf("SendCalendarNotification() error = %v", err)
+	}
+	var payload notification.CustomerNotificationRequested
+	if err := protojson.Unmarshal(publisher.messages[0].Payload, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.GetNotificationType() != string(domainv2.NotificationTypeAppointmentCanceled) || payload.GetBody().GetFields()["cancelReason"].GetStringValue() != "deleted" {
+		t.Fatalf("payload=%#v", payload)
+	}
+}
+
+func TestCustomerNotificationSenderPublishesWaitlistOffer(t *testing.T) {
+	publisher := &publisherStub{}
+	sender := NewCustomerNotificationSender(publisher)
+	now := time.Date(2026, time.July, 4, 13, 30, 0, 0, time.UTC)
+	entry := domainv2.WaitlistEntry{
+		ID:         "entry-1",
+		CalendarID: domainv2.DefaultCalendarID,
+		Customer:   domainv2.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+		Status:     domainv2.WaitlistEntryOffered,
+		Offer:      &domainv2.WaitlistOffer{CalendarEventID: "event-1", Start: now, End: now.Add(time.Hour), OfferedAt: now, ExpiresAt: now.Add(2 * time.Hour)},
+	}
+
+	id, err := sender.SendWaitlistOffer(context.Background(), entry, "waitlist:entry-1:offer:event-1")
+	if err != nil {
+		t.Fatalf("SendWaitlistOffer() error = %v", err)
+	}
+	if id != "waitlist:entry-1:offer:event-1" || len(publisher.messages) != 1 {
+		t.Fatalf("id=%q messages=%d", id, len(publisher.messages))
+	}
+	var payload notification.CustomerNotificationRequested
+	if err := protojson.Unmarshal(publisher.messages[0].Payload, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.GetCustomerIds()[0] != "customer-1" || payload.GetNotificationType() != string(domainv2.NotificationTypeWaitlistOffer) {
+		t.Fatalf("payload=%#v", payload)
+	}
+	if payload.GetBody().GetFields()["expiresAt"].GetStringValue() != "2026-07-04T15:30:00Z" {
+		t.Fatalf("body=%v", payload.GetBody())
+	}
+}
+
 type publisherStub struct {
 	messages []outbox.Message
 }
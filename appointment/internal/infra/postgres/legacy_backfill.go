@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain"
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/postgres/queries"
+)
+
+const legacyTimeBlockDefaultReason = "blocked"
+
+// LegacyBackfillReport counts the legacy agenda events found and backfilled, with the ones that failed and
+// the backfilled events read back differently.
+type LegacyBackfillReport struct {
+	Candidates int
+	Backfilled int
+	Failures   []LegacyBackfillFailure
+	Mismatches []LegacyParityMismatch
+}
+
+type LegacyBackfillFailure struct {
+	AgendaEventID string
+	Err           error
+}
+
+// LegacyParityMismatch is a field read differently from the legacy agenda_events columns and from the
+// detail tables.
+type LegacyParityMismatch struct {
+	AgendaEventID string
+	Field         string
+	Legacy        string
+	Current       string
+}
+
+func (m LegacyParityMismatch) String() string {
+	return fmt.Sprintf("%s %s: legacy=%q current=%q", m.AgendaEventID, m.Field, m.Legacy, m.Current)
+}
+
+// BackfillLegacyAgendaEvents writes the detail rows of the agenda events saved before the detail tables
+// existed, from their legacy agenda_events columns. Each event is backfilled in its own transaction and
+// without lifecycle events; events already backfilled are not selected again, so the backfill can be
+// re-run. Every backfilled event is read back and compared, services and reminder included. With dryRun
+// only the candidates are counted.
+func (r *Repository) BackfillLegacyAgendaEvents(ctx context.Context, dryRun bool) (LegacyBackfillReport, error) {
+	ids, err := queries.New(r.db).FindLegacyAgendaEventIDs(ctx)
+	if err != nil {
+		return LegacyBackfillReport{}, err
+	}
+	report := LegacyBackfillReport{Candidates: len(ids)}
+	if dryRun {
+		return report, nil
+	}
+	for _, id := range ids {
+		if err := r.Tx(ctx, func(ctx context.Context) error {
+			return r.backfillLegacyAgendaEvent(ctx, id)
+		}); err != nil {
+			report.Failures = append(report.Failures, LegacyBackfillFailure{AgendaEventID: id, Err: err})
+			continue
+		}
+		report.Backfilled++
+		mismatches, err := r.verifyLegacyAgendaEvent(ctx, id, true)
+		if err != nil {
+			return report, err
+		}
+		report.Mismatches = append(report.Mismatches, mismatches...)
+	}
+	return report, nil
+}
+
+func (r *Repository) backfillLegacyAgendaEvent(ctx context.Context, id string) error {
+	event, err := r.findLegacyAgendaEvent(ctx, id)
+	if err != nil || event == nil {
+		return err
+	}
+	if err := queries.New(r.db).NormalizeLegacyAgendaEvent(ctx, id); err != nil {
+		return err
+	}
+	if legacyCalendarEventType(event.Type) == domainv2.CalendarEventTypeTimeBlock {
+		return queries.New(r.db).SaveAgendaTimeBlock(ctx, queries.SaveAgendaTimeBlockParams{
+			AgendaEventID: event.ID,
+			Reason:        legacyTimeBlockReason(*event),
+			CreatedAt:     timestamp(event.CreatedAt),
+			UpdatedAt:     timestamp(event.UpdatedAt),
+		})
+	}
+	return r.saveAgendaEventDetails(ctx, event)
+}
+
+// legacyTimeBlockReason is the reason given to a time block saved without its agenda_time_blocks row: the
+// legacy columns keep no reason, so the title stands in for it.
+func legacyTimeBlockReason(event domain.AgendaEvent) string {
+	if reason := strings.TrimSpace(event.Title); reason != "" {
+		return reason
+	}
+	return legacyTimeBlockDefaultReason
+}
+
+// VerifyLegacyAgendaEvents reads every agenda event with the legacy reader and with the calendar event
+// reader and returns the fields they disagree on.
+func (r *Repository) VerifyLegacyAgendaEvents(ctx context.Context) ([]LegacyParityMismatch, error) {
+	ids, err := queries.New(r.db).ListAgendaEventIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var mismatches []LegacyParityMismatch
+	for _, id := range ids {
+		eventMismatches, err := r.verifyLegacyAgendaEvent(ctx, id, false)
+		if err != nil {
+			return nil, err
+		}
+		mismatches = append(mismatches, eventMismatches...)
+	}
+	return mismatches, nil
+}
+
+func (r *Repository) verifyLegacyAgendaEvent(ctx context.Context, id string, details bool) ([]LegacyParityMismatch, error) {
+	legacy, err := r.findLegacyAgendaEvent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := r.FindCalendarEventView(ctx, id)
+	if err != nil || current == nil {
+		return []LegacyParityMismatch{{AgendaEventID: id, Field: "read", Current: fmt.Sprint(err)}}, nil
+	}
+	return compareLegacyAgendaEvent(*legacy, *current, details), nil
+}
+
+// findLegacyAgendaEvent reads the agenda event from the agenda_events columns only.
+func (r *Repository) findLegacyAgendaEvent(ctx context.Context, id string) (*domain.AgendaEvent, error) {
+	row, err := queries.New(r.db).FindAgendaEvent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return agendaEventFromRow(row)
+}
+
+// compareLegacyAgendaEvent compares the fields every writer keeps in agenda_events. Services and reminder
+// are compared with details only: calendar event writes do not update their legacy columns, which are
+// current only right after the backfill.
+func compareLegacyAgendaEvent(legacy domain.AgendaEvent, current applicationv2.CalendarEventView, details bool) []LegacyParityMismatch {
+	var mismatches []LegacyParityMismatch
+	compare := func(field string, legacyValue string, currentValue string) {
+		if legacyValue != currentValue {
+			mismatches = append(mismatches, LegacyParityMismatch{AgendaEventID: legacy.ID, Field: field, Legacy: legacyValue, Current: currentValue})
+		}
+	}
+	event := current.Event
+	compare("type", string(legacyCalendarEventType(legacy.Type)), string(event.Type))
+	compare("start", legacy.Start.UTC().Format(time.RFC3339), event.Range.Start.UTC().Format(time.RFC3339))
+	compare("end", legacy.End.UTC().Format(time.RFC3339), event.Range.End.UTC().Format(time.RFC3339))
+	compare("title", legacy.Title, event.Title)
+	compare("description", legacy.Description, event.Description)
+	var cancelReason string
+	if event.Cancellation != nil {
+		cancelReason = string(event.Cancellation.Reason)
+	}
+	compare("cancel_reason", legacyCancelReason(legacy.CancelReason), cancelReason)
+
+	if detail, ok := event.Detail.(domainv2.Appointment); ok {
+		compare("customer_id", legacy.Attendee.ID, detail.Customer.ID)
+		compare("customer_display_name", legacy.Attendee.DisplayName, detail.Customer.DisplayName)
+		if !details {
+			return mismatches
+		}
+		legacyServices := make([]string, 0, len(legacy.Services))
+		for _, service := range legacy.Services {
+			legacyServices = append(legacyServices, service.Name)
+		}
+		currentServices := make([]string, 0, len(detail.Services))
+		for _, service := range detail.Services {
+			currentServices = append(currentServices, service.ServiceName)
+		}
+		compare("services", strings.Join(legacyServices, ","), strings.Join(currentServices, ","))
+		var status, remindBefore string
+		if len(current.Reminders) > 0 {
+			status = string(current.Reminders[0].Status)
+			remindBefore = current.Reminders[0].RemindBefore.String()
+		}
+		compare("reminder_status", appointmentReminderStatus(legacy.ReminderStatus), status)
+		compare("remind_before", legacy.RemindBefore.String(), remindBefore)
+	}
+	return mismatches
+}
+
+// legacyCalendarEventType maps the legacy event types, including the generic "event" of the oldest rows,
+// to the calendar event types.
+func legacyCalendarEventType(eventType domain.EventType) domainv2.CalendarEventType {
+	switch eventType {
+	case domain.EventTypeAppointment:
+		return domainv2.CalendarEventTypeAppointment
+	case domain.EventTypeGeneric:
+		return domainv2.CalendarEventTypeManual
+	default:
+		return domainv2.CalendarEventType(eventType)
+	}
+}
+
+func legacyCancelReason(reason *domain.CancelReason) string {
+	if reason == nil {
+		return ""
+	}
+	return string(*reason)
+}
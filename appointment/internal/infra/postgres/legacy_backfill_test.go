@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain"
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestCompareLegacyAgendaEventAcceptsTheBackfilledAppointment(t *testing.T) {
+	legacy, current := legacyAppointmentFixture()
+
+	if mismatches := compareLegacyAgendaEvent(legacy, current, true); len(mismatches) != 0 {
+		t.Fatalf("mismatches = %v, want none", mismatches)
+	}
+}
+
+func TestCompareLegacyAgendaEventReportsTheDifferentFields(t *testing.T) {
+	legacy, current := legacyAppointmentFixture()
+	current.Event.Range.End = current.Event.Range.End.Add(30 * time.Minute)
+	current.Reminders[0].Status = domainv2.ReminderStatusSent
+
+	mismatches := compareLegacyAgendaEvent(legacy, current, true)
+	if len(mismatches) != 2 || mismatches[0].Field != "end" || mismatches[1].Field != "reminder_status" {
+		t.Fatalf("mismatches = %v, want end and reminder_status", mismatches)
+	}
+	if mismatches[1].Legacy != "scheduled" || mismatches[1].Current != "sent" {
+		t.Fatalf("reminder mismatch = %v", mismatches[1])
+	}
+
+	if mismatches := compareLegacyAgendaEvent(legacy, current, false); len(mismatches) != 1 || mismatches[0].Field != "end" {
+		t.Fatalf("mismatches without details = %v, want only end", mismatches)
+	}
+}
+
+func TestCompareLegacyAgendaEventReadsGenericEventsAsManual(t *testing.T) {
+	start := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	legacy := domain.AgendaEvent{ID: "event-1", Type: legacyEventType("event"), Title: "Inventory", Start: start, End: start.Add(time.Hour)}
+	current := applicationv2.CalendarEventView{Event: domainv2.CalendarEvent{
+		ID:     "event-1",
+		Type:   domainv2.CalendarEventTypeManual,
+		Range:  domainv2.TimeRange{Start: start, End: start.Add(time.Hour)},
+		Title:  "Inventory",
+		Detail: domainv2.ManualEvent{Title: "Inventory"},
+	}}
+
+	if mismatches := compareLegacyAgendaEvent(legacy, current, true); len(mismatches) != 0 {
+		t.Fatalf("mismatches = %v, want none", mismatches)
+	}
+	if got := legacyEventType("manual"); got != domain.EventTypeGeneric {
+		t.Fatalf("legacyEventType(manual) = %q, want %q", got, domain.EventTypeGeneric)
+	}
+}
+
+func TestCompareLegacyAgendaEventAcceptsTheBackfilledTimeBlock(t *testing.T) {
+	start := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	legacy := domain.AgendaEvent{ID: "event-1", Type: legacyEventType("time_block"), Title: "Holiday", Start: start, End: start.Add(8 * time.Hour)}
+	current := applicationv2.CalendarEventView{Event: domainv2.CalendarEvent{
+		ID:     "event-1",
+		Type:   domainv2.CalendarEventTypeTimeBlock,
+		Range:  domainv2.TimeRange{Start: start, End: start.Add(8 * time.Hour)},
+		Title:  "Holiday",
+		Detail: domainv2.TimeBlock{Reason: legacyTimeBlockReason(legacy)},
+	}}
+
+	if mismatches := compareLegacyAgendaEvent(legacy, current, true); len(mismatches) != 0 {
+		t.Fatalf("mismatches = %v, want none", mismatches)
+	}
+	if reason := legacyTimeBlockReason(legacy); reason != "Holiday" {
+		t.Fatalf("legacyTimeBlockReason() = %q, want the title", reason)
+	}
+	if reason := legacyTimeBlockReason(domain.AgendaEvent{Title: " "}); reason != legacyTimeBlockDefaultReason {
+		t.Fatalf("legacyTimeBlockReason() without title = %q, want %q", reason, legacyTimeBlockDefaultReason)
+	}
+}
+
+func legacyAppointmentFixture() (domain.AgendaEvent, applicationv2.CalendarEventView) {
+	start := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	reason := domain.CancelReasonCustomer
+	legacy := domain.AgendaEvent{
+		ID:             "event-1",
+		Type:           domain.EventTypeAppointment,
+		Title:          "Facial",
+		Start:          start,
+		End:            start.Add(time.Hour),
+		Attendee:       domain.Attendee{ID: "customer-1", DisplayName: "Ada"},
+		Services:       []domain.AppointmentServiceRef{{Name: "Facial"}, {Name: "Massage"}},
+		CancelReason:   &reason,
+		ReminderStatus: domain.ReminderScheduled,
+		RemindBefore:   24 * time.Hour,
+	}
+	current := applicationv2.CalendarEventView{
+		Event: domainv2.CalendarEvent{
+			ID:           "event-1",
+			Type:         domainv2.CalendarEventTypeAppointment,
+			Range:        domainv2.TimeRange{Start: start, End: start.Add(time.Hour)},
+			Title:        "Facial",
+			Cancellation: &domainv2.CalendarEventCancellation{Reason: domainv2.CancelReasonCustomer, CanceledAt: start},
+			Detail: domainv2.Appointment{
+				Customer: domainv2.CustomerRef{ID: "customer-1", DisplayName: "Ada"},
+				Services: []domainv2.ServiceItem{{ServiceName: "Facial"}, {ServiceName: "Massage", Position: 1}},
+			},
+		},
+		Reminders: []domainv2.AppointmentReminder{{Status: domainv2.ReminderStatusScheduled, RemindBefore: 24 * time.Hour}},
+	}
+	return legacy, current
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/cloudevents"
+	"github.com/petretiandrea/outbox-go/pkg/outbox"
+)
+
+// ChannelAppointmentLifecycleEvents carries the public calendar lifecycle events as structured CloudEvents.
+const ChannelAppointmentLifecycleEvents = "beaesthetic.appointments.lifecycle"
+
+func newCalendarLifecycleCloudEventMessage(calendarEvent domainv2.CalendarEvent, event domainv2.LifecycleEvent) (outbox.Message, error) {
+	id := uuid.NewString()
+	payload, occurredAt, err := cloudevents.NewCalendarLifecycleEvent(id, calendarEvent, event)
+	if err != nil {
+		return outbox.Message{}, err
+	}
+	return outbox.Message{
+		ID:          id,
+		Channel:     outbox.Channel(ChannelAppointmentLifecycleEvents),
+		AffinityKey: outbox.AffinityKey(event.CalendarEventID),
+		Payload:     payload,
+		Metadata:    outbox.Metadata{},
+		OccurredAt:  occurredAt,
+	}, nil
+}
@@ -1,4 +1,4 @@
--- name: SaveAgendaEventV2 :exec
+-- name: SaveAgendaEventV2 :one
 INSERT INTO agenda_events (
     id,
     calendar_id,
@@ -22,9 +22,12 @@ INSERT INTO agenda_events (
     remind_before_seconds,
     version,
     created_at,
-    updated_at
+    updated_at,
+    recurrence_rule,
+    recurrence_exception_dates,
+    recurrence_end_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, '[]'::jsonb, $15, $16, 'PENDING', NULL, 0, 1, $17, $18
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, '[]'::jsonb, $15, $16, 'PENDING', NULL, 0, 1, $17, $18, $20, $21, $22
 )
 ON CONFLICT (id) DO UPDATE SET
     calendar_id = $2,
@@ -43,7 +46,12 @@ ON CONFLICT (id) DO UPDATE SET
     cancel_reason = $15,
     canceled_at = $16,
     version = agenda_events.version + 1,
-    updated_at = $18;
+    updated_at = $18,
+    recurrence_rule = $20,
+    recurrence_exception_dates = $21,
+    recurrence_end_at = $22
+WHERE agenda_events.version = $19
+RETURNING version;
 
 -- name: SaveAppointment :exec
 INSERT INTO appointments (
@@ -51,14 +59,18 @@ INSERT INTO appointments (
     customer_id,
     customer_display_name,
     created_at,
-    updated_at
+    updated_at,
+    attendance_status,
+    attendance_recorded_at
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
 ON CONFLICT (agenda_event_id) DO UPDATE SET
     customer_id = $2,
     customer_display_name = $3,
-    updated_at = $5;
+    updated_at = $5,
+    attendance_status = coalesce($6, appointments.attendance_status),
+    attendance_recorded_at = coalesce($7, appointments.attendance_recorded_at);
 
 -- name: DeleteAppointmentServiceItems :exec
 DELETE FROM appointment_service_items
@@ -69,13 +81,17 @@ INSERT INTO appointment_service_items (
     agenda_event_id,
     service_id,
     service_name,
-    position
+    position,
+    duration_seconds,
+    buffer_seconds
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6
 )
 ON CONFLICT (agenda_event_id, position) DO UPDATE SET
     service_id = $2,
-    service_name = $3;
+    service_name = $3,
+    duration_seconds = $5,
+    buffer_seconds = $6;
 
 -- name: SaveAppointmentReminder :exec
 INSERT INTO appointment_reminders (
@@ -87,11 +103,12 @@ INSERT INTO appointment_reminders (
     sent_at,
     failed_at,
     failure_reason,
-    updated_at
+    updated_at,
+    position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
-ON CONFLICT (agenda_event_id) DO UPDATE SET
+ON CONFLICT (agenda_event_id, position) DO UPDATE SET
     status = $2,
     remind_before_seconds = $3,
     scheduled_at = $4,
@@ -145,9 +162,10 @@ INSERT INTO appointment_notifications (
     failure_message,
     created_at,
     completed_at,
-    expires_at
+    expires_at,
+    reminder_position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 )
 ON CONFLICT (correlation_key) DO UPDATE SET
     agenda_event_id = $2,
@@ -160,7 +178,8 @@ ON CONFLICT (correlation_key) DO UPDATE SET
     failure_reason = $9,
     failure_message = $10,
     completed_at = $12,
-    expires_at = $13;
+    expires_at = $13,
+    reminder_position = $14;
 
 -- name: MarkAppointmentNotificationSent :exec
 UPDATE appointment_notifications
@@ -184,11 +203,57 @@ SELECT
     n.failure_message,
     n.created_at,
     n.completed_at,
-    n.expires_at
+    n.expires_at,
+    n.reminder_position
 FROM appointment_notifications n
 JOIN appointments a ON a.agenda_event_id = n.agenda_event_id
 WHERE n.correlation_key = $1;
 
+-- name: FindAppointmentNotifications :many
+SELECT
+    n.correlation_key,
+    a.agenda_event_id,
+    n.notification_kind,
+    n.notification_type,
+    n.status,
+    n.recipient_type,
+    n.recipient_id,
+    n.notification_idempotency_key,
+    n.failure_reason,
+    n.failure_message,
+    n.created_at,
+    n.completed_at,
+    n.expires_at,
+    n.reminder_position
+FROM appointment_notifications n
+JOIN appointments a ON a.agenda_event_id = n.agenda_event_id
+WHERE n.agenda_event_id = $1
+ORDER BY n.created_at ASC, n.correlation_key ASC;
+
+-- name: FindExpiredAppointmentNotifications :many
+SELECT
+    n.correlation_key,
+    a.agenda_event_id,
+    n.notification_kind,
+    n.notification_type,
+    n.status,
+    n.recipient_type,
+    n.recipient_id,
+    n.notification_idempotency_key,
+    n.failure_reason,
+    n.failure_message,
+    n.created_at,
+    n.completed_at,
+    n.expires_at,
+    n.reminder_position
+FROM appointment_notifications n
+JOIN appointments a ON a.agenda_event_id = n.agenda_event_id
+WHERE n.status = 'pending'
+  AND n.expires_at <= @expired_before::timestamptz
+ORDER BY n.expires_at ASC
+LIMIT @max_rows::int
+FOR UPDATE OF n SKIP LOCKED;
+
 -- name: FindAgendaEventFromDetails :one
 SELECT
     e.id,
@@ -208,9 +273,13 @@ SELECT
     e.version,
     e.created_at,
     e.updated_at,
+    e.recurrence_rule,
+    e.recurrence_exception_dates,
     CASE WHEN a.agenda_event_id IS NULL THEN '' ELSE a.agenda_event_id::text END AS agenda_event_id,
     CASE WHEN a.customer_id IS NULL THEN '' ELSE a.customer_id::text END AS customer_id,
     a.customer_display_name,
+    a.attendance_status,
+    a.attendance_recorded_at,
     m.title AS manual_title,
     m.description AS manual_description,
     m.location AS manual_location,
@@ -228,7 +297,9 @@ SELECT
             jsonb_build_object(
                 'Name', si.service_name,
                 'serviceId', si.service_id,
-                'position', si.position
+                'position', si.position,
+                'durationSeconds', si.duration_seconds,
+                'bufferSeconds', si.buffer_seconds
             )
             ORDER BY si.position
         ) FILTER (WHERE si.agenda_event_id IS NOT NULL),
@@ -238,7 +309,7 @@ FROM agenda_events e
 LEFT JOIN appointments a ON a.agenda_event_id = e.id
 LEFT JOIN agenda_manual_events m ON m.agenda_event_id = e.id
 LEFT JOIN agenda_time_blocks tb ON tb.agenda_event_id = e.id
-LEFT JOIN appointment_reminders r ON r.agenda_event_id = a.agenda_event_id
+LEFT JOIN appointment_reminders r ON r.agenda_event_id = a.agenda_event_id AND r.position = 0
 LEFT JOIN appointment_service_items si ON si.agenda_event_id = a.agenda_event_id
 WHERE e.id = $1
 GROUP BY
@@ -259,9 +330,13 @@ GROUP BY
     e.version,
     e.created_at,
     e.updated_at,
+    e.recurrence_rule,
+    e.recurrence_exception_dates,
     a.agenda_event_id,
     a.customer_id,
     a.customer_display_name,
+    a.attendance_status,
+    a.attendance_recorded_at,
     m.title,
     m.description,
     m.location,
@@ -275,16 +350,49 @@ GROUP BY
     r.failure_reason,
     r.updated_at;
 
+-- name: FindAppointmentReminders :many
+SELECT agenda_event_id, status, remind_before_seconds, scheduled_at, sent_requested_at, sent_at, failed_at, failure_reason, updated_at, position
+FROM appointment_reminders
+WHERE agenda_event_id = $1
+ORDER BY position ASC;
+
 -- name: SearchAgendaEventIDsFromDetails :many
 SELECT e.id
 FROM agenda_events e
 LEFT JOIN appointments a ON a.agenda_event_id = e.id
-WHERE e.canceled_at IS NULL
-  AND (@filter_calendar::boolean = false OR e.calendar_id::text = @calendar_id::text)
+WHERE (@filter_calendar::boolean = false OR e.calendar_id::text = ANY(@calendar_ids::text[]))
   AND (@filter_customer::boolean = false OR a.customer_id::text = @customer_id::text)
   AND (@filter_event_types::boolean = false OR e.event_type = ANY(@event_types::text[]))
-  AND (@filter_time_range::boolean = false OR (e.start_at < @end_at::timestamptz AND e.end_at > @start_at::timestamptz))
-ORDER BY e.start_at ASC, e.end_at ASC;
+  AND (@filter_time_range::boolean = false OR (
+      e.start_at < @end_at::timestamptz
+      AND (
+          e.end_at > @start_at::timestamptz
+          OR (e.recurrence_rule IS NOT NULL AND (e.recurrence_end_at IS NULL OR e.recurrence_end_at > @start_at::timestamptz))
+      )
+  ))
+  AND (@include_canceled::boolean = true OR e.canceled_at IS NULL)
+  AND (@filter_text::boolean = false OR (
+      strpos(lower(e.title), lower(@text::text)) > 0
+      OR strpos(lower(e.description), lower(@text::text)) > 0
+      OR strpos(lower(a.customer_display_name), lower(@text::text)) > 0
+      OR EXISTS (
+          SELECT 1
+          FROM appointment_service_items s
+          WHERE s.agenda_event_id = e.id
+            AND strpos(lower(s.service_name), lower(@text::text)) > 0
+      )
+  ))
+  AND (@filter_after::boolean = false
+      OR (@descending::boolean = false AND (e.start_at, e.end_at, e.id::text) > (@after_start_at::timestamptz, @after_end_at::timestamptz, @after_id::text))
+      OR (@descending::boolean = true AND (e.start_at, e.end_at, e.id::text) < (@after_start_at::timestamptz, @after_end_at::timestamptz, @after_id::text)))
+ORDER BY
+    CASE WHEN @descending::boolean = false THEN e.start_at END ASC,
+    CASE WHEN @descending::boolean = false THEN e.end_at END ASC,
+    CASE WHEN @descending::boolean = false THEN e.id::text END ASC,
+    e.start_at DESC,
+    e.end_at DESC,
+    e.id::text DESC
+LIMIT sqlc.narg(limit_count)::int;
 
 -- name: FindFutureAppointmentAgendaEventIDsFromDetails :many
 SELECT e.id
@@ -294,6 +402,15 @@ WHERE e.canceled_at IS NULL
   AND e.start_at >= $1
 ORDER BY e.start_at ASC, e.end_at ASC;
 
+-- name: FindScheduledAppointmentReminders :many
+SELECT r.agenda_event_id, r.position, r.scheduled_at, e.start_at
+FROM appointment_reminders r
+JOIN agenda_events e ON e.id = r.agenda_event_id
+WHERE r.status = 'scheduled'
+  AND e.canceled_at IS NULL
+  AND e.start_at > $1
+ORDER BY e.start_at ASC, r.position ASC;
+
 -- name: MarkAppointmentNotificationFailed :exec
 UPDATE appointment_notifications
 SET status = 'failed',
@@ -301,3 +418,78 @@ SET status = 'failed',
     failure_message = $3,
     completed_at = $4
 WHERE correlation_key = $1;
+
+-- name: RankCustomersByAppointments :many
+SELECT a.customer_id,
+       (array_agg(a.customer_display_name ORDER BY e.start_at DESC))[1]::text AS customer_display_name,
+       count(*) AS appointment_count
+FROM appointments a
+JOIN agenda_events e ON e.id = a.agenda_event_id
+WHERE e.canceled_at IS NULL
+GROUP BY a.customer_id
+ORDER BY appointment_count DESC, a.customer_id ASC
+LIMIT sqlc.arg(limit_count)::int
+OFFSET sqlc.arg(offset_count)::int;
+
+-- name: RankCustomersByCancellations :many
+SELECT a.customer_id,
+       (array_agg(a.customer_display_name ORDER BY e.start_at DESC))[1]::text AS customer_display_name,
+       count(*) AS cancellation_count
+FROM appointments a
+JOIN agenda_events e ON e.id = a.agenda_event_id
+WHERE e.canceled_at IS NOT NULL
+  AND e.cancel_reason = 'customer_cancel'
+GROUP BY a.customer_id
+ORDER BY cancellation_count DESC, a.customer_id ASC
+LIMIT sqlc.arg(limit_count)::int
+OFFSET sqlc.arg(offset_count)::int;
+
+-- name: RankCustomersByNoShows :many
+SELECT a.customer_id,
+       (array_agg(a.customer_display_name ORDER BY e.start_at DESC))[1]::text AS customer_display_name,
+       count(*) FILTER (WHERE a.attendance_status = 'no_show') AS no_show_count,
+       count(*) AS attendance_count
+FROM appointments a
+JOIN agenda_events e ON e.id = a.agenda_event_id
+WHERE e.canceled_at IS NULL
+  AND a.attendance_status IS NOT NULL
+GROUP BY a.customer_id
+HAVING count(*) FILTER (WHERE a.attendance_status = 'no_show') > 0
+ORDER BY no_show_count DESC, attendance_count ASC, a.customer_id ASC
+LIMIT sqlc.arg(limit_count)::int
+OFFSET sqlc.arg(offset_count)::int;
+
+-- name: CountCustomerCancellationsByDayOfWeek :many
+SELECT EXTRACT(ISODOW FROM e.start_at AT TIME ZONE e.timezone)::int AS iso_day_of_week,
+       count(*) AS cancellation_count
+FROM agenda_events e
+JOIN appointments a ON a.agenda_event_id = e.id
+WHERE e.canceled_at IS NOT NULL
+  AND e.cancel_reason = 'customer_cancel'
+GROUP BY iso_day_of_week
+ORDER BY iso_day_of_week;
+
+-- name: ListAppointmentCustomerIDs :many
+SELECT DISTINCT customer_id::text AS customer_id
+FROM appointments
+ORDER BY customer_id;
+
+-- name: UpdateAppointmentCustomerDisplayName :many
+WITH renamed AS (
+    UPDATE appointments a
+    SET customer_display_name = @customer_display_name::text,
+        updated_at = @updated_at
+    FROM agenda_events e
+    WHERE e.id = a.agenda_event_id
+      AND a.customer_id::text = @customer_id::text
+      AND a.customer_display_name <> @customer_display_name::text
+      AND e.end_at > @ends_after
+    RETURNING a.agenda_event_id
+)
+UPDATE agenda_events
+SET attendee_display_name = @customer_display_name::text,
+    updated_at = @updated_at,
+    version = agenda_events.version + 1
+FROM renamed
+WHERE agenda_events.id = renamed.agenda_event_id
+RETURNING agenda_events.id;
@@ -11,6 +11,45 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countCustomerCancellationsByDayOfWeek = `-- name: CountCustomerCancellationsByDayOfWeek :many
+SELECT EXTRACT(ISODOW FROM e.start_at AT TIME ZONE e.timezone)::int AS iso_day_of_week,
+       count(*) AS cancellation_count
+FROM agenda_events e
+JOIN appointments a ON a.agenda_event_id = e.id
+WHERE e.canceled_at IS NOT NULL
+  AND e.cancel_reason = 'customer_cancel'
+GROUP BY iso_day_of_week
+ORDER BY iso_day_of_week
+`
+
+type CountCustomerCancellationsByDayOfWeekRow struct {
+	IsoDayOfWeek      int32 `json:"iso_day_of_week"`
+	CancellationCount int64 `json:"cancellation_count"`
+}
+
+func (q *Queries) CountCustomerCancellationsByDayOfWeek(ctx context.Context) ([]CountCustomerCancellationsByDayOfWeekRow, error) {
+	rows, err := q.db.Query(ctx, countCustomerCancellationsByDayOfWeek)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountCustomerCancellationsByDayOfWeekRow
+	for rows.Next() {
+		var i CountCustomerCancellationsByDayOfWeekRow
+		if err := rows.Scan(
+			&i.IsoDayOfWeek,
+			&i.CancellationCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteAppointmentServiceItems = `-- name: DeleteAppointmentServiceItems :exec
 DELETE FROM appointment_service_items
 WHERE agenda_event_id = $1
@@ -40,9 +79,13 @@ SELECT
     e.version,
     e.created_at,
     e.updated_at,
+    e.recurrence_rule,
+    e.recurrence_exception_dates,
     CASE WHEN a.agenda_event_id IS NULL THEN '' ELSE a.agenda_event_id::text END AS agenda_event_id,
     CASE WHEN a.customer_id IS NULL THEN '' ELSE a.customer_id::text END AS customer_id,
     a.customer_display_name,
+    a.attendance_status,
+    a.attendance_recorded_at,
     m.title AS manual_title,
     m.description AS manual_description,
     m.location AS manual_location,
@@ -60,7 +103,9 @@ SELECT
             jsonb_build_object(
                 'Name', si.service_name,
                 'serviceId', si.service_id,
-                'position', si.position
+                'position', si.position,
+                'durationSeconds', si.duration_seconds,
+                'bufferSeconds', si.buffer_seconds
             )
             ORDER BY si.position
         ) FILTER (WHERE si.agenda_event_id IS NOT NULL),
@@ -70,7 +115,7 @@ FROM agenda_events e
 LEFT JOIN appointments a ON a.agenda_event_id = e.id
 LEFT JOIN agenda_manual_events m ON m.agenda_event_id = e.id
 LEFT JOIN agenda_time_blocks tb ON tb.agenda_event_id = e.id
-LEFT JOIN appointment_reminders r ON r.agenda_event_id = a.agenda_event_id
+LEFT JOIN appointment_reminders r ON r.agenda_event_id = a.agenda_event_id AND r.position = 0
 LEFT JOIN appointment_service_items si ON si.agenda_event_id = a.agenda_event_id
 WHERE e.id = $1
 GROUP BY
@@ -91,9 +136,13 @@ GROUP BY
     e.version,
     e.created_at,
     e.updated_at,
+    e.recurrence_rule,
+    e.recurrence_exception_dates,
     a.agenda_event_id,
     a.customer_id,
     a.customer_display_name,
+    a.attendance_status,
+    a.attendance_recorded_at,
     m.title,
     m.description,
     m.location,
@@ -109,39 +158,43 @@ GROUP BY
 `
 
 type FindAgendaEventFromDetailsRow struct {
-	ID                      string             `json:"id"`
-	CalendarID              string             `json:"calendar_id"`
-	EventType               string             `json:"event_type"`
-	LegacyTitle             string             `json:"legacy_title"`
-	LegacyDescription       string             `json:"legacy_description"`
-	StartAt                 pgtype.Timestamptz `json:"start_at"`
-	EndAt                   pgtype.Timestamptz `json:"end_at"`
-	Timezone                string             `json:"timezone"`
-	AllDay                  bool               `json:"all_day"`
-	DisplayTitle            pgtype.Text        `json:"display_title"`
-	DisplayDescription      pgtype.Text        `json:"display_description"`
-	Visibility              string             `json:"visibility"`
-	CancelReason            pgtype.Text        `json:"cancel_reason"`
-	CanceledAt              pgtype.Timestamptz `json:"canceled_at"`
-	Version                 int64              `json:"version"`
-	CreatedAt               pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
-	AgendaEventID           string             `json:"agenda_event_id"`
-	CustomerID              string             `json:"customer_id"`
-	CustomerDisplayName     pgtype.Text        `json:"customer_display_name"`
-	ManualTitle             pgtype.Text        `json:"manual_title"`
-	ManualDescription       pgtype.Text        `json:"manual_description"`
-	ManualLocation          pgtype.Text        `json:"manual_location"`
-	TimeBlockReason         pgtype.Text        `json:"time_block_reason"`
-	ReminderStatus          pgtype.Text        `json:"reminder_status"`
-	RemindBeforeSeconds     pgtype.Int4        `json:"remind_before_seconds"`
-	ReminderScheduledAt     pgtype.Timestamptz `json:"reminder_scheduled_at"`
-	ReminderSentRequestedAt pgtype.Timestamptz `json:"reminder_sent_requested_at"`
-	ReminderSentAt          pgtype.Timestamptz `json:"reminder_sent_at"`
-	ReminderFailedAt        pgtype.Timestamptz `json:"reminder_failed_at"`
-	ReminderFailureReason   pgtype.Text        `json:"reminder_failure_reason"`
-	ReminderUpdatedAt       pgtype.Timestamptz `json:"reminder_updated_at"`
-	ServicesJson            string             `json:"services_json"`
+	ID                       string               `json:"id"`
+	CalendarID               string               `json:"calendar_id"`
+	EventType                string               `json:"event_type"`
+	LegacyTitle              string               `json:"legacy_title"`
+	LegacyDescription        string               `json:"legacy_description"`
+	StartAt                  pgtype.Timestamptz   `json:"start_at"`
+	EndAt                    pgtype.Timestamptz   `json:"end_at"`
+	Timezone                 string               `json:"timezone"`
+	AllDay                   bool                 `json:"all_day"`
+	DisplayTitle             pgtype.Text          `json:"display_title"`
+	DisplayDescription       pgtype.Text          `json:"display_description"`
+	Visibility               string               `json:"visibility"`
+	CancelReason             pgtype.Text          `json:"cancel_reason"`
+	CanceledAt               pgtype.Timestamptz   `json:"canceled_at"`
+	Version                  int64                `json:"version"`
+	CreatedAt                pgtype.Timestamptz   `json:"created_at"`
+	UpdatedAt                pgtype.Timestamptz   `json:"updated_at"`
+	RecurrenceRule           pgtype.Text          `json:"recurrence_rule"`
+	RecurrenceExceptionDates []pgtype.Timestamptz `json:"recurrence_exception_dates"`
+	AgendaEventID            string               `json:"agenda_event_id"`
+	CustomerID               string               `json:"customer_id"`
+	CustomerDisplayName      pgtype.Text          `json:"customer_display_name"`
+	AttendanceStatus         pgtype.Text          `json:"attendance_status"`
+	AttendanceRecordedAt     pgtype.Timestamptz   `json:"attendance_recorded_at"`
+	ManualTitle              pgtype.Text          `json:"manual_title"`
+	ManualDescription        pgtype.Text          `json:"manual_description"`
+	ManualLocation           pgtype.Text          `json:"manual_location"`
+	TimeBlockReason          pgtype.Text          `json:"time_block_reason"`
+	ReminderStatus           pgtype.Text          `json:"reminder_status"`
+	RemindBeforeSeconds      pgtype.Int4          `json:"remind_before_seconds"`
+	ReminderScheduledAt      pgtype.Timestamptz   `json:"reminder_scheduled_at"`
+	ReminderSentRequestedAt  pgtype.Timestamptz   `json:"reminder_sent_requested_at"`
+	ReminderSentAt           pgtype.Timestamptz   `json:"reminder_sent_at"`
+	ReminderFailedAt         pgtype.Timestamptz   `json:"reminder_failed_at"`
+	ReminderFailureReason    pgtype.Text          `json:"reminder_failure_reason"`
+	ReminderUpdatedAt        pgtype.Timestamptz   `json:"reminder_updated_at"`
+	ServicesJson             string               `json:"services_json"`
 }
 
 func (q *Queries) FindAgendaEventFromDetails(ctx context.Context, id string) (FindAgendaEventFromDetailsRow, error) {
@@ -165,9 +218,13 @@ func (q *Queries) FindAgendaEventFromDetails(ctx context.Context, id string) (Fi
 		&i.Version,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RecurrenceRule,
+		&i.RecurrenceExceptionDates,
 		&i.AgendaEventID,
 		&i.CustomerID,
 		&i.CustomerDisplayName,
+		&i.AttendanceStatus,
+		&i.AttendanceRecordedAt,
 		&i.ManualTitle,
 		&i.ManualDescription,
 		&i.ManualLocation,
@@ -199,7 +256,8 @@ SELECT
     n.failure_message,
     n.created_at,
     n.completed_at,
-    n.expires_at
+    n.expires_at,
+    n.reminder_position
 FROM appointment_notifications n
 JOIN appointments a ON a.agenda_event_id = n.agenda_event_id
 WHERE n.correlation_key = $1
@@ -219,6 +277,7 @@ type FindAppointmentNotificationRow struct {
 	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
 	CompletedAt                pgtype.Timestamptz `json:"completed_at"`
 	ExpiresAt                  pgtype.Timestamptz `json:"expires_at"`
+	ReminderPosition           pgtype.Int4        `json:"reminder_position"`
 }
 
 func (q *Queries) FindAppointmentNotification(ctx context.Context, correlationKey string) (FindAppointmentNotificationRow, error) {
@@ -238,10 +297,205 @@ func (q *Queries) FindAppointmentNotification(ctx context.Context, correlationKe
 		&i.CreatedAt,
 		&i.CompletedAt,
 		&i.ExpiresAt,
+		&i.ReminderPosition,
 	)
 	return i, err
 }
 
+const findAppointmentNotifications = `-- name: FindAppointmentNotifications :many
+SELECT
+    n.correlation_key,
+    a.agenda_event_id,
+    n.notification_kind,
+    n.notification_type,
+    n.status,
+    n.recipient_type,
+    n.recipient_id,
+    n.notification_idempotency_key,
+    n.failure_reason,
+    n.failure_message,
+    n.created_at,
+    n.completed_at,
+    n.expires_at,
+    n.reminder_position
+FROM appointment_notifications n
+JOIN appointments a ON a.agenda_event_id = n.agenda_event_id
+WHERE n.agenda_event_id = $1
+ORDER BY n.created_at ASC, n.correlation_key ASC
+`
+
+type FindAppointmentNotificationsRow struct {
+	CorrelationKey             string             `json:"correlation_key"`
+	AgendaEventID              string             `json:"agenda_event_id"`
+	NotificationKind           string             `json:"notification_kind"`
+	NotificationType           string             `json:"notification_type"`
+	Status                     string             `json:"status"`
+	RecipientType              string             `json:"recipient_type"`
+	RecipientID                string             `json:"recipient_id"`
+	NotificationIdempotencyKey pgtype.Text        `json:"notification_idempotency_key"`
+	FailureReason              pgtype.Text        `json:"failure_reason"`
+	FailureMessage             pgtype.Text        `json:"failure_message"`
+	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
+	CompletedAt                pgtype.Timestamptz `json:"completed_at"`
+	ExpiresAt                  pgtype.Timestamptz `json:"expires_at"`
+	ReminderPosition           pgtype.Int4        `json:"reminder_position"`
+}
+
+func (q *Queries) FindAppointmentNotifications(ctx context.Context, agendaEventID string) ([]FindAppointmentNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, findAppointmentNotifications, agendaEventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindAppointmentNotificationsRow
+	for rows.Next() {
+		var i FindAppointmentNotificationsRow
+		if err := rows.Scan(
+			&i.CorrelationKey,
+			&i.AgendaEventID,
+			&i.NotificationKind,
+			&i.NotificationType,
+			&i.Status,
+			&i.RecipientType,
+			&i.RecipientID,
+			&i.NotificationIdempotencyKey,
+			&i.FailureReason,
+			&i.FailureMessage,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+			&i.ReminderPosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findAppointmentReminders = `-- name: FindAppointmentReminders :many
+SELECT agenda_event_id, status, remind_before_seconds, scheduled_at, sent_requested_at, sent_at, failed_at, failure_reason, updated_at, position
+FROM appointment_reminders
+WHERE agenda_event_id = $1
+ORDER BY position ASC
+`
+
+func (q *Queries) FindAppointmentReminders(ctx context.Context, agendaEventID string) ([]AppointmentReminder, error) {
+	rows, err := q.db.Query(ctx, findAppointmentReminders, agendaEventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AppointmentReminder
+	for rows.Next() {
+		var i AppointmentReminder
+		if err := rows.Scan(
+			&i.AgendaEventID,
+			&i.Status,
+			&i.RemindBeforeSeconds,
+			&i.ScheduledAt,
+			&i.SentRequestedAt,
+			&i.SentAt,
+			&i.FailedAt,
+			&i.FailureReason,
+			&i.UpdatedAt,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findExpiredAppointmentNotifications = `-- name: FindExpiredAppointmentNotifications :many
+SELECT
+    n.correlation_key,
+    a.agenda_event_id,
+    n.notification_kind,
+    n.notification_type,
+    n.status,
+    n.recipient_type,
+    n.recipient_id,
+    n.notification_idempotency_key,
+    n.failure_reason,
+    n.failure_message,
+    n.created_at,
+    n.completed_at,
+    n.expires_at,
+    n.reminder_position
+FROM appointment_notifications n
+JOIN appointments a ON a.agenda_event_id = n.agenda_event_id
+WHERE n.status = 'pending'
+  AND n.expires_at <= $1::timestamptz
+ORDER BY n.expires_at ASC
+LIMIT $2::int
+FOR UPDATE OF n SKIP LOCKED
+`
+
+type FindExpiredAppointmentNotificationsParams struct {
+	ExpiredBefore pgtype.Timestamptz `json:"expired_before"`
+	MaxRows       int32              `json:"max_rows"`
+}
+
+type FindExpiredAppointmentNotificationsRow struct {
+	CorrelationKey             string             `json:"correlation_key"`
+	AgendaEventID              string             `json:"agenda_event_id"`
+	NotificationKind           string             `json:"notification_kind"`
+	NotificationType           string             `json:"notification_type"`
+	Status                     string             `json:"status"`
+	RecipientType              string             `json:"recipient_type"`
+	RecipientID                string             `json:"recipient_id"`
+	NotificationIdempotencyKey pgtype.Text        `json:"notification_idempotency_key"`
+	FailureReason              pgtype.Text        `json:"failure_reason"`
+	FailureMessage             pgtype.Text        `json:"failure_message"`
+	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
+	CompletedAt                pgtype.Timestamptz `json:"completed_at"`
+	ExpiresAt                  pgtype.Timestamptz `json:"expires_at"`
+	ReminderPosition           pgtype.Int4        `json:"reminder_position"`
+}
+
+func (q *Queries) FindExpiredAppointmentNotifications(ctx context.Context, arg FindExpiredAppointmentNotificationsParams) ([]FindExpiredAppointmentNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, findExpiredAppointmentNotifications, arg.ExpiredBefore, arg.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindExpiredAppointmentNotificationsRow
+	for rows.Next() {
+		var i FindExpiredAppointmentNotificationsRow
+		if err := rows.Scan(
+			&i.CorrelationKey,
+			&i.AgendaEventID,
+			&i.NotificationKind,
+			&i.NotificationType,
+			&i.Status,
+			&i.RecipientType,
+			&i.RecipientID,
+			&i.NotificationIdempotencyKey,
+			&i.FailureReason,
+			&i.FailureMessage,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+			&i.ReminderPosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findFutureAppointmentAgendaEventIDsFromDetails = `-- name: FindFutureAppointmentAgendaEventIDsFromDetails :many
 SELECT e.id
 FROM agenda_events e
@@ -271,6 +525,74 @@ func (q *Queries) FindFutureAppointmentAgendaEventIDsFromDetails(ctx context.Con
 	return items, nil
 }
 
+const findScheduledAppointmentReminders = `-- name: FindScheduledAppointmentReminders :many
+SELECT r.agenda_event_id, r.position, r.scheduled_at, e.start_at
+FROM appointment_reminders r
+JOIN agenda_events e ON e.id = r.agenda_event_id
+WHERE r.status = 'scheduled'
+  AND e.canceled_at IS NULL
+  AND e.start_at > $1
+ORDER BY e.start_at ASC, r.position ASC
+`
+
+type FindScheduledAppointmentRemindersRow struct {
+	AgendaEventID string             `json:"agenda_event_id"`
+	Position      int32              `json:"position"`
+	ScheduledAt   pgtype.Timestamptz `json:"scheduled_at"`
+	StartAt       pgtype.Timestamptz `json:"start_at"`
+}
+
+func (q *Queries) FindScheduledAppointmentReminders(ctx context.Context, startAt pgtype.Timestamptz) ([]FindScheduledAppointmentRemindersRow, error) {
+	rows, err := q.db.Query(ctx, findScheduledAppointmentReminders, startAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindScheduledAppointmentRemindersRow
+	for rows.Next() {
+		var i FindScheduledAppointmentRemindersRow
+		if err := rows.Scan(
+			&i.AgendaEventID,
+			&i.Position,
+			&i.ScheduledAt,
+			&i.StartAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAppointmentCustomerIDs = `-- name: ListAppointmentCustomerIDs :many
+SELECT DISTINCT customer_id::text AS customer_id
+FROM appointments
+ORDER BY customer_id
+`
+
+func (q *Queries) ListAppointmentCustomerIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listAppointmentCustomerIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var customer_id string
+		if err := rows.Scan(&customer_id); err != nil {
+			return nil, err
+		}
+		items = append(items, customer_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markAppointmentNotificationFailed = `-- name: MarkAppointmentNotificationFailed :exec
 UPDATE appointment_notifications
 SET status = 'failed',
@@ -316,7 +638,157 @@ func (q *Queries) MarkAppointmentNotificationSent(ctx context.Context, arg MarkA
 	return err
 }
 
-const saveAgendaEventV2 = `-- name: SaveAgendaEventV2 :exec
+const rankCustomersByAppointments = `-- name: RankCustomersByAppointments :many
+SELECT a.customer_id,
+       (array_agg(a.customer_display_name ORDER BY e.start_at DESC))[1]::text AS customer_display_name,
+       count(*) AS appointment_count
+FROM appointments a
+JOIN agenda_events e ON e.id = a.agenda_event_id
+WHERE e.canceled_at IS NULL
+GROUP BY a.customer_id
+ORDER BY appointment_count DESC, a.customer_id ASC
+LIMIT $1::int
+OFFSET $2::int
+`
+
+type RankCustomersByAppointmentsParams struct {
+	LimitCount  int32 `json:"limit_count"`
+	OffsetCount int32 `json:"offset_count"`
+}
+
+type RankCustomersByAppointmentsRow struct {
+	CustomerID          string `json:"customer_id"`
+	CustomerDisplayName string `json:"customer_display_name"`
+	AppointmentCount    int64  `json:"appointment_count"`
+}
+
+func (q *Queries) RankCustomersByAppointments(ctx context.Context, arg RankCustomersByAppointmentsParams) ([]RankCustomersByAppointmentsRow, error) {
+	rows, err := q.db.Query(ctx, rankCustomersByAppointments, arg.LimitCount, arg.OffsetCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankCustomersByAppointmentsRow
+	for rows.Next() {
+		var i RankCustomersByAppointmentsRow
+		if err := rows.Scan(
+			&i.CustomerID,
+			&i.CustomerDisplayName,
+			&i.AppointmentCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rankCustomersByCancellations = `-- name: RankCustomersByCancellations :many
+SELECT a.customer_id,
+       (array_agg(a.customer_display_name ORDER BY e.start_at DESC))[1]::text AS customer_display_name,
+       count(*) AS cancellation_count
+FROM appointments a
+JOIN agenda_events e ON e.id = a.agenda_event_id
+WHERE e.canceled_at IS NOT NULL
+  AND e.cancel_reason = 'customer_cancel'
+GROUP BY a.customer_id
+ORDER BY cancellation_count DESC, a.customer_id ASC
+LIMIT $1::int
+OFFSET $2::int
+`
+
+type RankCustomersByCancellationsParams struct {
+	LimitCount  int32 `json:"limit_count"`
+	OffsetCount int32 `json:"offset_count"`
+}
+
+type RankCustomersByCancellationsRow struct {
+	CustomerID          string `json:"customer_id"`
+	CustomerDisplayName string `json:"customer_display_name"`
+	CancellationCount   int64  `json:"cancellation_count"`
+}
+
+func (q *Queries) RankCustomersByCancellations(ctx context.Context, arg RankCustomersByCancellationsParams) ([]RankCustomersByCancellationsRow, error) {
+	rows, err := q.db.Query(ctx, rankCustomersByCancellations, arg.LimitCount, arg.OffsetCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankCustomersByCancellationsRow
+	for rows.Next() {
+		var i RankCustomersByCancellationsRow
+		if err := rows.Scan(
+			&i.CustomerID,
+			&i.CustomerDisplayName,
+			&i.CancellationCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rankCustomersByNoShows = `-- name: RankCustomersByNoShows :many
+SELECT a.customer_id,
+       (array_agg(a.customer_display_name ORDER BY e.start_at DESC))[1]::text AS customer_display_name,
+       count(*) FILTER (WHERE a.attendance_status = 'no_show') AS no_show_count,
+       count(*) AS attendance_count
+FROM appointments a
+JOIN agenda_events e ON e.id = a.agenda_event_id
+WHERE e.canceled_at IS NULL
+  AND a.attendance_status IS NOT NULL
+GROUP BY a.customer_id
+HAVING count(*) FILTER (WHERE a.attendance_status = 'no_show') > 0
+ORDER BY no_show_count DESC, attendance_count ASC, a.customer_id ASC
+LIMIT $1::int
+OFFSET $2::int
+`
+
+type RankCustomersByNoShowsParams struct {
+	LimitCount  int32 `json:"limit_count"`
+	OffsetCount int32 `json:"offset_count"`
+}
+
+type RankCustomersByNoShowsRow struct {
+	CustomerID          string `json:"customer_id"`
+	CustomerDisplayName string `json:"customer_display_name"`
+	NoShowCount         int64  `json:"no_show_count"`
+	AttendanceCount     int64  `json:"attendance_count"`
+}
+
+func (q *Queries) RankCustomersByNoShows(ctx context.Context, arg RankCustomersByNoShowsParams) ([]RankCustomersByNoShowsRow, error) {
+	rows, err := q.db.Query(ctx, rankCustomersByNoShows, arg.LimitCount, arg.OffsetCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankCustomersByNoShowsRow
+	for rows.Next() {
+		var i RankCustomersByNoShowsRow
+		if err := rows.Scan(
+			&i.CustomerID,
+			&i.CustomerDisplayName,
+			&i.NoShowCount,
+			&i.AttendanceCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveAgendaEventV2 = `-- name: SaveAgendaEventV2 :one
 INSERT INTO agenda_events (
     id,
     calendar_id,
@@ -340,9 +812,12 @@ INSERT INTO agenda_events (
     remind_before_seconds,
     version,
     created_at,
-    updated_at
+    updated_at,
+    recurrence_rule,
+    recurrence_exception_dates,
+    recurrence_end_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, '[]'::jsonb, $15, $16, 'PENDING', NULL, 0, 1, $17, $18
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, '[]'::jsonb, $15, $16, 'PENDING', NULL, 0, 1, $17, $18, $20, $21, $22
 )
 ON CONFLICT (id) DO UPDATE SET
     calendar_id = $2,
@@ -361,32 +836,41 @@ ON CONFLICT (id) DO UPDATE SET
     cancel_reason = $15,
     canceled_at = $16,
     version = agenda_events.version + 1,
-    updated_at = $18
+    updated_at = $18,
+    recurrence_rule = $20,
+    recurrence_exception_dates = $21,
+    recurrence_end_at = $22
+WHERE agenda_events.version = $19
+RETURNING version
 `
 
 type SaveAgendaEventV2Params struct {
-	ID                  string             `json:"id"`
-	CalendarID          string             `json:"calendar_id"`
-	EventType           string             `json:"event_type"`
-	Title               string             `json:"title"`
-	Description         string             `json:"description"`
-	StartAt             pgtype.Timestamptz `json:"start_at"`
-	EndAt               pgtype.Timestamptz `json:"end_at"`
-	Timezone            string             `json:"timezone"`
-	AllDay              bool               `json:"all_day"`
-	DisplayTitle        pgtype.Text        `json:"display_title"`
-	DisplayDescription  pgtype.Text        `json:"display_description"`
-	Visibility          string             `json:"visibility"`
-	AttendeeID          string             `json:"attendee_id"`
-	AttendeeDisplayName string             `json:"attendee_display_name"`
-	CancelReason        pgtype.Text        `json:"cancel_reason"`
-	CanceledAt          pgtype.Timestamptz `json:"canceled_at"`
-	CreatedAt           pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	ID                       string               `json:"id"`
+	CalendarID               string               `json:"calendar_id"`
+	EventType                string               `json:"event_type"`
+	Title                    string               `json:"title"`
+	Description              string               `json:"description"`
+	StartAt                  pgtype.Timestamptz   `json:"start_at"`
+	EndAt                    pgtype.Timestamptz   `json:"end_at"`
+	Timezone                 string               `json:"timezone"`
+	AllDay                   bool                 `json:"all_day"`
+	DisplayTitle             pgtype.Text          `json:"display_title"`
+	DisplayDescription       pgtype.Text          `json:"display_description"`
+	Visibility               string               `json:"visibility"`
+	AttendeeID               string               `json:"attendee_id"`
+	AttendeeDisplayName      string               `json:"attendee_display_name"`
+	CancelReason             pgtype.Text          `json:"cancel_reason"`
+	CanceledAt               pgtype.Timestamptz   `json:"canceled_at"`
+	CreatedAt                pgtype.Timestamptz   `json:"created_at"`
+	UpdatedAt                pgtype.Timestamptz   `json:"updated_at"`
+	Version                  int64                `json:"version"`
+	RecurrenceRule           pgtype.Text          `json:"recurrence_rule"`
+	RecurrenceExceptionDates []pgtype.Timestamptz `json:"recurrence_exception_dates"`
+	RecurrenceEndAt          pgtype.Timestamptz   `json:"recurrence_end_at"`
 }
 
-func (q *Queries) SaveAgendaEventV2(ctx context.Context, arg SaveAgendaEventV2Params) error {
-	_, err := q.db.Exec(ctx, saveAgendaEventV2,
+func (q *Queries) SaveAgendaEventV2(ctx context.Context, arg SaveAgendaEventV2Params) (int64, error) {
+	row := q.db.QueryRow(ctx, saveAgendaEventV2,
 		arg.ID,
 		arg.CalendarID,
 		arg.EventType,
@@ -405,8 +889,14 @@ func (q *Queries) SaveAgendaEventV2(ctx context.Context, arg SaveAgendaEventV2Pa
 		arg.CanceledAt,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Version,
+		arg.RecurrenceRule,
+		arg.RecurrenceExceptionDates,
+		arg.RecurrenceEndAt,
 	)
-	return err
+	var version int64
+	err := row.Scan(&version)
+	return version, err
 }
 
 const saveAgendaManualEvent = `-- name: SaveAgendaManualEvent :exec
@@ -485,22 +975,28 @@ INSERT INTO appointments (
     customer_id,
     customer_display_name,
     created_at,
-    updated_at
+    updated_at,
+    attendance_status,
+    attendance_recorded_at
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
 ON CONFLICT (agenda_event_id) DO UPDATE SET
     customer_id = $2,
     customer_display_name = $3,
-    updated_at = $5
+    updated_at = $5,
+    attendance_status = coalesce($6, appointments.attendance_status),
+    attendance_recorded_at = coalesce($7, appointments.attendance_recorded_at)
 `
 
 type SaveAppointmentParams struct {
-	AgendaEventID       string             `json:"agenda_event_id"`
-	CustomerID          string             `json:"customer_id"`
-	CustomerDisplayName string             `json:"customer_display_name"`
-	CreatedAt           pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	AgendaEventID        string             `json:"agenda_event_id"`
+	CustomerID           string             `json:"customer_id"`
+	CustomerDisplayName  string             `json:"customer_display_name"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	AttendanceStatus     pgtype.Text        `json:"attendance_status"`
+	AttendanceRecordedAt pgtype.Timestamptz `json:"attendance_recorded_at"`
 }
 
 func (q *Queries) SaveAppointment(ctx context.Context, arg SaveAppointmentParams) error {
@@ -510,6 +1006,8 @@ func (q *Queries) SaveAppointment(ctx context.Context, arg SaveAppointmentParams
 		arg.CustomerDisplayName,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.AttendanceStatus,
+		arg.AttendanceRecordedAt,
 	)
 	return err
 }
@@ -528,9 +1026,10 @@ INSERT INTO appointment_notifications (
     failure_message,
     created_at,
     completed_at,
-    expires_at
+    expires_at,
+    reminder_position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 )
 ON CONFLICT (correlation_key) DO UPDATE SET
     agenda_event_id = $2,
@@ -543,7 +1042,8 @@ ON CONFLICT (correlation_key) DO UPDATE SET
     failure_reason = $9,
     failure_message = $10,
     completed_at = $12,
-    expires_at = $13
+    expires_at = $13,
+    reminder_position = $14
 `
 
 type SaveAppointmentNotificationParams struct {
@@ -560,6 +1060,7 @@ type SaveAppointmentNotificationParams struct {
 	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
 	CompletedAt                pgtype.Timestamptz `json:"completed_at"`
 	ExpiresAt                  pgtype.Timestamptz `json:"expires_at"`
+	ReminderPosition           pgtype.Int4        `json:"reminder_position"`
 }
 
 func (q *Queries) SaveAppointmentNotification(ctx context.Context, arg SaveAppointmentNotificationParams) error {
@@ -577,6 +1078,7 @@ func (q *Queries) SaveAppointmentNotification(ctx context.Context, arg SaveAppoi
 		arg.CreatedAt,
 		arg.CompletedAt,
 		arg.ExpiresAt,
+		arg.ReminderPosition,
 	)
 	return err
 }
@@ -591,11 +1093,12 @@ INSERT INTO appointment_reminders (
     sent_at,
     failed_at,
     failure_reason,
-    updated_at
+    updated_at,
+    position
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
-ON CONFLICT (agenda_event_id) DO UPDATE SET
+ON CONFLICT (agenda_event_id, position) DO UPDATE SET
     status = $2,
     remind_before_seconds = $3,
     scheduled_at = $4,
@@ -616,6 +1119,7 @@ type SaveAppointmentReminderParams struct {
 	FailedAt            pgtype.Timestamptz `json:"failed_at"`
 	FailureReason       pgtype.Text        `json:"failure_reason"`
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	Position            int32              `json:"position"`
 }
 
 func (q *Queries) SaveAppointmentReminder(ctx context.Context, arg SaveAppointmentReminderParams) error {
@@ -629,6 +1133,7 @@ func (q *Queries) SaveAppointmentReminder(ctx context.Context, arg SaveAppointme
 		arg.FailedAt,
 		arg.FailureReason,
 		arg.UpdatedAt,
+		arg.Position,
 	)
 	return err
 }
@@ -638,20 +1143,26 @@ INSERT INTO appointment_service_items (
     agenda_event_id,
     service_id,
     service_name,
-    position
+    position,
+    duration_seconds,
+    buffer_seconds
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6
 )
 ON CONFLICT (agenda_event_id, position) DO UPDATE SET
     service_id = $2,
-    service_name = $3
+    service_name = $3,
+    duration_seconds = $5,
+    buffer_seconds = $6
 `
 
 type SaveAppointmentServiceItemParams struct {
-	AgendaEventID string      `json:"agenda_event_id"`
-	ServiceID     pgtype.Text `json:"service_id"`
-	ServiceName   string      `json:"service_name"`
-	Position      int32       `json:"position"`
+	AgendaEventID   string      `json:"agenda_event_id"`
+	ServiceID       pgtype.Text `json:"service_id"`
+	ServiceName     string      `json:"service_name"`
+	Position        int32       `json:"position"`
+	DurationSeconds int32       `json:"duration_seconds"`
+	BufferSeconds   int32       `json:"buffer_seconds"`
 }
 
 func (q *Queries) SaveAppointmentServiceItem(ctx context.Context, arg SaveAppointmentServiceItemParams) error {
@@ -660,6 +1171,8 @@ func (q *Queries) SaveAppointmentServiceItem(ctx context.Context, arg SaveAppoin
 		arg.ServiceID,
 		arg.ServiceName,
 		arg.Position,
+		arg.DurationSeconds,
+		arg.BufferSeconds,
 	)
 	return err
 }
@@ -668,17 +1181,44 @@ const searchAgendaEventIDsFromDetails = `-- name: SearchAgendaEventIDsFromDetail
 SELECT e.id
 FROM agenda_events e
 LEFT JOIN appointments a ON a.agenda_event_id = e.id
-WHERE e.canceled_at IS NULL
-  AND ($1::boolean = false OR e.calendar_id::text = $2::text)
+WHERE ($1::boolean = false OR e.calendar_id::text = ANY($2::text[]))
   AND ($3::boolean = false OR a.customer_id::text = $4::text)
   AND ($5::boolean = false OR e.event_type = ANY($6::text[]))
-  AND ($7::boolean = false OR (e.start_at < $8::timestamptz AND e.end_at > $9::timestamptz))
-ORDER BY e.start_at ASC, e.end_at ASC
+  AND ($7::boolean = false OR (
+      e.start_at < $8::timestamptz
+      AND (
+          e.end_at > $9::timestamptz
+          OR (e.recurrence_rule IS NOT NULL AND (e.recurrence_end_at IS NULL OR e.recurrence_end_at > $9::timestamptz))
+      )
+  ))
+  AND ($10::boolean = true OR e.canceled_at IS NULL)
+  AND ($11::boolean = false OR (
+      strpos(lower(e.title), lower($12::text)) > 0
+      OR strpos(lower(e.description), lower($12::text)) > 0
+      OR strpos(lower(a.customer_display_name), lower($12::text)) > 0
+      OR EXISTS (
+          SELECT 1
+          FROM appointment_service_items s
+          WHERE s.agenda_event_id = e.id
+            AND strpos(lower(s.service_name), lower($12::text)) > 0
+      )
+  ))
+  AND ($13::boolean = false
+      OR ($14::boolean = false AND (e.start_at, e.end_at, e.id::text) > ($15::timestamptz, $16::timestamptz, $17::text))
+      OR ($14::boolean = true AND (e.start_at, e.end_at, e.id::text) < ($15::timestamptz, $16::timestamptz, $17::text)))
+ORDER BY
+    CASE WHEN $14::boolean = false THEN e.start_at END ASC,
+    CASE WHEN $14::boolean = false THEN e.end_at END ASC,
+    CASE WHEN $14::boolean = false THEN e.id::text END ASC,
+    e.start_at DESC,
+    e.end_at DESC,
+    e.id::text DESC
+LIMIT $18::int
 `
 
 type SearchAgendaEventIDsFromDetailsParams struct {
 	FilterCalendar   bool               `json:"filter_calendar"`
-	CalendarID       string             `json:"calendar_id"`
+	CalendarIds      []string           `json:"calendar_ids"`
 	FilterCustomer   bool               `json:"filter_customer"`
 	CustomerID       string             `json:"customer_id"`
 	FilterEventTypes bool               `json:"filter_event_types"`
@@ -686,12 +1226,21 @@ type SearchAgendaEventIDsFromDetailsParams struct {
 	FilterTimeRange  bool               `json:"filter_time_range"`
 	EndAt            pgtype.Timestamptz `json:"end_at"`
 	StartAt          pgtype.Timestamptz `json:"start_at"`
+	IncludeCanceled  bool               `json:"include_canceled"`
+	FilterText       bool               `json:"filter_text"`
+	Text             string             `json:"text"`
+	FilterAfter      bool               `json:"filter_after"`
+	Descending       bool               `json:"descending"`
+	AfterStartAt     pgtype.Timestamptz `json:"after_start_at"`
+	AfterEndAt       pgtype.Timestamptz `json:"after_end_at"`
+	AfterID          string             `json:"after_id"`
+	LimitCount       pgtype.Int4        `json:"limit_count"`
 }
 
 func (q *Queries) SearchAgendaEventIDsFromDetails(ctx context.Context, arg SearchAgendaEventIDsFromDetailsParams) ([]string, error) {
 	rows, err := q.db.Query(ctx, searchAgendaEventIDsFromDetails,
 		arg.FilterCalendar,
-		arg.CalendarID,
+		arg.CalendarIds,
 		arg.FilterCustomer,
 		arg.CustomerID,
 		arg.FilterEventTypes,
@@ -699,6 +1248,68 @@ func (q *Queries) SearchAgendaEventIDsFromDetails(ctx context.Context, arg Searc
 		arg.FilterTimeRange,
 		arg.EndAt,
 		arg.StartAt,
+		arg.IncludeCanceled,
+		arg.FilterText,
+		arg.Text,
+		arg.FilterAfter,
+		arg.Descending,
+		arg.AfterStartAt,
+		arg.AfterEndAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAppointmentCustomerDisplayName = `-- name: UpdateAppointmentCustomerDisplayName :many
+WITH renamed AS (
+    UPDATE appointments a
+    SET customer_display_name = $1::text,
+        updated_at = $2
+    FROM agenda_events e
+    WHERE e.id = a.agenda_event_id
+      AND a.customer_id::text = $3::text
+      AND a.customer_display_name <> $1::text
+      AND e.end_at > $4
+    RETURNING a.agenda_event_id
+)
+UPDATE agenda_events
+SET attendee_display_name = $1::text,
+    updated_at = $2,
+    version = agenda_events.version + 1
+FROM renamed
+WHERE agenda_events.id = renamed.agenda_event_id
+RETURNING agenda_events.id
+`
+
+type UpdateAppointmentCustomerDisplayNameParams struct {
+	CustomerDisplayName string             `json:"customer_display_name"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	CustomerID          string             `json:"customer_id"`
+	EndsAfter           pgtype.Timestamptz `json:"ends_after"`
+}
+
+func (q *Queries) UpdateAppointmentCustomerDisplayName(ctx context.Context, arg UpdateAppointmentCustomerDisplayNameParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, updateAppointmentCustomerDisplayName,
+		arg.CustomerDisplayName,
+		arg.UpdatedAt,
+		arg.CustomerID,
+		arg.EndsAfter,
 	)
 	if err != nil {
 		return nil, err
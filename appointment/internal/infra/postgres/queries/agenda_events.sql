@@ -76,3 +76,30 @@ WHERE cancel_reason IS NULL
   AND event_type = $1
   AND start_at >= $2
 ORDER BY start_at ASC;
+
+-- name: FindLegacyAgendaEventIDs :many
+SELECT e.id
+FROM agenda_events e
+LEFT JOIN appointments a ON a.agenda_event_id = e.id
+LEFT JOIN agenda_manual_events m ON m.agenda_event_id = e.id
+LEFT JOIN agenda_time_blocks tb ON tb.agenda_event_id = e.id
+WHERE e.event_type = 'event'
+   OR e.display_title IS NULL
+   OR (e.cancel_reason IS NOT NULL AND e.canceled_at IS NULL)
+   OR (e.event_type = 'appointment' AND a.agenda_event_id IS NULL)
+   OR (e.event_type = 'manual' AND m.agenda_event_id IS NULL)
+   OR (e.event_type = 'time_block' AND tb.agenda_event_id IS NULL)
+ORDER BY e.created_at ASC, e.id ASC;
+
+-- name: ListAgendaEventIDs :many
+SELECT id
+FROM agenda_events
+ORDER BY created_at ASC, id ASC;
+
+-- name: NormalizeLegacyAgendaEvent :exec
+UPDATE agenda_events
+SET event_type = CASE WHEN event_type = 'event' THEN 'manual' ELSE event_type END,
+    display_title = coalesce(display_title, title),
+    display_description = coalesce(display_description, description),
+    canceled_at = coalesce(canceled_at, CASE WHEN cancel_reason IS NOT NULL THEN updated_at END)
+WHERE id = $1;
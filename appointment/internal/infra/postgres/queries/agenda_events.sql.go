@@ -110,6 +110,81 @@ func (q *Queries) FindFutureAppointmentIDs(ctx context.Context, arg FindFutureAp
 	return items, nil
 }
 
+const findLegacyAgendaEventIDs = `-- name: FindLegacyAgendaEventIDs :many
+SELECT e.id
+FROM agenda_events e
+LEFT JOIN appointments a ON a.agenda_event_id = e.id
+LEFT JOIN agenda_manual_events m ON m.agenda_event_id = e.id
+LEFT JOIN agenda_time_blocks tb ON tb.agenda_event_id = e.id
+WHERE e.event_type = 'event'
+   OR e.display_title IS NULL
+   OR (e.cancel_reason IS NOT NULL AND e.canceled_at IS NULL)
+   OR (e.event_type = 'appointment' AND a.agenda_event_id IS NULL)
+   OR (e.event_type = 'manual' AND m.agenda_event_id IS NULL)
+   OR (e.event_type = 'time_block' AND tb.agenda_event_id IS NULL)
+ORDER BY e.created_at ASC, e.id ASC
+`
+
+func (q *Queries) FindLegacyAgendaEventIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, findLegacyAgendaEventIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAgendaEventIDs = `-- name: ListAgendaEventIDs :many
+SELECT id
+FROM agenda_events
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) ListAgendaEventIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listAgendaEventIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const normalizeLegacyAgendaEvent = `-- name: NormalizeLegacyAgendaEvent :exec
+UPDATE agenda_events
+SET event_type = CASE WHEN event_type = 'event' THEN 'manual' ELSE event_type END,
+    display_title = coalesce(display_title, title),
+    display_description = coalesce(display_description, description),
+    canceled_at = coalesce(canceled_at, CASE WHEN cancel_reason IS NOT NULL THEN updated_at END)
+WHERE id = $1
+`
+
+func (q *Queries) NormalizeLegacyAgendaEvent(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, normalizeLegacyAgendaEvent, id)
+	return err
+}
+
 const saveAgendaEvent = `-- name: SaveAgendaEvent :exec
 INSERT INTO agenda_events (
     id,
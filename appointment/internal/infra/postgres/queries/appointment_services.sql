@@ -1,18 +1,20 @@
 -- name: SaveAppointmentService :exec
-INSERT INTO appointment_services (id, name, tags, color_hex)
-VALUES ($1, $2, $3, $4)
+INSERT INTO appointment_services (id, name, tags, color_hex, duration_seconds, buffer_seconds)
+VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (id) DO UPDATE SET
     name = $2,
     tags = $3,
-    color_hex = $4;
+    color_hex = $4,
+    duration_seconds = $5,
+    buffer_seconds = $6;
 
 -- name: FindAppointmentServices :many
-SELECT id, name, tags, color_hex
+SELECT id, name, tags, color_hex, duration_seconds, buffer_seconds
 FROM appointment_services
 ORDER BY name;
 
 -- name: SearchAppointmentServices :many
-SELECT id, name, tags, color_hex
+SELECT id, name, tags, color_hex, duration_seconds, buffer_seconds
 FROM appointment_services
 WHERE search_text ILIKE '%' || sqlc.arg(query)::text || '%'
    OR search_text % sqlc.arg(query)::text
@@ -20,6 +22,6 @@ ORDER BY similarity(search_text, sqlc.arg(query)::text) DESC, name
 LIMIT sqlc.arg(limit_count)::int;
 
 -- name: FindAppointmentService :one
-SELECT id, name, tags, color_hex
+SELECT id, name, tags, color_hex, duration_seconds, buffer_seconds
 FROM appointment_services
 WHERE id = $1;
@@ -13,16 +13,18 @@ import (
 )
 
 const findAppointmentService = `-- name: FindAppointmentService :one
-SELECT id, name, tags, color_hex
+SELECT id, name, tags, color_hex, duration_seconds, buffer_seconds
 FROM appointment_services
 WHERE id = $1
 `
 
 type FindAppointmentServiceRow struct {
-	ID       string          `json:"id"`
-	Name     string          `json:"name"`
-	Tags     json.RawMessage `json:"tags"`
-	ColorHex pgtype.Text     `json:"color_hex"`
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Tags            json.RawMessage `json:"tags"`
+	ColorHex        pgtype.Text     `json:"color_hex"`
+	DurationSeconds int32           `json:"duration_seconds"`
+	BufferSeconds   int32           `json:"buffer_seconds"`
 }
 
 func (q *Queries) FindAppointmentService(ctx context.Context, id string) (FindAppointmentServiceRow, error) {
@@ -33,21 +35,25 @@ func (q *Queries) FindAppointmentService(ctx context.Context, id string) (FindAp
 		&i.Name,
 		&i.Tags,
 		&i.ColorHex,
+		&i.DurationSeconds,
+		&i.BufferSeconds,
 	)
 	return i, err
 }
 
 const findAppointmentServices = `-- name: FindAppointmentServices :many
-SELECT id, name, tags, color_hex
+SELECT id, name, tags, color_hex, duration_seconds, buffer_seconds
 FROM appointment_services
 ORDER BY name
 `
 
 type FindAppointmentServicesRow struct {
-	ID       string          `json:"id"`
-	Name     string          `json:"name"`
-	Tags     json.RawMessage `json:"tags"`
-	ColorHex pgtype.Text     `json:"color_hex"`
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Tags            json.RawMessage `json:"tags"`
+	ColorHex        pgtype.Text     `json:"color_hex"`
+	DurationSeconds int32           `json:"duration_seconds"`
+	BufferSeconds   int32           `json:"buffer_seconds"`
 }
 
 func (q *Queries) FindAppointmentServices(ctx context.Context) ([]FindAppointmentServicesRow, error) {
@@ -64,6 +70,8 @@ func (q *Queries) FindAppointmentServices(ctx context.Context) ([]FindAppointmen
 			&i.Name,
 			&i.Tags,
 			&i.ColorHex,
+			&i.DurationSeconds,
+			&i.BufferSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -76,19 +84,23 @@ func (q *Queries) FindAppointmentServices(ctx context.Context) ([]FindAppointmen
 }
 
 const saveAppointmentService = `-- name: SaveAppointmentService :exec
-INSERT INTO appointment_services (id, name, tags, color_hex)
-VALUES ($1, $2, $3, $4)
+INSERT INTO appointment_services (id, name, tags, color_hex, duration_seconds, buffer_seconds)
+VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (id) DO UPDATE SET
     name = $2,
     tags = $3,
-    color_hex = $4
+    color_hex = $4,
+    duration_seconds = $5,
+    buffer_seconds = $6
 `
 
 type SaveAppointmentServiceParams struct {
-	ID       string          `json:"id"`
-	Name     string          `json:"name"`
-	Tags     json.RawMessage `json:"tags"`
-	ColorHex pgtype.Text     `json:"color_hex"`
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Tags            json.RawMessage `json:"tags"`
+	ColorHex        pgtype.Text     `json:"color_hex"`
+	DurationSeconds int32           `json:"duration_seconds"`
+	BufferSeconds   int32           `json:"buffer_seconds"`
 }
 
 func (q *Queries) SaveAppointmentService(ctx context.Context, arg SaveAppointmentServiceParams) error {
@@ -97,12 +109,14 @@ func (q *Queries) SaveAppointmentService(ctx context.Context, arg SaveAppointmen
 		arg.Name,
 		arg.Tags,
 		arg.ColorHex,
+		arg.DurationSeconds,
+		arg.BufferSeconds,
 	)
 	return err
 }
 
 const searchAppointmentServices = `-- name: SearchAppointmentServices :many
-SELECT id, name, tags, color_hex
+SELECT id, name, tags, color_hex, duration_seconds, buffer_seconds
 FROM appointment_services
 WHERE search_text ILIKE '%' || $1::text || '%'
    OR search_text % $1::text
@@ -116,10 +130,12 @@ type SearchAppointmentServicesParams struct {
 }
 
 type SearchAppointmentServicesRow struct {
-	ID       string          `json:"id"`
-	Name     string          `json:"name"`
-	Tags     json.RawMessage `json:"tags"`
-	ColorHex pgtype.Text     `json:"color_hex"`
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Tags            json.RawMessage `json:"tags"`
+	ColorHex        pgtype.Text     `json:"color_hex"`
+	DurationSeconds int32           `json:"duration_seconds"`
+	BufferSeconds   int32           `json:"buffer_seconds"`
 }
 
 func (q *Queries) SearchAppointmentServices(ctx context.Context, arg SearchAppointmentServicesParams) ([]SearchAppointmentServicesRow, error) {
@@ -136,6 +152,8 @@ func (q *Queries) SearchAppointmentServices(ctx context.Context, arg SearchAppoi
 			&i.Name,
 			&i.Tags,
 			&i.ColorHex,
+			&i.DurationSeconds,
+			&i.BufferSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,25 @@
+-- name: SaveCalendar :exec
+INSERT INTO calendars (id, name, color_hex, archived_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE SET
+    name = $2,
+    color_hex = $3,
+    archived_at = $4,
+    updated_at = $6;
+
+-- name: FindCalendar :one
+SELECT id, name, color_hex, archived_at, created_at, updated_at
+FROM calendars
+WHERE id = $1;
+
+-- name: ListCalendars :many
+SELECT id, name, color_hex, archived_at, created_at, updated_at
+FROM calendars
+WHERE @include_archived::boolean = true OR archived_at IS NULL
+ORDER BY name, id;
+
+-- name: LockCalendar :exec
+SELECT id
+FROM calendars
+WHERE id = $1
+FOR NO KEY UPDATE;
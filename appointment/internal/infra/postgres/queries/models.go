@@ -11,29 +11,32 @@ import (
 )
 
 type AgendaEvent struct {
-	ID                  string             `json:"id"`
-	CalendarID          string             `json:"calendar_id"`
-	EventType           string             `json:"event_type"`
-	Title               string             `json:"title"`
-	Description         string             `json:"description"`
-	StartAt             pgtype.Timestamptz `json:"start_at"`
-	EndAt               pgtype.Timestamptz `json:"end_at"`
-	Timezone            string             `json:"timezone"`
-	AllDay              bool               `json:"all_day"`
-	DisplayTitle        pgtype.Text        `json:"display_title"`
-	DisplayDescription  pgtype.Text        `json:"display_description"`
-	Visibility          string             `json:"visibility"`
-	AttendeeID          string             `json:"attendee_id"`
-	AttendeeDisplayName string             `json:"attendee_display_name"`
-	Services            json.RawMessage    `json:"services"`
-	CancelReason        pgtype.Text        `json:"cancel_reason"`
-	CanceledAt          pgtype.Timestamptz `json:"canceled_at"`
-	ReminderStatus      string             `json:"reminder_status"`
-	ReminderSentAt      pgtype.Timestamptz `json:"reminder_sent_at"`
-	RemindBeforeSeconds int32              `json:"remind_before_seconds"`
-	Version             int64              `json:"version"`
-	CreatedAt           pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	ID                       string               `json:"id"`
+	CalendarID               string               `json:"calendar_id"`
+	EventType                string               `json:"event_type"`
+	Title                    string               `json:"title"`
+	Description              string               `json:"description"`
+	StartAt                  pgtype.Timestamptz   `json:"start_at"`
+	EndAt                    pgtype.Timestamptz   `json:"end_at"`
+	Timezone                 string               `json:"timezone"`
+	AllDay                   bool                 `json:"all_day"`
+	DisplayTitle             pgtype.Text          `json:"display_title"`
+	DisplayDescription       pgtype.Text          `json:"display_description"`
+	Visibility               string               `json:"visibility"`
+	AttendeeID               string               `json:"attendee_id"`
+	AttendeeDisplayName      string               `json:"attendee_display_name"`
+	Services                 json.RawMessage      `json:"services"`
+	CancelReason             pgtype.Text          `json:"cancel_reason"`
+	CanceledAt               pgtype.Timestamptz   `json:"canceled_at"`
+	ReminderStatus           string               `json:"reminder_status"`
+	ReminderSentAt           pgtype.Timestamptz   `json:"reminder_sent_at"`
+	RemindBeforeSeconds      int32                `json:"remind_before_seconds"`
+	Version                  int64                `json:"version"`
+	CreatedAt                pgtype.Timestamptz   `json:"created_at"`
+	UpdatedAt                pgtype.Timestamptz   `json:"updated_at"`
+	RecurrenceRule           pgtype.Text          `json:"recurrence_rule"`
+	RecurrenceExceptionDates []pgtype.Timestamptz `json:"recurrence_exception_dates"`
+	RecurrenceEndAt          pgtype.Timestamptz   `json:"recurrence_end_at"`
 }
 
 type AgendaManualEvent struct {
@@ -53,11 +56,13 @@ type AgendaTimeBlock struct {
 }
 
 type Appointment struct {
-	AgendaEventID       string             `json:"agenda_event_id"`
-	CustomerID          string             `json:"customer_id"`
-	CustomerDisplayName string             `json:"customer_display_name"`
-	CreatedAt           pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	AgendaEventID        string             `json:"agenda_event_id"`
+	CustomerID           string             `json:"customer_id"`
+	CustomerDisplayName  string             `json:"customer_display_name"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	AttendanceStatus     pgtype.Text        `json:"attendance_status"`
+	AttendanceRecordedAt pgtype.Timestamptz `json:"attendance_recorded_at"`
 }
 
 type AppointmentNotification struct {
@@ -74,6 +79,7 @@ type AppointmentNotification struct {
 	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
 	CompletedAt                pgtype.Timestamptz `json:"completed_at"`
 	ExpiresAt                  pgtype.Timestamptz `json:"expires_at"`
+	ReminderPosition           pgtype.Int4        `json:"reminder_position"`
 }
 
 type AppointmentReminder struct {
@@ -86,21 +92,35 @@ type AppointmentReminder struct {
 	FailedAt            pgtype.Timestamptz `json:"failed_at"`
 	FailureReason       pgtype.Text        `json:"failure_reason"`
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	Position            int32              `json:"position"`
 }
 
 type AppointmentService struct {
-	ID         string          `json:"id"`
-	Name       string          `json:"name"`
-	Tags       json.RawMessage `json:"tags"`
-	ColorHex   pgtype.Text     `json:"color_hex"`
-	SearchText pgtype.Text     `json:"search_text"`
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Tags            json.RawMessage `json:"tags"`
+	ColorHex        pgtype.Text     `json:"color_hex"`
+	SearchText      pgtype.Text     `json:"search_text"`
+	DurationSeconds int32           `json:"duration_seconds"`
+	BufferSeconds   int32           `json:"buffer_seconds"`
 }
 
 type AppointmentServiceItem struct {
-	AgendaEventID string      `json:"agenda_event_id"`
-	ServiceID     pgtype.Text `json:"service_id"`
-	ServiceName   string      `json:"service_name"`
-	Position      int32       `json:"position"`
+	AgendaEventID   string      `json:"agenda_event_id"`
+	ServiceID       pgtype.Text `json:"service_id"`
+	ServiceName     string      `json:"service_name"`
+	Position        int32       `json:"position"`
+	DurationSeconds int32       `json:"duration_seconds"`
+	BufferSeconds   int32       `json:"buffer_seconds"`
+}
+
+type Calendar struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	ColorHex   pgtype.Text        `json:"color_hex"`
+	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
 
 type PendingNotification struct {
@@ -109,3 +129,48 @@ type PendingNotification struct {
 	NotificationType string             `json:"notification_type"`
 	ExpiresAt        pgtype.Timestamptz `json:"expires_at"`
 }
+
+type WaitlistEntry struct {
+	ID                      string             `json:"id"`
+	CalendarID              string             `json:"calendar_id"`
+	CustomerID              string             `json:"customer_id"`
+	CustomerDisplayName     string             `json:"customer_display_name"`
+	Services                json.RawMessage    `json:"services"`
+	WindowStartAt           pgtype.Timestamptz `json:"window_start_at"`
+	WindowEndAt             pgtype.Timestamptz `json:"window_end_at"`
+	Timezone                string             `json:"timezone"`
+	Status                  string             `json:"status"`
+	OfferCalendarEventID    pgtype.Text        `json:"offer_calendar_event_id"`
+	OfferStartAt            pgtype.Timestamptz `json:"offer_start_at"`
+	OfferEndAt              pgtype.Timestamptz `json:"offer_end_at"`
+	OfferedAt               pgtype.Timestamptz `json:"offered_at"`
+	OfferExpiresAt          pgtype.Timestamptz `json:"offer_expires_at"`
+	CreatedAt               pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
+	OfferedCalendarEventIds []string           `json:"offered_calendar_event_ids"`
+}
+
+type WebhookDelivery struct {
+	ID                 string             `json:"id"`
+	SubscriptionID     string             `json:"subscription_id"`
+	EventType          string             `json:"event_type"`
+	CalendarEventID    string             `json:"calendar_event_id"`
+	Payload            json.RawMessage    `json:"payload"`
+	Status             string             `json:"status"`
+	Attempts           int32              `json:"attempts"`
+	LastResponseStatus pgtype.Int4        `json:"last_response_status"`
+	LastError          pgtype.Text        `json:"last_error"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	LastAttemptAt      pgtype.Timestamptz `json:"last_attempt_at"`
+	DeliveredAt        pgtype.Timestamptz `json:"delivered_at"`
+}
+
+type WebhookSubscription struct {
+	ID         string             `json:"id"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []string           `json:"event_types"`
+	Enabled    bool               `json:"enabled"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
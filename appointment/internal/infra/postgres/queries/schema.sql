@@ -10,9 +10,18 @@ AS $$
     FROM jsonb_array_elements_text(coalesce(tags, '[]'::jsonb)) WITH ORDINALITY AS tag(value, ordinality)
 $$;
 
+CREATE TABLE calendars (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    color_hex TEXT NULL,
+    archived_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
 CREATE TABLE agenda_events (
     id UUID PRIMARY KEY,
-    calendar_id UUID NOT NULL DEFAULT 'd2a36e25-4824-4167-a062-a5af96f97703',
+    calendar_id UUID NOT NULL DEFAULT 'd2a36e25-4824-4167-a062-a5af96f97703' REFERENCES calendars(id),
     event_type TEXT NOT NULL,
     title TEXT NOT NULL DEFAULT '',
     description TEXT NOT NULL DEFAULT '',
@@ -33,7 +42,10 @@ CREATE TABLE agenda_events (
     remind_before_seconds INTEGER NOT NULL,
     version BIGINT NOT NULL DEFAULT 1,
     created_at TIMESTAMPTZ NOT NULL,
-    updated_at TIMESTAMPTZ NOT NULL
+    updated_at TIMESTAMPTZ NOT NULL,
+    recurrence_rule TEXT NULL,
+    recurrence_exception_dates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    recurrence_end_at TIMESTAMPTZ NULL
 );
 
 CREATE TABLE appointment_services (
@@ -46,7 +58,9 @@ CREATE TABLE appointment_services (
             coalesce(name, '') || ' ' ||
             appointment_service_tags_search_text(tags)
         )
-    ) STORED
+    ) STORED,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    buffer_seconds INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE TABLE pending_notifications (
@@ -61,7 +75,9 @@ CREATE TABLE appointments (
     customer_id UUID NOT NULL,
     customer_display_name TEXT NOT NULL,
     created_at TIMESTAMPTZ NOT NULL,
-    updated_at TIMESTAMPTZ NOT NULL
+    updated_at TIMESTAMPTZ NOT NULL,
+    attendance_status TEXT NULL,
+    attendance_recorded_at TIMESTAMPTZ NULL
 );
 
 CREATE TABLE agenda_manual_events (
@@ -85,11 +101,13 @@ CREATE TABLE appointment_service_items (
     service_id TEXT NULL,
     service_name TEXT NOT NULL,
     position INTEGER NOT NULL,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    buffer_seconds INTEGER NOT NULL DEFAULT 0,
     PRIMARY KEY (agenda_event_id, position)
 );
 
 CREATE TABLE appointment_reminders (
-    agenda_event_id UUID PRIMARY KEY REFERENCES appointments(agenda_event_id),
+    agenda_event_id UUID NOT NULL REFERENCES appointments(agenda_event_id),
     status TEXT NOT NULL,
     remind_before_seconds INTEGER NOT NULL,
     scheduled_at TIMESTAMPTZ NULL,
@@ -97,7 +115,9 @@ CREATE TABLE appointment_reminders (
     sent_at TIMESTAMPTZ NULL,
     failed_at TIMESTAMPTZ NULL,
     failure_reason TEXT NULL,
-    updated_at TIMESTAMPTZ NOT NULL
+    updated_at TIMESTAMPTZ NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (agenda_event_id, position)
 );
 
 CREATE TABLE appointment_notifications (
@@ -113,5 +133,51 @@ CREATE TABLE appointment_notifications (
     failure_message TEXT NULL,
     created_at TIMESTAMPTZ NOT NULL,
     completed_at TIMESTAMPTZ NULL,
-    expires_at TIMESTAMPTZ NOT NULL
+    expires_at TIMESTAMPTZ NOT NULL,
+    reminder_position INTEGER NULL
+);
+
+CREATE TABLE waitlist_entries (
+    id UUID PRIMARY KEY,
+    calendar_id UUID NOT NULL REFERENCES calendars(id),
+    customer_id UUID NOT NULL,
+    customer_display_name TEXT NOT NULL,
+    services JSONB NOT NULL DEFAULT '[]'::jsonb,
+    window_start_at TIMESTAMPTZ NOT NULL,
+    window_end_at TIMESTAMPTZ NOT NULL,
+    timezone TEXT NOT NULL,
+    status TEXT NOT NULL,
+    offer_calendar_event_id TEXT NULL,
+    offer_start_at TIMESTAMPTZ NULL,
+    offer_end_at TIMESTAMPTZ NULL,
+    offered_at TIMESTAMPTZ NULL,
+    offer_expires_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    offered_calendar_event_ids TEXT[] NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE webhook_subscriptions (
+    id UUID PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    enabled BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY,
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    calendar_event_id TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_response_status INTEGER NULL,
+    last_error TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    last_attempt_at TIMESTAMPTZ NULL,
+    delivered_at TIMESTAMPTZ NULL
 );
@@ -0,0 +1,32 @@
+-- name: SaveWaitlistEntry :exec
+INSERT INTO waitlist_entries (
+    id, calendar_id, customer_id, customer_display_name, services, window_start_at, window_end_at, timezone, status,
+    offer_calendar_event_id, offer_start_at, offer_end_at, offered_at, offer_expires_at, created_at, updated_at,
+    offered_calendar_event_ids
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+ON CONFLICT (id) DO UPDATE SET
+    status = $9,
+    offer_calendar_event_id = $10,
+    offer_start_at = $11,
+    offer_end_at = $12,
+    offered_at = $13,
+    offer_expires_at = $14,
+    updated_at = $16,
+    offered_calendar_event_ids = $17;
+
+-- name: FindWaitlistEntry :one
+SELECT id, calendar_id, customer_id, customer_display_name, services, window_start_at, window_end_at, timezone, status,
+    offer_calendar_event_id, offer_start_at, offer_end_at, offered_at, offer_expires_at, created_at, updated_at,
+    offered_calendar_event_ids
+FROM waitlist_entries
+WHERE id = $1;
+
+-- name: SearchWaitlistEntries :many
+SELECT id, calendar_id, customer_id, customer_display_name, services, window_start_at, window_end_at, timezone, status,
+    offer_calendar_event_id, offer_start_at, offer_end_at, offered_at, offer_expires_at, created_at, updated_at,
+    offered_calendar_event_ids
+FROM waitlist_entries
+WHERE (@filter_calendar::boolean = false OR calendar_id::text = ANY(@calendar_ids::text[]))
+  AND status = ANY(@statuses::text[])
+ORDER BY created_at ASC, id ASC;
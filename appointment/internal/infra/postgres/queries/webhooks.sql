@@ -0,0 +1,51 @@
+-- name: SaveWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (id, url, secret, event_types, enabled, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE SET
+    url = $2,
+    secret = $3,
+    event_types = $4,
+    enabled = $5,
+    updated_at = $7;
+
+-- name: FindWebhookSubscription :one
+SELECT id, url, secret, event_types, enabled, created_at, updated_at
+FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT id, url, secret, event_types, enabled, created_at, updated_at
+FROM webhook_subscriptions
+ORDER BY created_at ASC, id ASC;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: SaveWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    id, subscription_id, event_type, calendar_event_id, payload, status, attempts,
+    last_response_status, last_error, created_at, last_attempt_at, delivered_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (id) DO UPDATE SET
+    status = $6,
+    attempts = $7,
+    last_response_status = $8,
+    last_error = $9,
+    last_attempt_at = $11,
+    delivered_at = $12;
+
+-- name: FindWebhookDelivery :one
+SELECT id, subscription_id, event_type, calendar_event_id, payload, status, attempts,
+    last_response_status, last_error, created_at, last_attempt_at, delivered_at
+FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT id, subscription_id, event_type, calendar_event_id, payload, status, attempts,
+    last_response_status, last_error, created_at, last_attempt_at, delivered_at
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
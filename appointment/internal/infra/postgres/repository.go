@@ -135,10 +135,12 @@ func (r *Repository) findAgendaEventsByIDs(ctx context.Context, ids []string) ([
 func (r *Repository) SaveService(ctx context.Context, s domain.AppointmentService) (domain.AppointmentService, error) {
 	tags, _ := json.Marshal(s.Tags)
 	err := queries.New(r.db).SaveAppointmentService(ctx, queries.SaveAppointmentServiceParams{
-		ID:       s.ID,
-		Name:     s.Name,
-		Tags:     tags,
-		ColorHex: nullableText(s.Color),
+		ID:              s.ID,
+		Name:            s.Name,
+		Tags:            tags,
+		ColorHex:        nullableText(s.Color),
+		DurationSeconds: int32(s.Duration / time.Second),
+		BufferSeconds:   int32(s.Buffer / time.Second),
 	})
 	return s, err
 }
@@ -150,7 +152,7 @@ func (r *Repository) FindServices(ctx context.Context) ([]domain.AppointmentServ
 	}
 	out := make([]domain.AppointmentService, 0, len(rows))
 	for _, row := range rows {
-		service, err := appointmentServiceFromFields(row.ID, row.Name, row.Tags, row.ColorHex)
+		service, err := appointmentServiceFromFields(row.ID, row.Name, row.Tags, row.ColorHex, row.DurationSeconds, row.BufferSeconds)
 		if err != nil {
 			return nil, err
 		}
@@ -173,7 +175,7 @@ func (r *Repository) SearchServices(ctx context.Context, text string, limit int)
 	}
 	out := make([]domain.AppointmentService, 0, len(rows))
 	for _, row := range rows {
-		service, err := appointmentServiceFromFields(row.ID, row.Name, row.Tags, row.ColorHex)
+		service, err := appointmentServiceFromFields(row.ID, row.Name, row.Tags, row.ColorHex, row.DurationSeconds, row.BufferSeconds)
 		if err != nil {
 			return nil, err
 		}
@@ -190,7 +192,7 @@ func (r *Repository) FindService(ctx context.Context, id string) (*domain.Appoin
 	if err != nil {
 		return nil, err
 	}
-	service, err := appointmentServiceFromFields(row.ID, row.Name, row.Tags, row.ColorHex)
+	service, err := appointmentServiceFromFields(row.ID, row.Name, row.Tags, row.ColorHex, row.DurationSeconds, row.BufferSeconds)
 	return &service, err
 }
 
@@ -312,7 +314,7 @@ func (r *Repository) saveAppointmentNotification(ctx context.Context, notificati
 	})
 }
 
-func agendaEventFromRow(row queries.AgendaEvent) (*domain.AgendaEvent, error) {
+func agendaEventFromRow(row queries.FindAgendaEventRow) (*domain.AgendaEvent, error) {
 	var services []domain.AppointmentServiceRef
 	if err := json.Unmarshal(row.Services, &services); err != nil {
 		return nil, err
@@ -332,7 +334,7 @@ func agendaEventFromRow(row queries.AgendaEvent) (*domain.AgendaEvent, error) {
 
 	return &domain.AgendaEvent{
 		ID:          row.ID,
-		Type:        domain.EventType(row.EventType),
+		Type:        legacyEventType(row.EventType),
 		Title:       row.Title,
 		Description: row.Description,
 		Start:       row.StartAt.Time.UTC(),
@@ -352,6 +354,14 @@ func agendaEventFromRow(row queries.AgendaEvent) (*domain.AgendaEvent, error) {
 	}, nil
 }
 
+// legacyEventType reads the manual events saved with the calendar event type as generic events.
+func legacyEventType(eventType string) domain.EventType {
+	if eventType == string(domainv2.CalendarEventTypeManual) {
+		return domain.EventTypeGeneric
+	}
+	return domain.EventType(eventType)
+}
+
 func agendaEventFromDetailsRow(row queries.FindAgendaEventFromDetailsRow) (*domain.AgendaEvent, error) {
 	var services []domain.AppointmentServiceRef
 	if row.ServicesJson != "" {
@@ -417,16 +427,18 @@ func agendaEventFromDetailsRow(row queries.FindAgendaEventFromDetailsRow) (*doma
 	}, nil
 }
 
-func appointmentServiceFromFields(id string, name string, tagsBytes []byte, color pgtype.Text) (domain.AppointmentService, error) {
+func appointmentServiceFromFields(id string, name string, tagsBytes []byte, color pgtype.Text, durationSeconds int32, bufferSeconds int32) (domain.AppointmentService, error) {
 	var tags []string
 	if err := json.Unmarshal(tagsBytes, &tags); err != nil {
 		return domain.AppointmentService{}, err
 	}
 	return domain.AppointmentService{
-		ID:    id,
-		Name:  name,
-		Tags:  tags,
-		Color: textPointer(color),
+		ID:       id,
+		Name:     name,
+		Tags:     tags,
+		Color:    textPointer(color),
+		Duration: time.Duration(durationSeconds) * time.Second,
+		Buffer:   time.Duration(bufferSeconds) * time.Second,
 	}, nil
 }
 
@@ -441,6 +453,13 @@ func nullableTimestamp(value *time.Time) pgtype.Timestamptz {
 	return timestamp(*value)
 }
 
+func nullableInt4(value *int) pgtype.Int4 {
+	if value == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*value), Valid: true}
+}
+
 func nullableText(value *string) pgtype.Text {
 	if value == nil {
 		return pgtype.Text{}
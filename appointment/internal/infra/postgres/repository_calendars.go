@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/postgres/queries"
+)
+
+func (r *Repository) NextCalendarID() string {
+	return uuid.NewString()
+}
+
+func (r *Repository) FindCalendar(ctx context.Context, calendarID string) (*domainv2.Calendar, error) {
+	row, err := queries.New(r.db).FindCalendar(ctx, calendarID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	calendar := calendarFromRow(row)
+	return &calendar, nil
+}
+
+// LockCalendarEvents locks the calendar row until the end of the transaction. FOR NO KEY UPDATE serializes
+// the conflict-checked writes without blocking the inserts that only reference the calendar.
+func (r *Repository) LockCalendarEvents(ctx context.Context, calendarID string) error {
+	return queries.New(r.db).LockCalendar(ctx, calendarID)
+}
+
+func (r *Repository) ListCalendars(ctx context.Context, includeArchived bool) ([]domainv2.Calendar, error) {
+	rows, err := queries.New(r.db).ListCalendars(ctx, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domainv2.Calendar, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, calendarFromRow(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) SaveCalendar(ctx context.Context, calendar domainv2.Calendar) error {
+	return queries.New(r.db).SaveCalendar(ctx, queries.SaveCalendarParams{
+		ID:         calendar.ID,
+		Name:       calendar.Name,
+		ColorHex:   nullableText(calendar.Color),
+		ArchivedAt: nullableTimestamp(calendar.ArchivedAt),
+		CreatedAt:  timestamp(calendar.CreatedAt),
+		UpdatedAt:  timestamp(calendar.UpdatedAt),
+	})
+}
+
+func calendarFromRow(row queries.Calendar) domainv2.Calendar {
+	return domainv2.Calendar{
+		ID:         row.ID,
+		Name:       row.Name,
+		Color:      nullableString(row.ColorHex),
+		ArchivedAt: nullableTime(row.ArchivedAt),
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+}
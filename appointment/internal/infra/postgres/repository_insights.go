@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/postgres/queries"
+)
+
+func (r *Repository) RankCustomersByAppointments(ctx context.Context, limit int, offset int) ([]applicationv2.CustomerAppointmentCount, error) {
+	rows, err := queries.New(r.db).RankCustomersByAppointments(ctx, queries.RankCustomersByAppointmentsParams{
+		LimitCount:  int32(limit),
+		OffsetCount: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]applicationv2.CustomerAppointmentCount, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, applicationv2.CustomerAppointmentCount{
+			CustomerID:          row.CustomerID,
+			CustomerDisplayName: row.CustomerDisplayName,
+			Count:               int(row.AppointmentCount),
+		})
+	}
+	return out, nil
+}
+
+func (r *Repository) RankCustomersByCancellations(ctx context.Context, limit int, offset int) ([]applicationv2.CustomerAppointmentCount, error) {
+	rows, err := queries.New(r.db).RankCustomersByCancellations(ctx, queries.RankCustomersByCancellationsParams{
+		LimitCount:  int32(limit),
+		OffsetCount: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]applicationv2.CustomerAppointmentCount, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, applicationv2.CustomerAppointmentCount{
+			CustomerID:          row.CustomerID,
+			CustomerDisplayName: row.CustomerDisplayName,
+			Count:               int(row.CancellationCount),
+		})
+	}
+	return out, nil
+}
+
+func (r *Repository) RankCustomersByNoShows(ctx context.Context, limit int, offset int) ([]applicationv2.CustomerNoShowCount, error) {
+	rows, err := queries.New(r.db).RankCustomersByNoShows(ctx, queries.RankCustomersByNoShowsParams{
+		LimitCount:  int32(limit),
+		OffsetCount: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]applicationv2.CustomerNoShowCount, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, applicationv2.CustomerNoShowCount{
+			CustomerID:          row.CustomerID,
+			CustomerDisplayName: row.CustomerDisplayName,
+			NoShows:             int(row.NoShowCount),
+			Attendances:         int(row.AttendanceCount),
+		})
+	}
+	return out, nil
+}
+
+func (r *Repository) CountCustomerCancellationsByWeekday(ctx context.Context) ([]applicationv2.WeekdayCount, error) {
+	rows, err := queries.New(r.db).CountCustomerCancellationsByDayOfWeek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]applicationv2.WeekdayCount, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, applicationv2.WeekdayCount{
+			Weekday: weekdayFromISO(row.IsoDayOfWeek),
+			Count:   int(row.CancellationCount),
+		})
+	}
+	return out, nil
+}
+
+// weekdayFromISO maps ISO 8601 day numbers, where Monday is 1 and Sunday is 7, to time.Weekday.
+func weekdayFromISO(day int32) time.Weekday {
+	return time.Weekday(day % 7)
+}
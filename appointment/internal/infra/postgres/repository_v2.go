@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -62,21 +63,54 @@ func (r *Repository) FindCalendarEventView(ctx context.Context, agendaEventID st
 	if err != nil {
 		return nil, err
 	}
-	reminder, err := appointmentReminderV2FromDetails(row)
+	reminderRows, err := queries.New(r.db).FindAppointmentReminders(ctx, event.ID)
 	if err != nil {
 		return nil, err
 	}
-	return &applicationv2.CalendarEventView{Event: event, Reminder: reminder}, nil
+	reminders := make([]domainv2.AppointmentReminder, 0, len(reminderRows))
+	for _, reminderRow := range reminderRows {
+		reminder, err := appointmentReminderV2FromRow(reminderRow)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+	notificationRows, err := queries.New(r.db).FindAppointmentNotifications(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]domainv2.AppointmentNotification, 0, len(notificationRows))
+	for _, notificationRow := range notificationRows {
+		notification, err := appointmentNotificationV2FromRow(queries.FindAppointmentNotificationRow(notificationRow))
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	return &applicationv2.CalendarEventView{Event: event, Reminders: reminders, Notifications: notifications}, nil
 }
 
 func (r *Repository) SearchCalendarEventViews(ctx context.Context, query applicationv2.ListCalendarEventsQuery) ([]applicationv2.CalendarEventView, error) {
 	params := queries.SearchAgendaEventIDsFromDetailsParams{
-		FilterCalendar:   query.CalendarID != "",
-		CalendarID:       query.CalendarID,
+		FilterCalendar:   len(query.CalendarIDs) > 0,
+		CalendarIds:      query.CalendarIDs,
 		FilterCustomer:   query.CustomerID != "",
 		CustomerID:       query.CustomerID,
 		FilterTimeRange:  query.Start != nil && query.End != nil,
 		FilterEventTypes: len(query.EventTypes) > 0,
+		IncludeCanceled:  query.IncludeCanceled,
+		FilterText:       query.Text != "",
+		Text:             query.Text,
+		Descending:       query.Descending,
+	}
+	if query.After != nil {
+		params.FilterAfter = true
+		params.AfterStartAt = timestamp(query.After.Start)
+		params.AfterEndAt = timestamp(query.After.End)
+		params.AfterID = query.After.ID
+	}
+	if query.PageSize > 0 {
+		params.LimitCount = pgtype.Int4{Int32: int32(query.PageSize), Valid: true}
 	}
 	if query.Start != nil {
 		params.StartAt = timestamp(*query.Start)
@@ -105,24 +139,18 @@ func (r *Repository) SearchCalendarEventViews(ctx context.Context, query applica
 	return out, nil
 }
 
-func appointmentReminderV2FromDetails(row queries.FindAgendaEventFromDetailsRow) (*domainv2.AppointmentReminder, error) {
-	if !row.ReminderStatus.Valid {
-		return nil, nil
-	}
-	reminder, err := domainv2.ReconstituteAppointmentReminder(domainv2.AppointmentReminder{
-		Status:          domainv2.ReminderStatus(row.ReminderStatus.String),
-		RemindBefore:    time.Duration(row.RemindBeforeSeconds.Int32) * time.Second,
-		ScheduledAt:     nullableTime(row.ReminderScheduledAt),
-		SentRequestedAt: nullableTime(row.ReminderSentRequestedAt),
-		SentAt:          nullableTime(row.ReminderSentAt),
-		FailedAt:        nullableTime(row.ReminderFailedAt),
-		FailureReason:   nullableString(row.ReminderFailureReason),
-		UpdatedAt:       row.ReminderUpdatedAt.Time,
+func appointmentReminderV2FromRow(row queries.AppointmentReminder) (domainv2.AppointmentReminder, error) {
+	return domainv2.ReconstituteAppointmentReminder(domainv2.AppointmentReminder{
+		Position:        int(row.Position),
+		Status:          domainv2.ReminderStatus(row.Status),
+		RemindBefore:    time.Duration(row.RemindBeforeSeconds) * time.Second,
+		ScheduledAt:     nullableTime(row.ScheduledAt),
+		SentRequestedAt: nullableTime(row.SentRequestedAt),
+		SentAt:          nullableTime(row.SentAt),
+		FailedAt:        nullableTime(row.FailedAt),
+		FailureReason:   nullableString(row.FailureReason),
+		UpdatedAt:       row.UpdatedAt.Time,
 	})
-	if err != nil {
-		return nil, err
-	}
-	return &reminder, nil
 }
 
 func (r *Repository) SaveCalendarEvent(ctx context.Context, event *domainv2.CalendarEvent) error {
@@ -132,30 +160,46 @@ func (r *Repository) SaveCalendarEvent(ctx context.Context, event *domainv2.Cale
 	var err error
 	switch detail := event.Detail.(type) {
 	case domainv2.Appointment:
-		err = r.saveAppointment(ctx, *event, detail)
+		err = r.saveAppointment(ctx, event, detail)
 	case domainv2.ManualEvent:
-		err = r.saveManualEvent(ctx, *event, detail)
+		err = r.saveManualEvent(ctx, event, detail)
 	case domainv2.TimeBlock:
-		err = r.saveTimeBlock(ctx, *event, detail)
+		err = r.saveTimeBlock(ctx, event, detail)
 	default:
 		return fmt.Errorf("unsupported calendar event type %T", event)
 	}
 	if err != nil {
 		return err
 	}
-	return r.publishCalendarLifecycleEvents(ctx, event.PullEvents())
+	return r.publishCalendarLifecycleEvents(ctx, *event, event.PullEvents())
 }
 
-func (r *Repository) saveAppointment(ctx context.Context, event domainv2.CalendarEvent, appointment domainv2.Appointment) error {
-	if err := queries.New(r.db).SaveAgendaEventV2(ctx, agendaEventV2Params(event, appointment.Customer.ID, appointment.Customer.DisplayName)); err != nil {
+// saveAgendaEventV2 upserts the common calendar event row guarded by the version the event was loaded with,
+// and advances event.Version to the stored one.
+func (r *Repository) saveAgendaEventV2(ctx context.Context, event *domainv2.CalendarEvent, attendeeID string, attendeeDisplayName string) error {
+	version, err := queries.New(r.db).SaveAgendaEventV2(ctx, agendaEventV2Params(*event, attendeeID, attendeeDisplayName))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return applicationv2.ErrCalendarEventVersionConflict
+	}
+	if err != nil {
+		return err
+	}
+	event.Version = version
+	return nil
+}
+
+func (r *Repository) saveAppointment(ctx context.Context, event *domainv2.CalendarEvent, appointment domainv2.Appointment) error {
+	if err := r.saveAgendaEventV2(ctx, event, appointment.Customer.ID, appointment.Customer.DisplayName); err != nil {
 		return err
 	}
 	if err := queries.New(r.db).SaveAppointment(ctx, queries.SaveAppointmentParams{
-		AgendaEventID:       event.ID,
-		CustomerID:          appointment.Customer.ID,
-		CustomerDisplayName: appointment.Customer.DisplayName,
-		CreatedAt:           timestamp(appointment.CreatedAt),
-		UpdatedAt:           timestamp(appointment.UpdatedAt),
+		AgendaEventID:        event.ID,
+		CustomerID:           appointment.Customer.ID,
+		CustomerDisplayName:  appointment.Customer.DisplayName,
+		CreatedAt:            timestamp(appointment.CreatedAt),
+		UpdatedAt:            timestamp(appointment.UpdatedAt),
+		AttendanceStatus:     attendanceStatusText(appointment.Attendance),
+		AttendanceRecordedAt: attendanceRecordedAt(appointment.Attendance),
 	}); err != nil {
 		return err
 	}
@@ -164,10 +208,12 @@ func (r *Repository) saveAppointment(ctx context.Context, event domainv2.Calenda
 	}
 	for _, service := range appointment.Services {
 		if err := queries.New(r.db).SaveAppointmentServiceItem(ctx, queries.SaveAppointmentServiceItemParams{
-			AgendaEventID: event.ID,
-			ServiceID:     nullableText(service.ServiceID),
-			ServiceName:   service.ServiceName,
-			Position:      int32(service.Position),
+			AgendaEventID:   event.ID,
+			ServiceID:       nullableText(service.ServiceID),
+			ServiceName:     service.ServiceName,
+			Position:        int32(service.Position),
+			DurationSeconds: int32(service.Duration / time.Second),
+			BufferSeconds:   int32(service.Buffer / time.Second),
 		}); err != nil {
 			return err
 		}
@@ -187,36 +233,97 @@ func (r *Repository) FindAppointmentNotification(ctx context.Context, correlatio
 	if err != nil {
 		return nil, err
 	}
-	recipient, err := domainv2.ReconstituteNotificationRecipient(row.RecipientType, row.RecipientID)
+	notification, err := appointmentNotificationV2FromRow(row)
 	if err != nil {
 		return nil, err
 	}
-	notification, err := domainv2.ReconstituteAppointmentNotification(domainv2.AppointmentNotification{
-		CorrelationKey:  row.CorrelationKey,
-		CalendarEventID: row.AgendaEventID,
-		Kind:            domainv2.NotificationKind(row.NotificationKind),
-		Type:            domainv2.NotificationType(row.NotificationType),
-		Status:          domainv2.NotificationStatus(row.Status),
-		Recipient:       recipient,
-		IdempotencyKey:  nullableString(row.NotificationIdempotencyKey),
-		FailureReason:   nullableString(row.FailureReason),
-		FailureMessage:  nullableString(row.FailureMessage),
-		CreatedAt:       row.CreatedAt.Time,
-		CompletedAt:     nullableTime(row.CompletedAt),
-		ExpiresAt:       row.ExpiresAt.Time,
+	return &notification, nil
+}
+
+// FindExpiredAppointmentNotifications locks up to limit pending notifications whose
+// ExpiresAt is not after now, skipping the ones an outcome is updating.
+func (r *Repository) FindExpiredAppointmentNotifications(ctx context.Context, now time.Time, limit int) ([]domainv2.AppointmentNotification, error) {
+	rows, err := queries.New(r.db).FindExpiredAppointmentNotifications(ctx, queries.FindExpiredAppointmentNotificationsParams{
+		ExpiredBefore: pgtype.Timestamptz{Time: now.UTC(), Valid: true},
+		MaxRows:       int32(limit),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &notification, nil
+	notifications := make([]domainv2.AppointmentNotification, 0, len(rows))
+	for _, row := range rows {
+		notification, err := appointmentNotificationV2FromRow(queries.FindAppointmentNotificationRow(row))
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, nil
+}
+
+// FindScheduledCalendarReminders returns the scheduled reminders of the non-canceled
+// appointments starting after startAfter.
+func (r *Repository) FindScheduledCalendarReminders(ctx context.Context, startAfter time.Time) ([]applicationv2.ScheduledCalendarReminder, error) {
+	rows, err := queries.New(r.db).FindScheduledAppointmentReminders(ctx, pgtype.Timestamptz{Time: startAfter.UTC(), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	reminders := make([]applicationv2.ScheduledCalendarReminder, 0, len(rows))
+	for _, row := range rows {
+		if !row.ScheduledAt.Valid {
+			continue
+		}
+		reminders = append(reminders, applicationv2.ScheduledCalendarReminder{
+			CalendarEventID: row.AgendaEventID,
+			Position:        int(row.Position),
+			ExpectedStartAt: row.StartAt.Time.UTC(),
+			SendAt:          row.ScheduledAt.Time.UTC(),
+		})
+	}
+	return reminders, nil
+}
+
+func (r *Repository) RenameAppointmentCustomer(ctx context.Context, customer domainv2.CustomerRef, endsAfter time.Time, now time.Time) ([]string, error) {
+	return queries.New(r.db).UpdateAppointmentCustomerDisplayName(ctx, queries.UpdateAppointmentCustomerDisplayNameParams{
+		CustomerDisplayName: customer.DisplayName,
+		UpdatedAt:           timestamp(now),
+		CustomerID:          customer.ID,
+		EndsAfter:           timestamp(endsAfter),
+	})
+}
+
+func (r *Repository) ListAppointmentCustomerIDs(ctx context.Context) ([]string, error) {
+	return queries.New(r.db).ListAppointmentCustomerIDs(ctx)
+}
+
+func appointmentNotificationV2FromRow(row queries.FindAppointmentNotificationRow) (domainv2.AppointmentNotification, error) {
+	recipient, err := domainv2.ReconstituteNotificationRecipient(row.RecipientType, row.RecipientID)
+	if err != nil {
+		return domainv2.AppointmentNotification{}, err
+	}
+	return domainv2.ReconstituteAppointmentNotification(domainv2.AppointmentNotification{
+		CorrelationKey:   row.CorrelationKey,
+		CalendarEventID:  row.AgendaEventID,
+		Kind:             domainv2.NotificationKind(row.NotificationKind),
+		Type:             domainv2.NotificationType(row.NotificationType),
+		Status:           domainv2.NotificationStatus(row.Status),
+		Recipient:        recipient,
+		IdempotencyKey:   nullableString(row.NotificationIdempotencyKey),
+		ReminderPosition: nullableInt(row.ReminderPosition),
+		FailureReason:    nullableString(row.FailureReason),
+		FailureMessage:   nullableString(row.FailureMessage),
+		CreatedAt:        row.CreatedAt.Time,
+		CompletedAt:      nullableTime(row.CompletedAt),
+		ExpiresAt:        row.ExpiresAt.Time,
+	})
 }
 
 func (r *Repository) SaveAppointmentNotification(ctx context.Context, notification domainv2.AppointmentNotification) error {
 	return queries.New(r.db).SaveAppointmentNotification(ctx, appointmentNotificationV2Params(notification))
 }
 
-func (r *Repository) saveManualEvent(ctx context.Context, event domainv2.CalendarEvent, manualEvent domainv2.ManualEvent) error {
-	if err := queries.New(r.db).SaveAgendaEventV2(ctx, agendaEventV2Params(event, "self", "self")); err != nil {
+func (r *Repository) saveManualEvent(ctx context.Context, event *domainv2.CalendarEvent, manualEvent domainv2.ManualEvent) error {
+	if err := r.saveAgendaEventV2(ctx, event, "self", "self"); err != nil {
 		return err
 	}
 	return queries.New(r.db).SaveAgendaManualEvent(ctx, queries.SaveAgendaManualEventParams{
@@ -229,8 +336,8 @@ func (r *Repository) saveManualEvent(ctx context.Context, event domainv2.Calenda
 	})
 }
 
-func (r *Repository) saveTimeBlock(ctx context.Context, event domainv2.CalendarEvent, timeBlock domainv2.TimeBlock) error {
-	if err := queries.New(r.db).SaveAgendaEventV2(ctx, agendaEventV2Params(event, "self", "self")); err != nil {
+func (r *Repository) saveTimeBlock(ctx context.Context, event *domainv2.CalendarEvent, timeBlock domainv2.TimeBlock) error {
+	if err := r.saveAgendaEventV2(ctx, event, "self", "self"); err != nil {
 		return err
 	}
 	return queries.New(r.db).SaveAgendaTimeBlock(ctx, queries.SaveAgendaTimeBlockParams{
@@ -252,26 +359,37 @@ func agendaEventV2Params(event domainv2.CalendarEvent, attendeeID string, attend
 		valueAt := event.Cancellation.CanceledAt
 		canceledAt = &valueAt
 	}
-	return queries.SaveAgendaEventV2Params{
-		ID:                  event.ID,
-		CalendarID:          event.CalendarID,
-		EventType:           string(event.Type),
-		Title:               title,
-		Description:         description,
-		StartAt:             timestamp(event.Range.Start),
-		EndAt:               timestamp(event.Range.End),
-		Timezone:            event.Range.Timezone,
-		AllDay:              event.Range.AllDay,
-		DisplayTitle:        nullableText(&event.Title),
-		DisplayDescription:  nullableText(&event.Description),
-		Visibility:          string(event.Visibility),
-		AttendeeID:          attendeeID,
-		AttendeeDisplayName: attendeeDisplayName,
-		CancelReason:        nullableText(cancelReason),
-		CanceledAt:          nullableTimestamp(canceledAt),
-		CreatedAt:           timestamp(event.CreatedAt),
-		UpdatedAt:           timestamp(event.UpdatedAt),
+	params := queries.SaveAgendaEventV2Params{
+		ID:                       event.ID,
+		CalendarID:               event.CalendarID,
+		EventType:                string(event.Type),
+		Title:                    title,
+		Description:              description,
+		StartAt:                  timestamp(event.Range.Start),
+		EndAt:                    timestamp(event.Range.End),
+		Timezone:                 event.Range.Timezone,
+		AllDay:                   event.Range.AllDay,
+		DisplayTitle:             nullableText(&event.Title),
+		DisplayDescription:       nullableText(&event.Description),
+		Visibility:               string(event.Visibility),
+		AttendeeID:               attendeeID,
+		AttendeeDisplayName:      attendeeDisplayName,
+		CancelReason:             nullableText(cancelReason),
+		CanceledAt:               nullableTimestamp(canceledAt),
+		CreatedAt:                timestamp(event.CreatedAt),
+		UpdatedAt:                timestamp(event.UpdatedAt),
+		Version:                  event.Version,
+		RecurrenceExceptionDates: []pgtype.Timestamptz{},
+	}
+	if event.Recurrence != nil {
+		rule := event.Recurrence.Rule.String()
+		params.RecurrenceRule = nullableText(&rule)
+		params.RecurrenceEndAt = nullableTimestamp(event.Recurrence.End(event.Range))
+		for _, exceptionDate := range event.Recurrence.ExceptionDates {
+			params.RecurrenceExceptionDates = append(params.RecurrenceExceptionDates, timestamp(exceptionDate))
+		}
 	}
+	return params
 }
 
 func appointmentReminderV2Params(agendaEventID string, reminder domainv2.AppointmentReminder) queries.SaveAppointmentReminderParams {
@@ -285,6 +403,7 @@ func appointmentReminderV2Params(agendaEventID string, reminder domainv2.Appoint
 		FailedAt:            nullableTimestamp(reminder.FailedAt),
 		FailureReason:       nullableText(reminder.FailureReason),
 		UpdatedAt:           timestamp(reminder.UpdatedAt),
+		Position:            int32(reminder.Position),
 	}
 }
 
@@ -303,20 +422,28 @@ func appointmentNotificationV2Params(notification domainv2.AppointmentNotificati
 		CreatedAt:                  timestamp(notification.CreatedAt),
 		CompletedAt:                nullableTimestamp(notification.CompletedAt),
 		ExpiresAt:                  timestamp(notification.ExpiresAt),
+		ReminderPosition:           nullableInt4(notification.ReminderPosition),
 	}
 }
 
-func (r *Repository) publishCalendarLifecycleEvents(ctx context.Context, events []domainv2.LifecycleEvent) error {
+// publishCalendarLifecycleEvents publishes each lifecycle event twice: as a CloudEvent carrying the saved
+// state of the calendar event, and on the internal job queue, which drives reminders, notifications and the
+// webhooks posting that same CloudEvent.
+func (r *Repository) publishCalendarLifecycleEvents(ctx context.Context, calendarEvent domainv2.CalendarEvent, events []domainv2.LifecycleEvent) error {
 	if len(events) == 0 {
 		return nil
 	}
-	messages := make([]outbox.Message, 0, len(events))
+	messages := make([]outbox.Message, 0, 2*len(events))
 	for _, event := range events {
-		message, err := newCalendarLifecycleOutboxMessage(event)
+		cloudEvent, err := newCalendarLifecycleCloudEventMessage(calendarEvent, event)
 		if err != nil {
 			return err
 		}
-		messages = append(messages, message)
+		message, err := newCalendarLifecycleOutboxMessage(event, cloudEvent.Payload)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message, cloudEvent)
 	}
 	if err := r.publisher.Publish(ctx, messages...); err != nil {
 		return fmt.Errorf("publish calendar lifecycle events: %w", err)
@@ -324,8 +451,14 @@ func (r *Repository) publishCalendarLifecycleEvents(ctx context.Context, events
 	return nil
 }
 
-func newCalendarLifecycleOutboxMessage(event domainv2.LifecycleEvent) (outbox.Message, error) {
-	payload, err := json.Marshal(event)
+// calendarLifecycleJob is the internal job payload of a lifecycle event, with the CloudEvent published for it.
+type calendarLifecycleJob struct {
+	domainv2.LifecycleEvent
+	CloudEvent json.RawMessage `json:"cloudEvent,omitempty"`
+}
+
+func newCalendarLifecycleOutboxMessage(event domainv2.LifecycleEvent, cloudEvent []byte) (outbox.Message, error) {
+	payload, err := json.Marshal(calendarLifecycleJob{LifecycleEvent: event, CloudEvent: cloudEvent})
 	if err != nil {
 		return outbox.Message{}, fmt.Errorf("marshal calendar lifecycle event: %w", err)
 	}
@@ -366,6 +499,17 @@ func agendaEventV2FromDetails(row queries.FindAgendaEventFromDetailsRow) (domain
 			CanceledAt: canceledAt,
 		}
 	}
+	if row.RecurrenceRule.Valid {
+		exceptionDates := make([]time.Time, 0, len(row.RecurrenceExceptionDates))
+		for _, exceptionDate := range row.RecurrenceExceptionDates {
+			exceptionDates = append(exceptionDates, exceptionDate.Time)
+		}
+		recurrence, err := domainv2.NewRecurrence(row.RecurrenceRule.String, exceptionDates)
+		if err != nil {
+			return domainv2.CalendarEvent{}, err
+		}
+		event.Recurrence = &recurrence
+	}
 	return event, nil
 }
 
@@ -378,14 +522,40 @@ func appointmentV2FromDetails(row queries.FindAgendaEventFromDetailsRow) (domain
 	if err != nil {
 		return domainv2.Appointment{}, err
 	}
-	return domainv2.ReconstituteAppointment(customer, services, row.CreatedAt.Time, row.UpdatedAt.Time)
+	var attendance *domainv2.AppointmentAttendance
+	if row.AttendanceStatus.Valid {
+		status, err := domainv2.ParseAttendanceStatus(row.AttendanceStatus.String)
+		if err != nil {
+			return domainv2.Appointment{}, err
+		}
+		attendance = &domainv2.AppointmentAttendance{Status: status, RecordedAt: row.AttendanceRecordedAt.Time}
+	}
+	return domainv2.ReconstituteAppointment(customer, services, attendance, row.CreatedAt.Time, row.UpdatedAt.Time)
+}
+
+// attendanceStatusText and attendanceRecordedAt are NULL when no outcome was recorded, which SaveAppointment
+// treats as "keep the stored outcome" so that legacy writes do not erase it.
+func attendanceStatusText(attendance *domainv2.AppointmentAttendance) pgtype.Text {
+	if attendance == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: string(attendance.Status), Valid: true}
+}
+
+func attendanceRecordedAt(attendance *domainv2.AppointmentAttendance) pgtype.Timestamptz {
+	if attendance == nil {
+		return pgtype.Timestamptz{}
+	}
+	return timestamp(attendance.RecordedAt)
 }
 
 func serviceItemsV2FromJSON(data string) ([]domainv2.ServiceItem, error) {
 	var rows []struct {
-		Name      string  `json:"Name"`
-		ServiceID *string `json:"serviceId"`
-		Position  int     `json:"position"`
+		Name            string  `json:"Name"`
+		ServiceID       *string `json:"serviceId"`
+		Position        int     `json:"position"`
+		DurationSeconds int     `json:"durationSeconds"`
+		BufferSeconds   int     `json:"bufferSeconds"`
 	}
 	if err := json.Unmarshal([]byte(data), &rows); err != nil {
 		return nil, err
@@ -396,7 +566,13 @@ func serviceItemsV2FromJSON(data string) ([]domainv2.ServiceItem, error) {
 		if position < 0 {
 			position = i
 		}
-		item, err := domainv2.NewServiceItem(row.ServiceID, row.Name, position)
+		var item domainv2.ServiceItem
+		var err error
+		if row.ServiceID != nil {
+			item, err = domainv2.NewCatalogServiceItem(*row.ServiceID, row.Name, time.Duration(row.DurationSeconds)*time.Second, time.Duration(row.BufferSeconds)*time.Second, position)
+		} else {
+			item, err = domainv2.NewServiceItem(nil, row.Name, position)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -412,6 +588,14 @@ func nullableString(value pgtype.Text) *string {
 	return &value.String
 }
 
+func nullableInt(value pgtype.Int4) *int {
+	if !value.Valid {
+		return nil
+	}
+	converted := int(value.Int32)
+	return &converted
+}
+
 func nullableTime(value pgtype.Timestamptz) *time.Time {
 	if !value.Valid {
 		return nil
@@ -8,11 +8,13 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
 	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/postgres/queries"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
 	"github.com/petretiandrea/outbox-go/pkg/outbox"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestNewCalendarLifecycleOutboxMessageUsesInternalJobChannel(t *testing.T) {
-	message, err := newCalendarLifecycleOutboxMessage(domainv2.CalendarEventCreated("event-1"))
+	message, err := newCalendarLifecycleOutboxMessage(domainv2.CalendarEventCreated("event-1"), []byte(`{"id":"cloud-event-1"}`))
 	if err != nil {
 		t.Fatalf("newCalendarLifecycleOutboxMessage() error = %v", err)
 	}
@@ -24,38 +26,130 @@ func TestNewCalendarLifecycleOutboxMessageUsesInternalJobChannel(t *testing.T) {
 	}
 
 	var payload struct {
-		Type            string `json:"type"`
-		CalendarEventID string `json:"calendarEventId"`
+		Type            string          `json:"type"`
+		CalendarEventID string          `json:"calendarEventId"`
+		CloudEvent      json.RawMessage `json:"cloudEvent"`
 	}
 	if err := json.Unmarshal(message.Payload, &payload); err != nil {
 		t.Fatalf("payload is not json: %v", err)
 	}
-	if payload.Type != "CalendarEventCreated" || payload.CalendarEventID != "event-1" {
+	if payload.Type != "CalendarEventCreated" || payload.CalendarEventID != "event-1" || string(payload.CloudEvent) != `{"id":"cloud-event-1"}` {
 		t.Fatalf("payload = %#v", payload)
 	}
 }
 
-func TestAppointmentReminderV2FromDetailsReconstitutesFullState(t *testing.T) {
+func TestNewCalendarLifecycleCloudEventMessageCarriesTheRescheduledAppointment(t *testing.T) {
+	now := time.Date(2026, 8, 8, 9, 0, 0, 0, time.UTC)
+	eventRange, err := domainv2.NewTimeRange(now.Add(24*time.Hour), now.Add(25*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	moved, err := domainv2.NewTimeRange(now.Add(26*time.Hour), now.Add(27*time.Hour), "Europe/Rome", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := domainv2.NewCatalogServiceItem("service-1", "Facial", 45*time.Minute, 10*time.Minute, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := domainv2.NewAppointmentEvent(domainv2.AppointmentEventParams{
+		EventID:    "event-1",
+		CalendarID: domainv2.DefaultCalendarID,
+		Range:      eventRange,
+		Customer:   domainv2.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+		Services:   []domainv2.ServiceItem{service},
+		Now:        now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event.PullEvents()
+	event.Reschedule(moved, now.Add(time.Hour))
+	event.Version = 2
+
+	message, err := newCalendarLifecycleCloudEventMessage(event, event.PullEvents()[0])
+	if err != nil {
+		t.Fatalf("newCalendarLifecycleCloudEventMessage() error = %v", err)
+	}
+	if message.Channel != outbox.Channel(ChannelAppointmentLifecycleEvents) || message.AffinityKey != outbox.AffinityKey("event-1") {
+		t.Fatalf("channel = %s, affinity key = %s", message.Channel, message.AffinityKey)
+	}
+
+	var envelope struct {
+		SpecVersion string          `json:"specversion"`
+		ID          string          `json:"id"`
+		Type        string          `json:"type"`
+		Subject     string          `json:"subject"`
+		Time        time.Time       `json:"time"`
+		Data        json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(message.Payload, &envelope); err != nil {
+		t.Fatalf("payload is not json: %v", err)
+	}
+	if envelope.SpecVersion != "1.0" || envelope.ID != message.ID || envelope.Type != "beaesthetic.appointment.v1.CalendarEventRescheduled" || envelope.Subject != "event-1" || !envelope.Time.Equal(now.Add(time.Hour)) {
+		t.Fatalf("envelope = %#v", envelope)
+	}
+	var data appointmentcontracts.CalendarEventLifecycleEvent
+	if err := protojson.Unmarshal(envelope.Data, &data); err != nil {
+		t.Fatalf("data is not a lifecycle event: %v", err)
+	}
+	if data.GetLifecycleType() != appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_RESCHEDULED || data.GetVersion() != 2 {
+		t.Fatalf("data = %v", &data)
+	}
+	if !data.GetPreviousTimeRange().GetStartAt().AsTime().Equal(eventRange.Start) || !data.GetTimeRange().GetStartAt().AsTime().Equal(moved.Start) {
+		t.Fatalf("time ranges = %v -> %v", data.GetPreviousTimeRange(), data.GetTimeRange())
+	}
+	if data.GetCustomer().GetCustomerId() != "customer-1" || len(data.GetServices()) != 1 || data.GetServices()[0].GetDurationMinutes() != 45 {
+		t.Fatalf("customer = %v, services = %v", data.GetCustomer(), data.GetServices())
+	}
+}
+
+func TestAppointmentReminderV2FromRowReconstitutesFullState(t *testing.T) {
 	scheduledAt := time.Date(2026, 8, 8, 9, 0, 0, 0, time.UTC)
 	sentRequestedAt := scheduledAt.Add(time.Hour)
-	row := queries.FindAgendaEventFromDetailsRow{
-		ReminderStatus:          pgtype.Text{String: "send_requested", Valid: true},
-		RemindBeforeSeconds:     pgtype.Int4{Int32: 86400, Valid: true},
-		ReminderScheduledAt:     pgtype.Timestamptz{Time: scheduledAt, Valid: true},
-		ReminderSentRequestedAt: pgtype.Timestamptz{Time: sentRequestedAt, Valid: true},
-		ReminderUpdatedAt:       pgtype.Timestamptz{Time: sentRequestedAt, Valid: true},
+	row := queries.AppointmentReminder{
+		Status:              "send_requested",
+		RemindBeforeSeconds: 86400,
+		ScheduledAt:         pgtype.Timestamptz{Time: scheduledAt, Valid: true},
+		SentRequestedAt:     pgtype.Timestamptz{Time: sentRequestedAt, Valid: true},
+		UpdatedAt:           pgtype.Timestamptz{Time: sentRequestedAt, Valid: true},
+		Position:            1,
 	}
 
-	reminder, err := appointmentReminderV2FromDetails(row)
+	reminder, err := appointmentReminderV2FromRow(row)
 	if err != nil {
-		t.Fatalf("appointmentReminderV2FromDetails() error = %v", err)
+		t.Fatalf("appointmentReminderV2FromRow() error = %v", err)
 	}
-	if reminder == nil || reminder.Status != domainv2.ReminderStatusSendRequested || reminder.RemindBefore != 24*time.Hour {
+	if reminder.Status != domainv2.ReminderStatusSendRequested || reminder.RemindBefore != 24*time.Hour || reminder.Position != 1 {
 		t.Fatalf("reminder = %#v", reminder)
 	}
 	if reminder.ScheduledAt == nil || !reminder.ScheduledAt.Equal(scheduledAt) || reminder.SentRequestedAt == nil || !reminder.SentRequestedAt.Equal(sentRequestedAt) {
 		t.Fatalf("reminder timestamps = %#v", reminder)
 	}
+	if params := appointmentReminderV2Params("event-1", reminder); params.Position != 1 {
+		t.Fatalf("saved position = %d, want 1", params.Position)
+	}
+}
+
+func TestAppointmentV2FromDetailsRestoresTheAttendance(t *testing.T) {
+	recordedAt := time.Date(2026, 8, 8, 11, 0, 0, 0, time.UTC)
+	row := queries.FindAgendaEventFromDetailsRow{
+		CustomerID:           "customer-1",
+		ServicesJson:         "[]",
+		AttendanceStatus:     pgtype.Text{String: "no_show", Valid: true},
+		AttendanceRecordedAt: pgtype.Timestamptz{Time: recordedAt, Valid: true},
+	}
+
+	appointment, err := appointmentV2FromDetails(row)
+	if err != nil {
+		t.Fatalf("appointmentV2FromDetails() error = %v", err)
+	}
+	if appointment.Attendance == nil || appointment.Attendance.Status != domainv2.AttendanceNoShow || !appointment.Attendance.RecordedAt.Equal(recordedAt) {
+		t.Fatalf("attendance = %#v", appointment.Attendance)
+	}
+	if params := attendanceStatusText(nil); params.Valid {
+		t.Fatalf("attendance status without outcome = %#v, want NULL", params)
+	}
 }
 
 func TestServiceItemsV2FromJSONKeepsServiceFields(t *testing.T) {
@@ -69,3 +163,53 @@ func TestServiceItemsV2FromJSONKeepsServiceFields(t *testing.T) {
 		t.Fatalf("service items = %#v", items)
 	}
 }
+
+func TestServiceItemsV2FromJSONRestoresTheDurationSnapshot(t *testing.T) {
+	items, err := serviceItemsV2FromJSON(`[{"Name":"Facial","serviceId":"service-1","position":0,"durationSeconds":2700,"bufferSeconds":600},{"Name":"Consultation","serviceId":null,"position":1,"durationSeconds":0,"bufferSeconds":0}]`)
+	if err != nil {
+		t.Fatalf("serviceItemsV2FromJSON() error = %v", err)
+	}
+	if len(items) != 2 || items[0].Duration != 45*time.Minute || items[0].Buffer != 10*time.Minute || items[1].ServiceID != nil {
+		t.Fatalf("items = %#v", items)
+	}
+}
+
+func TestWaitlistEntryRowRoundTripKeepsServicesAndOffer(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	service, err := domainv2.NewCatalogServiceItem("service-1", "Facial", 45*time.Minute, 10*time.Minute, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := domainv2.WaitlistEntry{
+		ID:         "entry-1",
+		CalendarID: domainv2.DefaultCalendarID,
+		Customer:   domainv2.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+		Services:   []domainv2.ServiceItem{service},
+		Window:     domainv2.TimeRange{Start: now, End: now.Add(4 * time.Hour), Timezone: "Europe/Rome"},
+		Status:     domainv2.WaitlistEntryOffered,
+		Offer: &domainv2.WaitlistOffer{
+			CalendarEventID: "event-1",
+			Start:           now.Add(time.Hour),
+			End:             now.Add(2 * time.Hour),
+			OfferedAt:       now,
+			ExpiresAt:       now.Add(2 * time.Hour),
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	params, err := waitlistEntryParams(entry)
+	if err != nil {
+		t.Fatalf("waitlistEntryParams() error = %v", err)
+	}
+	restored, err := waitlistEntryFromRow(queries.WaitlistEntry(params))
+	if err != nil {
+		t.Fatalf("waitlistEntryFromRow() error = %v", err)
+	}
+	if len(restored.Services) != 1 || restored.Services[0].Duration != 45*time.Minute || restored.Services[0].Buffer != 10*time.Minute {
+		t.Fatalf("services = %#v", restored.Services)
+	}
+	if restored.Offer == nil || *restored.Offer != *entry.Offer || restored.Window != entry.Window {
+		t.Fatalf("restored = %#v, want %#v", restored, entry)
+	}
+}
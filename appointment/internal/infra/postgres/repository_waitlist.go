@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/postgres/queries"
+)
+
+func (r *Repository) NextWaitlistEntryID() string {
+	return uuid.NewString()
+}
+
+func (r *Repository) FindWaitlistEntry(ctx context.Context, entryID string) (*domainv2.WaitlistEntry, error) {
+	row, err := queries.New(r.db).FindWaitlistEntry(ctx, entryID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	entry, err := waitlistEntryFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *Repository) SearchWaitlistEntries(ctx context.Context, query applicationv2.ListWaitlistEntriesQuery) ([]domainv2.WaitlistEntry, error) {
+	params := queries.SearchWaitlistEntriesParams{
+		FilterCalendar: len(query.CalendarIDs) > 0,
+		CalendarIds:    query.CalendarIDs,
+		Statuses:       make([]string, 0, len(query.Statuses)),
+	}
+	for _, status := range query.Statuses {
+		params.Statuses = append(params.Statuses, string(status))
+	}
+	rows, err := queries.New(r.db).SearchWaitlistEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domainv2.WaitlistEntry, 0, len(rows))
+	for _, row := range rows {
+		entry, err := waitlistEntryFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, entry)
+	}
+	return out, nil
+}
+
+func (r *Repository) SaveWaitlistEntry(ctx context.Context, entry domainv2.WaitlistEntry) error {
+	params, err := waitlistEntryParams(entry)
+	if err != nil {
+		return err
+	}
+	return queries.New(r.db).SaveWaitlistEntry(ctx, params)
+}
+
+func waitlistEntryParams(entry domainv2.WaitlistEntry) (queries.SaveWaitlistEntryParams, error) {
+	services, err := serviceItemsV2JSON(entry.Services)
+	if err != nil {
+		return queries.SaveWaitlistEntryParams{}, err
+	}
+	params := queries.SaveWaitlistEntryParams{
+		ID:                      entry.ID,
+		CalendarID:              entry.CalendarID,
+		CustomerID:              entry.Customer.ID,
+		CustomerDisplayName:     entry.Customer.DisplayName,
+		Services:                services,
+		WindowStartAt:           timestamp(entry.Window.Start),
+		WindowEndAt:             timestamp(entry.Window.End),
+		Timezone:                entry.Window.Timezone,
+		Status:                  string(entry.Status),
+		CreatedAt:               timestamp(entry.CreatedAt),
+		UpdatedAt:               timestamp(entry.UpdatedAt),
+		OfferedCalendarEventIds: append([]string{}, entry.OfferedCalendarEventIDs...),
+	}
+	if offer := entry.Offer; offer != nil {
+		params.OfferCalendarEventID = pgtype.Text{String: offer.CalendarEventID, Valid: true}
+		params.OfferStartAt = timestamp(offer.Start)
+		params.OfferEndAt = timestamp(offer.End)
+		params.OfferedAt = timestamp(offer.OfferedAt)
+		params.OfferExpiresAt = timestamp(offer.ExpiresAt)
+	}
+	return params, nil
+}
+
+func waitlistEntryFromRow(row queries.WaitlistEntry) (domainv2.WaitlistEntry, error) {
+	services, err := serviceItemsV2FromJSON(string(row.Services))
+	if err != nil {
+		return domainv2.WaitlistEntry{}, err
+	}
+	entry := domainv2.WaitlistEntry{
+		ID:         row.ID,
+		CalendarID: row.CalendarID,
+		Customer:   domainv2.CustomerRef{ID: row.CustomerID, DisplayName: row.CustomerDisplayName},
+		Services:   services,
+		Window: domainv2.TimeRange{
+			Start:    row.WindowStartAt.Time.UTC(),
+			End:      row.WindowEndAt.Time.UTC(),
+			Timezone: row.Timezone,
+		},
+		Status:                  domainv2.WaitlistEntryStatus(row.Status),
+		OfferedCalendarEventIDs: row.OfferedCalendarEventIds,
+		CreatedAt:               row.CreatedAt.Time,
+		UpdatedAt:               row.UpdatedAt.Time,
+	}
+	if row.OfferCalendarEventID.Valid {
+		entry.Offer = &domainv2.WaitlistOffer{
+			CalendarEventID: row.OfferCalendarEventID.String,
+			Start:           row.OfferStartAt.Time.UTC(),
+			End:             row.OfferEndAt.Time.UTC(),
+			OfferedAt:       row.OfferedAt.Time.UTC(),
+			ExpiresAt:       row.OfferExpiresAt.Time.UTC(),
+		}
+	}
+	return domainv2.ReconstituteWaitlistEntry(entry)
+}
+
+// serviceItemsV2JSON encodes service items in the shape read back by serviceItemsV2FromJSON.
+func serviceItemsV2JSON(services []domainv2.ServiceItem) (json.RawMessage, error) {
+	type serviceItemJSON struct {
+		Name            string  `json:"Name"`
+		ServiceID       *string `json:"serviceId"`
+		Position        int     `json:"position"`
+		DurationSeconds int     `json:"durationSeconds"`
+		BufferSeconds   int     `json:"bufferSeconds"`
+	}
+	items := make([]serviceItemJSON, 0, len(services))
+	for _, service := range services {
+		items = append(items, serviceItemJSON{
+			Name:            service.ServiceName,
+			ServiceID:       service.ServiceID,
+			Position:        service.Position,
+			DurationSeconds: int(service.Duration / time.Second),
+			BufferSeconds:   int(service.Buffer / time.Second),
+		})
+	}
+	return json.Marshal(items)
+}
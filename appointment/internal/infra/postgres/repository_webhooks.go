@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/postgres/queries"
+)
+
+func (r *Repository) NextWebhookSubscriptionID() string {
+	return uuid.NewString()
+}
+
+func (r *Repository) NextWebhookDeliveryID() string {
+	return uuid.NewString()
+}
+
+func (r *Repository) FindWebhookSubscription(ctx context.Context, subscriptionID string) (*domainv2.WebhookSubscription, error) {
+	row, err := queries.New(r.db).FindWebhookSubscription(ctx, subscriptionID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	subscription := webhookSubscriptionFromRow(row)
+	return &subscription, nil
+}
+
+func (r *Repository) ListWebhookSubscriptions(ctx context.Context) ([]domainv2.WebhookSubscription, error) {
+	rows, err := queries.New(r.db).ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domainv2.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, webhookSubscriptionFromRow(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) SaveWebhookSubscription(ctx context.Context, subscription domainv2.WebhookSubscription) error {
+	return queries.New(r.db).SaveWebhookSubscription(ctx, queries.SaveWebhookSubscriptionParams{
+		ID:         subscription.ID,
+		Url:        subscription.URL,
+		Secret:     subscription.Secret,
+		EventTypes: append([]string{}, subscription.EventTypes...),
+		Enabled:    subscription.Enabled,
+		CreatedAt:  timestamp(subscription.CreatedAt),
+		UpdatedAt:  timestamp(subscription.UpdatedAt),
+	})
+}
+
+func (r *Repository) DeleteWebhookSubscription(ctx context.Context, subscriptionID string) (bool, error) {
+	deleted, err := queries.New(r.db).DeleteWebhookSubscription(ctx, subscriptionID)
+	return deleted > 0, err
+}
+
+func (r *Repository) FindWebhookDelivery(ctx context.Context, deliveryID string) (*domainv2.WebhookDelivery, error) {
+	row, err := queries.New(r.db).FindWebhookDelivery(ctx, deliveryID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	delivery := webhookDeliveryFromRow(row)
+	return &delivery, nil
+}
+
+func (r *Repository) ListWebhookDeliveries(ctx context.Context, subscriptionID string, limit int) ([]domainv2.WebhookDelivery, error) {
+	rows, err := queries.New(r.db).ListWebhookDeliveries(ctx, queries.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domainv2.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, webhookDeliveryFromRow(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) SaveWebhookDelivery(ctx context.Context, delivery domainv2.WebhookDelivery) error {
+	return queries.New(r.db).SaveWebhookDelivery(ctx, webhookDeliveryParams(delivery))
+}
+
+func webhookDeliveryParams(delivery domainv2.WebhookDelivery) queries.SaveWebhookDeliveryParams {
+	params := queries.SaveWebhookDeliveryParams{
+		ID:              delivery.ID,
+		SubscriptionID:  delivery.SubscriptionID,
+		EventType:       delivery.EventType,
+		CalendarEventID: delivery.CalendarEventID,
+		Payload:         delivery.Payload,
+		Status:          string(delivery.Status),
+		Attempts:        int32(delivery.Attempts),
+		CreatedAt:       timestamp(delivery.CreatedAt),
+		LastAttemptAt:   nullableTimestamp(delivery.LastAttemptAt),
+		DeliveredAt:     nullableTimestamp(delivery.DeliveredAt),
+	}
+	if delivery.LastResponseStatus != 0 {
+		params.LastResponseStatus = pgtype.Int4{Int32: int32(delivery.LastResponseStatus), Valid: true}
+	}
+	if delivery.LastError != "" {
+		params.LastError = pgtype.Text{String: delivery.LastError, Valid: true}
+	}
+	return params
+}
+
+func webhookSubscriptionFromRow(row queries.WebhookSubscription) domainv2.WebhookSubscription {
+	return domainv2.WebhookSubscription{
+		ID:         row.ID,
+		URL:        row.Url,
+		Secret:     row.Secret,
+		EventTypes: row.EventTypes,
+		Enabled:    row.Enabled,
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+}
+
+func webhookDeliveryFromRow(row queries.WebhookDelivery) domainv2.WebhookDelivery {
+	return domainv2.WebhookDelivery{
+		ID:                 row.ID,
+		SubscriptionID:     row.SubscriptionID,
+		EventType:          row.EventType,
+		CalendarEventID:    row.CalendarEventID,
+		Payload:            row.Payload,
+		Status:             domainv2.WebhookDeliveryStatus(row.Status),
+		Attempts:           int(row.Attempts),
+		LastResponseStatus: int(row.LastResponseStatus.Int32),
+		LastError:          row.LastError.String,
+		CreatedAt:          row.CreatedAt.Time,
+		LastAttemptAt:      nullableTime(row.LastAttemptAt),
+		DeliveredAt:        nullableTime(row.DeliveredAt),
+	}
+}
@@ -60,3 +60,35 @@ func (i *RiverJobInserter) CancelByKey(ctx context.Context, kind string, queue s
 	}
 	return nil
 }
+
+// ListActive returns the jobs of kind in queue that have not finished yet, in the
+// current transaction. It covers the same states CancelByKey cancels, running included.
+func (i *RiverJobInserter) ListActive(ctx context.Context, kind string, queue string) ([]*rivertype.JobRow, error) {
+	tx, ok := i.db.executor(ctx).(pgx.Tx)
+	if !ok {
+		return nil, fmt.Errorf("river job list requires a postgres transaction")
+	}
+	params := river.NewJobListParams().
+		First(500).
+		Kinds(kind).
+		Queues(queue).
+		States(
+			rivertype.JobStateAvailable,
+			rivertype.JobStatePending,
+			rivertype.JobStateRetryable,
+			rivertype.JobStateRunning,
+			rivertype.JobStateScheduled,
+		)
+	var rows []*rivertype.JobRow
+	for {
+		jobs, err := i.client.JobListTx(ctx, tx, params)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, jobs.Jobs...)
+		if len(jobs.Jobs) < 500 {
+			return rows, nil
+		}
+		params = params.After(jobs.LastCursor)
+	}
+}
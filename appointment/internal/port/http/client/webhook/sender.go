@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/infra/cloudevents"
+)
+
+const (
+	HeaderDelivery  = "X-Beaesthetic-Delivery"
+	HeaderTimestamp = "X-Beaesthetic-Timestamp"
+	// HeaderSignature carries "v1=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the
+	// subscription secret.
+	HeaderSignature = "X-Beaesthetic-Signature"
+)
+
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}
+}
+
+// SendWebhook posts the delivery payload signed with the subscription secret and returns the response status.
+func (s *Sender) SendWebhook(ctx context.Context, subscription domainv2.WebhookSubscription, delivery domainv2.WebhookDelivery) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := s.now().Unix()
+	request.Header.Set("Content-Type", cloudevents.ContentType)
+	request.Header.Set(HeaderDelivery, delivery.ID)
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+	return response.StatusCode, nil
+}
+
+// Sign returns the signature header value of a body sent at the unix timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestSenderPostsTheSignedPayload(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := NewSender(time.Second)
+	sender.now = func() time.Time { return time.Unix(1790000000, 0) }
+	subscription := domainv2.WebhookSubscription{ID: "subscription-1", URL: server.URL, Secret: "0123456789abcdef"}
+	delivery := domainv2.WebhookDelivery{ID: "delivery-1", Payload: []byte(`{"id":"delivery-1"}`)}
+
+	status, err := sender.SendWebhook(context.Background(), subscription, delivery)
+	if err != nil {
+		t.Fatalf("SendWebhook() error = %v", err)
+	}
+	if status != http.StatusAccepted {
+		t.Fatalf("status = %d", status)
+	}
+	if string(body) != `{"id":"delivery-1"}` {
+		t.Fatalf("body = %s", body)
+	}
+	if received.Header.Get("Content-Type") != "application/cloudevents+json" {
+		t.Fatalf("content type = %q", received.Header.Get("Content-Type"))
+	}
+	if received.Header.Get(HeaderDelivery) != "delivery-1" || received.Header.Get(HeaderTimestamp) != "1790000000" {
+		t.Fatalf("headers = %v", received.Header)
+	}
+	if got, want := received.Header.Get(HeaderSignature), Sign(subscription.Secret, 1790000000, body); got != want {
+		t.Fatalf("signature = %q, want %q", got, want)
+	}
+	if Sign("another-secret-value", 1790000000, body) == Sign(subscription.Secret, 1790000000, body) {
+		t.Fatal("signature does not depend on the secret")
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+)
+
+func (s *Server) getCalendarFeedLinkProto(ctx *gin.Context) {
+	response, err := s.GetCalendarFeedLink(ctx.Request.Context(), &appointmentcontracts.GetCalendarFeedLinkRequest{
+		CalendarId: strings.TrimSpace(ctx.Query("calendarId")),
+		CustomerId: strings.TrimSpace(ctx.Query("customerId")),
+	})
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) GetCalendarFeedLink(ctx context.Context, request *appointmentcontracts.GetCalendarFeedLinkRequest) (*appointmentcontracts.GetCalendarFeedLinkResponse, error) {
+	subject, err := calendarFeedSubjectFromProto(request)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	token, err := s.feeds.FeedToken(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.GetCalendarFeedLinkResponse{
+		Path:  calendarFeedPath(subject, token),
+		Token: token,
+	}, nil
+}
+
+func (s *Server) getCalendarFeedICS(ctx *gin.Context) {
+	s.writeCalendarFeed(ctx, applicationv2.CalendarFeedSubject{Kind: applicationv2.CalendarFeedKindCalendar, ID: ctx.Param("calendar_id")})
+}
+
+func (s *Server) getCustomerFeedICS(ctx *gin.Context) {
+	s.writeCalendarFeed(ctx, applicationv2.CalendarFeedSubject{Kind: applicationv2.CalendarFeedKindCustomer, ID: ctx.Param("customer_id")})
+}
+
+func (s *Server) writeCalendarFeed(ctx *gin.Context, subject applicationv2.CalendarFeedSubject) {
+	feed, err := s.feeds.Feed(ctx.Request.Context(), subject, ctx.Query("token"))
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	ctx.Header("Cache-Control", "private, max-age=300")
+	ctx.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(encodeICS(*feed, time.Now())))
+}
+
+func calendarFeedSubjectFromProto(request *appointmentcontracts.GetCalendarFeedLinkRequest) (applicationv2.CalendarFeedSubject, error) {
+	switch {
+	case request.GetCalendarId() != "" && request.GetCustomerId() == "":
+		return applicationv2.CalendarFeedSubject{Kind: applicationv2.CalendarFeedKindCalendar, ID: request.GetCalendarId()}, nil
+	case request.GetCustomerId() != "" && request.GetCalendarId() == "":
+		return applicationv2.CalendarFeedSubject{Kind: applicationv2.CalendarFeedKindCustomer, ID: request.GetCustomerId()}, nil
+	default:
+		return applicationv2.CalendarFeedSubject{}, fmt.Errorf("exactly one of calendarId and customerId is required")
+	}
+}
+
+func calendarFeedPath(subject applicationv2.CalendarFeedSubject, token string) string {
+	collection := "calendars"
+	if subject.Kind == applicationv2.CalendarFeedKindCustomer {
+		collection = "customers"
+	}
+	return fmt.Sprintf("/v1/calendar-feeds/%s/%s/feed.ics?token=%s", collection, url.PathEscape(subject.ID), url.QueryEscape(token))
+}
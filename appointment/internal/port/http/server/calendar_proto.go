@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -23,24 +25,67 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const defaultReminderBeforeSeconds = int32(24 * 60 * 60)
-
 var (
 	protoJSONUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: false}
 	protoJSONMarshal   = protojson.MarshalOptions{UseProtoNames: false, EmitUnpopulated: false}
 )
 
+var errServiceNotFound = errors.New("service not found")
+
+// invalidRequestError marks an error caused by the content of the request, answered with a bad request.
+type invalidRequestError struct {
+	err error
+}
+
+func invalidRequest(err error) error {
+	return invalidRequestError{err: err}
+}
+
+func (e invalidRequestError) Error() string {
+	return e.err.Error()
+}
+
+func (e invalidRequestError) Unwrap() error {
+	return e.err
+}
+
 func registerCalendarProtoRoutes(r gin.IRouter, handler *Server) {
 	r.POST("/v1/calendar-events", handler.createCalendarEventProto)
 	r.GET("/v1/calendar-events/:id", handler.getCalendarEventProto)
 	r.GET("/v1/calendar-events", handler.listCalendarEventsProto)
+	r.POST("/v1/calendar-events:bulkChange", handler.bulkChangeCalendarEventsProto)
 	r.PATCH("/v1/calendar-events/:id", handler.updateCalendarEventProto)
 	r.DELETE("/v1/calendar-events/:id", handler.cancelCalendarEventProto)
 	r.POST("/v1/calendar-events/:calendar_event_id/reminder/resend", handler.requestReminderResendProto)
+	r.GET("/v1/calendar-events/:id/notifications", handler.listCalendarEventNotificationsProto)
+	r.POST("/v1/calendar-events/:calendar_event_id/complete", handler.markCalendarEventCompletedProto)
+	r.POST("/v1/calendar-events/:calendar_event_id/no-show", handler.markCalendarEventNoShowProto)
+	r.GET("/v1/available-slots", handler.findAvailableSlotsProto)
+	r.POST("/v1/calendars", handler.createCalendarProto)
+	r.GET("/v1/calendars", handler.listCalendarsProto)
+	r.PATCH("/v1/calendars/:id", handler.updateCalendarProto)
+	r.POST("/v1/calendars/:id/archive", handler.archiveCalendarProto)
+	r.POST("/v1/calendars/:id/time-block-imports", handler.importTimeBlocksProto)
+	r.GET("/v1/calendar-feeds:link", handler.getCalendarFeedLinkProto)
+	r.GET("/v1/calendar-feeds/calendars/:calendar_id/feed.ics", handler.getCalendarFeedICS)
+	r.GET("/v1/calendar-feeds/customers/:customer_id/feed.ics", handler.getCustomerFeedICS)
 	r.POST("/v1/services", handler.createServiceProto)
 	r.PATCH("/v1/services/:id", handler.updateServiceProto)
 	r.GET("/v1/services:search", handler.searchServicesProto)
 	r.GET("/v1/services", handler.listServicesProto)
+	r.GET("/v1/insights/customer-ranking", handler.getCustomerRankingProto)
+	r.GET("/v1/insights/customer-cancellation-ranking", handler.getCustomerCancellationRankingProto)
+	r.GET("/v1/insights/customer-no-show-ranking", handler.getCustomerNoShowRankingProto)
+	r.GET("/v1/insights/overview", handler.getInsightOverviewProto)
+	r.POST("/v1/waitlist-entries", handler.createWaitlistEntryProto)
+	r.GET("/v1/waitlist-entries", handler.listWaitlistEntriesProto)
+	r.DELETE("/v1/waitlist-entries/:id", handler.removeWaitlistEntryProto)
+	r.POST("/v1/webhook-subscriptions", handler.createWebhookSubscriptionProto)
+	r.GET("/v1/webhook-subscriptions", handler.listWebhookSubscriptionsProto)
+	r.GET("/v1/webhook-subscriptions/:id", handler.getWebhookSubscriptionProto)
+	r.PATCH("/v1/webhook-subscriptions/:id", handler.updateWebhookSubscriptionProto)
+	r.DELETE("/v1/webhook-subscriptions/:id", handler.deleteWebhookSubscriptionProto)
+	r.GET("/v1/webhook-subscriptions/:id/deliveries", handler.listWebhookDeliveriesProto)
 }
 
 func (s *Server) createServiceProto(ctx *gin.Context) {
@@ -48,12 +93,20 @@ func (s *Server) createServiceProto(ctx *gin.Context) {
 	if !s.readProtoJSON(ctx, &request) {
 		return
 	}
-	service, err := s.services.CreateService(ctx.Request.Context(), request.GetName(), request.Tags, request.Color)
+	response, err := s.CreateService(ctx.Request.Context(), &request)
 	if err != nil {
-		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		s.writeCalendarError(ctx, err)
 		return
 	}
-	s.writeProtoJSON(ctx, http.StatusCreated, &appointmentcontracts.CreateServiceResponse{Service: catalogServiceProto(service)})
+	s.writeProtoJSON(ctx, http.StatusCreated, response)
+}
+
+func (s *Server) CreateService(ctx context.Context, request *appointmentcontracts.CreateServiceRequest) (*appointmentcontracts.CreateServiceResponse, error) {
+	service, err := s.services.CreateService(ctx, request.GetName(), request.Tags, request.Color, minutes(request.GetDurationMinutes()), minutes(request.GetBufferMinutes()))
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	return &appointmentcontracts.CreateServiceResponse{Service: catalogServiceProto(service)}, nil
 }
 
 func (s *Server) updateServiceProto(ctx *gin.Context) {
@@ -61,16 +114,24 @@ func (s *Server) updateServiceProto(ctx *gin.Context) {
 	if !s.readProtoJSON(ctx, &request) {
 		return
 	}
-	service, err := s.services.UpdateService(ctx.Request.Context(), ctx.Param("id"), request.Tags, request.Color)
+	request.Id = ctx.Param("id")
+	response, err := s.UpdateService(ctx.Request.Context(), &request)
 	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) UpdateService(ctx context.Context, request *appointmentcontracts.UpdateServiceRequest) (*appointmentcontracts.UpdateServiceResponse, error) {
+	service, err := s.services.UpdateService(ctx, request.GetId(), request.Tags, request.Color, optionalMinutes(request.DurationMinutes), optionalMinutes(request.BufferMinutes))
+	if err != nil {
+		return nil, err
+	}
 	if service == nil {
-		s.writeProtoError(ctx, http.StatusNotFound, "service not found")
-		return
+		return nil, errServiceNotFound
 	}
-	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.UpdateServiceResponse{Service: catalogServiceProto(*service)})
+	return &appointmentcontracts.UpdateServiceResponse{Service: catalogServiceProto(*service)}, nil
 }
 
 func (s *Server) searchServicesProto(ctx *gin.Context) {
@@ -79,7 +140,20 @@ func (s *Server) searchServicesProto(ctx *gin.Context) {
 		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	s.writeServiceSearchResponse(ctx, request.GetQuery(), int(request.GetLimit()), true)
+	response, err := s.SearchServices(ctx.Request.Context(), request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) SearchServices(ctx context.Context, request *appointmentcontracts.SearchServicesRequest) (*appointmentcontracts.SearchServicesResponse, error) {
+	services, err := s.catalogServices(ctx, request.GetQuery(), request.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.SearchServicesResponse{Services: services}, nil
 }
 
 func (s *Server) listServicesProto(ctx *gin.Context) {
@@ -88,24 +162,35 @@ func (s *Server) listServicesProto(ctx *gin.Context) {
 		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	s.writeServiceSearchResponse(ctx, request.GetQuery(), int(request.GetLimit()), false)
-}
-
-func (s *Server) writeServiceSearchResponse(ctx *gin.Context, query string, limit int, search bool) {
-	services, err := s.services.SearchServices(ctx.Request.Context(), query, limit)
+	response, err := s.ListServices(ctx.Request.Context(), request)
 	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) ListServices(ctx context.Context, request *appointmentcontracts.ListServicesRequest) (*appointmentcontracts.ListServicesResponse, error) {
+	services, err := s.catalogServices(ctx, request.GetQuery(), request.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.ListServicesResponse{Services: services}, nil
+}
+
+func (s *Server) catalogServices(ctx context.Context, query string, limit int32) ([]*appointmentcontracts.CatalogService, error) {
+	if limit < 0 {
+		return nil, invalidRequest(fmt.Errorf("limit must be a positive integer"))
+	}
+	services, err := s.services.SearchServices(ctx, query, int(limit))
+	if err != nil {
+		return nil, err
+	}
 	response := make([]*appointmentcontracts.CatalogService, 0, len(services))
 	for _, service := range services {
 		response = append(response, catalogServiceProto(service))
 	}
-	if search {
-		s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.SearchServicesResponse{Services: response})
-		return
-	}
-	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.ListServicesResponse{Services: response})
+	return response, nil
 }
 
 func listServicesRequestFromQuery(ctx *gin.Context) (*appointmentcontracts.ListServicesRequest, error) {
@@ -135,43 +220,73 @@ func (s *Server) createCalendarEventProto(ctx *gin.Context) {
 	if !s.readProtoJSON(ctx, &request) {
 		return
 	}
-	event, err := s.createCalendarEvent(ctx.Request.Context(), &request)
+	response, err := s.CreateCalendarEvent(ctx.Request.Context(), &request)
 	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
-	s.writeProtoJSON(ctx, http.StatusCreated, &appointmentcontracts.CreateCalendarEventResponse{CalendarEventId: event.ID})
+	s.writeProtoJSON(ctx, http.StatusCreated, response)
+}
+
+func (s *Server) CreateCalendarEvent(ctx context.Context, request *appointmentcontracts.CreateCalendarEventRequest) (*appointmentcontracts.CreateCalendarEventResponse, error) {
+	event, conflicts, err := s.createCalendarEvent(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.CreateCalendarEventResponse{
+		CalendarEventId: event.ID,
+		Conflicts:       calendarEventConflictsProto(conflicts),
+	}, nil
 }
 
 func (s *Server) getCalendarEventProto(ctx *gin.Context) {
-	view, err := s.calendar.GetCalendarEventView(ctx.Request.Context(), ctx.Param("id"))
+	response, err := s.GetCalendarEvent(ctx.Request.Context(), &appointmentcontracts.GetCalendarEventRequest{Id: ctx.Param("id")})
 	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
-	if view == nil {
-		s.writeProtoError(ctx, http.StatusNotFound, "calendar event not found")
-		return
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) GetCalendarEvent(ctx context.Context, request *appointmentcontracts.GetCalendarEventRequest) (*appointmentcontracts.GetCalendarEventResponse, error) {
+	view, err := s.calendarEventView(ctx, request.GetId())
+	if err != nil {
+		return nil, err
 	}
-	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.GetCalendarEventResponse{Event: calendarEventProto(*view)})
+	return &appointmentcontracts.GetCalendarEventResponse{Event: calendarEventProto(*view)}, nil
 }
 
 func (s *Server) listCalendarEventsProto(ctx *gin.Context) {
-	query, err := calendarEventsListQueryFromProto(ctx)
+	request, err := listCalendarEventsRequestFromQuery(ctx)
 	if err != nil {
 		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	views, err := s.calendar.ListCalendarEventViews(ctx.Request.Context(), query)
+	response, err := s.ListCalendarEvents(ctx.Request.Context(), request)
 	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
-	out := make([]*appointmentcontracts.CalendarEvent, 0, len(views))
-	for _, view := range views {
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) ListCalendarEvents(ctx context.Context, request *appointmentcontracts.ListCalendarEventsRequest) (*appointmentcontracts.ListCalendarEventsResponse, error) {
+	query, err := calendarEventsListQuery(request)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	page, err := s.calendar.ListCalendarEventPage(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*appointmentcontracts.CalendarEvent, 0, len(page.Views))
+	for _, view := range page.Views {
 		out = append(out, calendarEventProto(view))
 	}
-	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.ListCalendarEventsResponse{Events: out})
+	return &appointmentcontracts.ListCalendarEventsResponse{
+		Events:        out,
+		NextPageToken: calendarEventPageToken(page.Next),
+	}, nil
 }
 
 func (s *Server) updateCalendarEventProto(ctx *gin.Context) {
@@ -180,26 +295,31 @@ func (s *Server) updateCalendarEventProto(ctx *gin.Context) {
 		return
 	}
 	request.Id = ctx.Param("id")
-	command, err := s.updateCalendarEventCommand(ctx.Request.Context(), &request)
+	response, err := s.UpdateCalendarEvent(ctx.Request.Context(), &request)
 	if err != nil {
-		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		s.writeCalendarError(ctx, err)
 		return
 	}
-	event, err := s.calendar.Update(ctx.Request.Context(), command)
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) UpdateCalendarEvent(ctx context.Context, request *appointmentcontracts.UpdateCalendarEventRequest) (*appointmentcontracts.UpdateCalendarEventResponse, error) {
+	command, err := s.updateCalendarEventCommand(ctx, request)
 	if err != nil {
-		s.writeCalendarError(ctx, err)
-		return
+		return nil, invalidRequest(err)
 	}
-	view, err := s.calendar.GetCalendarEventView(ctx.Request.Context(), event.ID)
+	event, conflicts, err := s.calendar.Update(ctx, command)
 	if err != nil {
-		s.writeCalendarError(ctx, err)
-		return
+		return nil, err
 	}
-	if view == nil {
-		s.writeProtoError(ctx, http.StatusNotFound, "calendar event not found")
-		return
+	view, err := s.calendarEventView(ctx, event.ID)
+	if err != nil {
+		return nil, err
 	}
-	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.UpdateCalendarEventResponse{Event: calendarEventProto(*view)})
+	return &appointmentcontracts.UpdateCalendarEventResponse{
+		Event:     calendarEventProto(*view),
+		Conflicts: calendarEventConflictsProto(conflicts),
+	}, nil
 }
 
 func (s *Server) cancelCalendarEventProto(ctx *gin.Context) {
@@ -209,82 +329,397 @@ func (s *Server) cancelCalendarEventProto(ctx *gin.Context) {
 			return
 		}
 	}
+	request.Id = ctx.Param("id")
+	response, err := s.CancelCalendarEvent(ctx.Request.Context(), &request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) CancelCalendarEvent(ctx context.Context, request *appointmentcontracts.CancelCalendarEventRequest) (*appointmentcontracts.CancelCalendarEventResponse, error) {
 	reason := cancelReasonFromProto(request.GetReason())
 	if reason == "" {
 		reason = domain.CancelReasonDeleted
 	}
-	_, err := s.calendar.CancelEvent(ctx.Request.Context(), applicationv2.CancelEventCommand{CalendarEventID: ctx.Param("id"), Reason: reason})
+	occurrence, err := occurrenceSelectionFromProto(request.GetOccurrenceStartAt(), request.GetScope())
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	if _, err := s.calendar.CancelEvent(ctx, applicationv2.CancelEventCommand{
+		CalendarEventID: request.GetId(),
+		Reason:          reason,
+		ExpectedVersion: request.ExpectedVersion,
+		Occurrence:      occurrence,
+	}); err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.CancelCalendarEventResponse{}, nil
+}
+
+func (s *Server) bulkChangeCalendarEventsProto(ctx *gin.Context) {
+	var request appointmentcontracts.BulkChangeCalendarEventsRequest
+	if !s.readProtoJSON(ctx, &request) {
+		return
+	}
+	response, err := s.BulkChangeCalendarEvents(ctx.Request.Context(), &request)
 	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
-	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.CancelCalendarEventResponse{})
+	s.writeProtoJSON(ctx, http.StatusOK, response)
 }
 
-func (s *Server) requestReminderResendProto(ctx *gin.Context) {
-	eventID := ctx.Param("calendar_event_id")
-	var request appointmentcontracts.RequestReminderResendRequest
+func (s *Server) BulkChangeCalendarEvents(ctx context.Context, request *appointmentcontracts.BulkChangeCalendarEventsRequest) (*appointmentcontracts.BulkChangeCalendarEventsResponse, error) {
+	command, err := bulkChangeEventsCommandFromProto(request)
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	results, err := s.calendar.BulkChangeEvents(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	response := &appointmentcontracts.BulkChangeCalendarEventsResponse{
+		Results: make([]*appointmentcontracts.BulkChangeCalendarEventResult, 0, len(results)),
+	}
+	for _, result := range results {
+		out := &appointmentcontracts.BulkChangeCalendarEventResult{
+			CalendarEventId: result.CalendarEventID,
+			StartAt:         timestamppb.New(result.Start),
+			Status:          bulkChangeStatusProto(result.Status),
+		}
+		if result.Err != nil {
+			out.Error = result.Err.Error()
+		}
+		if result.Event != nil {
+			view, err := s.calendar.GetCalendarEventView(ctx, result.Event.ID)
+			if err != nil {
+				return nil, err
+			}
+			if view != nil {
+				out.Event = calendarEventProto(*view)
+			}
+		}
+		response.Results = append(response.Results, out)
+	}
+	return response, nil
+}
+
+func bulkChangeEventsCommandFromProto(request *appointmentcontracts.BulkChangeCalendarEventsRequest) (applicationv2.BulkChangeEventsCommand, error) {
+	if request.GetStartAt() == nil || request.GetEndAt() == nil {
+		return applicationv2.BulkChangeEventsCommand{}, fmt.Errorf("startAt and endAt are required")
+	}
+	command := applicationv2.BulkChangeEventsCommand{
+		CalendarID: request.GetCalendarId(),
+		Start:      request.GetStartAt().AsTime(),
+		End:        request.GetEndAt().AsTime(),
+	}
+	for _, eventType := range request.GetEventTypes() {
+		converted, err := calendarEventTypeFromString(eventType.String())
+		if err != nil {
+			return applicationv2.BulkChangeEventsCommand{}, err
+		}
+		command.EventTypes = append(command.EventTypes, converted)
+	}
+	switch action := request.GetAction().(type) {
+	case *appointmentcontracts.BulkChangeCalendarEventsRequest_Cancel:
+		reason := cancelReasonFromProto(action.Cancel.GetReason())
+		if reason == "" {
+			reason = domain.CancelReasonDeleted
+		}
+		command.Action = applicationv2.BulkCancelEvents{Reason: reason}
+	case *appointmentcontracts.BulkChangeCalendarEventsRequest_Shift:
+		if action.Shift.GetByMinutes() == 0 {
+			return applicationv2.BulkChangeEventsCommand{}, fmt.Errorf("shift.byMinutes must not be zero")
+		}
+		command.Action = applicationv2.BulkShiftEvents{By: time.Duration(action.Shift.GetByMinutes()) * time.Minute}
+	default:
+		return applicationv2.BulkChangeEventsCommand{}, fmt.Errorf("one of cancel or shift is required")
+	}
+	return command, nil
+}
+
+func bulkChangeStatusProto(status applicationv2.BulkEventStatus) appointmentcontracts.BulkChangeStatus {
+	switch status {
+	case applicationv2.BulkEventCanceled:
+		return appointmentcontracts.BulkChangeStatus_BULK_CHANGE_STATUS_CANCELED
+	case applicationv2.BulkEventShifted:
+		return appointmentcontracts.BulkChangeStatus_BULK_CHANGE_STATUS_SHIFTED
+	case applicationv2.BulkEventRejected:
+		return appointmentcontracts.BulkChangeStatus_BULK_CHANGE_STATUS_REJECTED
+	default:
+		return appointmentcontracts.BulkChangeStatus_BULK_CHANGE_STATUS_UNSPECIFIED
+	}
+}
+
+func (s *Server) markCalendarEventCompletedProto(ctx *gin.Context) {
+	var request appointmentcontracts.MarkCalendarEventCompletedRequest
 	if ctx.Request.Body != nil && ctx.Request.ContentLength != 0 {
 		if !s.readProtoJSON(ctx, &request) {
 			return
 		}
-		if request.GetCalendarEventId() != "" {
-			eventID = request.GetCalendarEventId()
+	}
+	request.CalendarEventId = ctx.Param("calendar_event_id")
+	response, err := s.MarkCalendarEventCompleted(ctx.Request.Context(), &request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) MarkCalendarEventCompleted(ctx context.Context, request *appointmentcontracts.MarkCalendarEventCompletedRequest) (*appointmentcontracts.MarkCalendarEventCompletedResponse, error) {
+	view, err := s.recordAttendance(ctx, request.GetCalendarEventId(), request.ExpectedVersion, s.calendar.MarkCompleted)
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.MarkCalendarEventCompletedResponse{Event: calendarEventProto(*view)}, nil
+}
+
+func (s *Server) markCalendarEventNoShowProto(ctx *gin.Context) {
+	var request appointmentcontracts.MarkCalendarEventNoShowRequest
+	if ctx.Request.Body != nil && ctx.Request.ContentLength != 0 {
+		if !s.readProtoJSON(ctx, &request) {
+			return
 		}
 	}
-	idempotencyKey := strings.TrimSpace(request.GetIdempotencyKey())
-	if idempotencyKey == "" {
-		idempotencyKey = uuid.NewString()
+	request.CalendarEventId = ctx.Param("calendar_event_id")
+	response, err := s.MarkCalendarEventNoShow(ctx.Request.Context(), &request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) MarkCalendarEventNoShow(ctx context.Context, request *appointmentcontracts.MarkCalendarEventNoShowRequest) (*appointmentcontracts.MarkCalendarEventNoShowResponse, error) {
+	view, err := s.recordAttendance(ctx, request.GetCalendarEventId(), request.ExpectedVersion, s.calendar.MarkNoShow)
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.MarkCalendarEventNoShowResponse{Event: calendarEventProto(*view)}, nil
+}
+
+// recordAttendance applies mark to the calendar event and returns its updated view.
+func (s *Server) recordAttendance(ctx context.Context, calendarEventID string, expectedVersion *int64, mark func(context.Context, applicationv2.RecordAttendanceCommand) (*domain.CalendarEvent, error)) (*applicationv2.CalendarEventView, error) {
+	event, err := mark(ctx, applicationv2.RecordAttendanceCommand{
+		CalendarEventID: calendarEventID,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.calendarEventView(ctx, event.ID)
+}
+
+// calendarEventView returns the view of a calendar event, or ErrCalendarEventNotFound when it does not exist.
+func (s *Server) calendarEventView(ctx context.Context, calendarEventID string) (*applicationv2.CalendarEventView, error) {
+	view, err := s.calendar.GetCalendarEventView(ctx, calendarEventID)
+	if err != nil {
+		return nil, err
 	}
-	if err := s.reminders.RequestReminderResend(ctx.Request.Context(), eventID, idempotencyKey); err != nil {
+	if view == nil {
+		return nil, applicationv2.ErrCalendarEventNotFound
+	}
+	return view, nil
+}
+
+func (s *Server) findAvailableSlotsProto(ctx *gin.Context) {
+	request, err := findAvailableSlotsRequestFromQuery(ctx)
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := s.FindAvailableSlots(ctx.Request.Context(), request)
+	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
-	view, err := s.calendar.GetCalendarEventView(ctx.Request.Context(), eventID)
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) FindAvailableSlots(ctx context.Context, request *appointmentcontracts.FindAvailableSlotsRequest) (*appointmentcontracts.FindAvailableSlotsResponse, error) {
+	query, err := s.availableSlotsQuery(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := s.availability.FindAvailableSlots(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	response := &appointmentcontracts.FindAvailableSlotsResponse{Slots: make([]*appointmentcontracts.AvailableSlot, 0, len(slots))}
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, &appointmentcontracts.AvailableSlot{
+			StartAt: timestamppb.New(slot.Start),
+			EndAt:   timestamppb.New(slot.End),
+		})
+	}
+	return response, nil
+}
+
+// availableSlotsQuery wraps the validation errors with invalidRequest; an unknown service is reported as
+// errServiceNotFound and a failing service lookup is returned as is.
+func (s *Server) availableSlotsQuery(ctx context.Context, request *appointmentcontracts.FindAvailableSlotsRequest) (applicationv2.FindAvailableSlotsQuery, error) {
+	if request.GetStartAt() == nil || request.GetEndAt() == nil {
+		return applicationv2.FindAvailableSlotsQuery{}, invalidRequest(fmt.Errorf("startAt and endAt are required"))
+	}
+	calendarID, err := domain.NormalizeCalendarID(request.GetCalendarId())
+	if err != nil {
+		return applicationv2.FindAvailableSlotsQuery{}, invalidRequest(err)
+	}
+	var servicesDuration time.Duration
+	for _, serviceID := range request.GetServiceIds() {
+		service, err := s.services.FindService(ctx, serviceID)
+		if err != nil {
+			return applicationv2.FindAvailableSlotsQuery{}, err
+		}
+		if service == nil {
+			return applicationv2.FindAvailableSlotsQuery{}, fmt.Errorf("%w: %s", errServiceNotFound, serviceID)
+		}
+		servicesDuration += service.Duration + service.Buffer
+	}
+	duration := minutes(request.GetDurationMinutes())
+	if duration == 0 {
+		duration = servicesDuration
+	}
+	if duration <= 0 {
+		return applicationv2.FindAvailableSlotsQuery{}, invalidRequest(fmt.Errorf("durationMinutes is required unless the services have a duration"))
+	}
+	return applicationv2.FindAvailableSlotsQuery{
+		CalendarID: calendarID,
+		Start:      request.GetStartAt().AsTime(),
+		End:        request.GetEndAt().AsTime(),
+		Duration:   duration,
+	}, nil
+}
+
+func findAvailableSlotsRequestFromQuery(ctx *gin.Context) (*appointmentcontracts.FindAvailableSlotsRequest, error) {
+	request := &appointmentcontracts.FindAvailableSlotsRequest{
+		CalendarId: ctx.Query("calendarId"),
+		ServiceIds: ctx.QueryArray("serviceIds"),
+	}
+	start, err := time.Parse(time.RFC3339, ctx.Query("startAt"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid startAt")
+	}
+	end, err := time.Parse(time.RFC3339, ctx.Query("endAt"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endAt")
+	}
+	request.StartAt = timestamppb.New(start)
+	request.EndAt = timestamppb.New(end)
+	raw := strings.TrimSpace(ctx.Query("durationMinutes"))
+	if raw == "" {
+		return request, nil
+	}
+	duration, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || duration < 1 {
+		return nil, fmt.Errorf("durationMinutes must be a positive integer")
+	}
+	request.DurationMinutes = int32(duration)
+	return request, nil
+}
+
+func (s *Server) listCalendarEventNotificationsProto(ctx *gin.Context) {
+	response, err := s.ListCalendarEventNotifications(ctx.Request.Context(), &appointmentcontracts.ListCalendarEventNotificationsRequest{CalendarEventId: ctx.Param("id")})
 	if err != nil {
 		s.writeCalendarError(ctx, err)
 		return
 	}
-	if view == nil {
-		s.writeProtoError(ctx, http.StatusNotFound, "calendar event not found")
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) ListCalendarEventNotifications(ctx context.Context, request *appointmentcontracts.ListCalendarEventNotificationsRequest) (*appointmentcontracts.ListCalendarEventNotificationsResponse, error) {
+	view, err := s.calendarEventView(ctx, request.GetCalendarEventId())
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.ListCalendarEventNotificationsResponse{
+		Notifications: appointmentNotificationsProto(view.Notifications),
+	}, nil
+}
+
+func (s *Server) requestReminderResendProto(ctx *gin.Context) {
+	var request appointmentcontracts.RequestReminderResendRequest
+	if ctx.Request.Body != nil && ctx.Request.ContentLength != 0 {
+		if !s.readProtoJSON(ctx, &request) {
+			return
+		}
+	}
+	if request.GetCalendarEventId() == "" {
+		request.CalendarEventId = ctx.Param("calendar_event_id")
+	}
+	response, err := s.RequestReminderResend(ctx.Request.Context(), &request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
 		return
 	}
-	response := appointmentcontracts.RequestReminderResendResponse{Event: calendarEventProto(*view)}
-	s.writeProtoJSON(ctx, http.StatusOK, &response)
+	s.writeProtoJSON(ctx, http.StatusOK, response)
 }
 
-func (s *Server) createCalendarEvent(ctx context.Context, request *appointmentcontracts.CreateCalendarEventRequest) (domain.CalendarEvent, error) {
+func (s *Server) RequestReminderResend(ctx context.Context, request *appointmentcontracts.RequestReminderResendRequest) (*appointmentcontracts.RequestReminderResendResponse, error) {
+	eventID := request.GetCalendarEventId()
+	idempotencyKey := strings.TrimSpace(request.GetIdempotencyKey())
+	if idempotencyKey == "" {
+		idempotencyKey = uuid.NewString()
+	}
+	var position *int
+	if request.ReminderPosition != nil {
+		value := int(request.GetReminderPosition())
+		position = &value
+	}
+	if err := s.reminders.RequestReminderResend(ctx, eventID, position, idempotencyKey); err != nil {
+		return nil, err
+	}
+	view, err := s.calendarEventView(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.RequestReminderResendResponse{Event: calendarEventProto(*view)}, nil
+}
+
+func (s *Server) createCalendarEvent(ctx context.Context, request *appointmentcontracts.CreateCalendarEventRequest) (*domain.CalendarEvent, []applicationv2.CalendarEventConflict, error) {
+	command, err := s.createCalendarEventCommand(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.calendar.Create(ctx, command)
+}
+
+func (s *Server) createCalendarEventCommand(ctx context.Context, request *appointmentcontracts.CreateCalendarEventRequest) (applicationv2.CreateEventCommand, error) {
 	base, err := createBaseFromProto(request.GetCalendarId(), request.GetTimeRange(), request.GetTitle(), request.GetDescription(), request.GetVisibility())
 	if err != nil {
-		return domain.CalendarEvent{}, err
+		return nil, err
+	}
+	if base.End.IsZero() && request.GetAppointment() == nil {
+		return nil, fmt.Errorf("%w: timeRange.endAt is required", domain.ErrInvalidTimeRange)
+	}
+	base.Recurrence, err = recurrenceFromProto(request.GetRecurrence())
+	if err != nil {
+		return nil, err
 	}
 	switch detail := request.GetDetail().(type) {
 	case *appointmentcontracts.CreateCalendarEventRequest_Appointment:
-		remindBefore := reminderBeforeFromProto(detail.Appointment.RemindBeforeSeconds)
-		services, err := s.serviceItemsFromProto(ctx, detail.Appointment.GetServices())
+		if base.Recurrence != nil {
+			return nil, fmt.Errorf("%w: appointments cannot recur", domain.ErrInvalidRecurrence)
+		}
+		remindBefore, err := reminderBeforeFromProto(detail.Appointment.RemindBeforeSeconds)
 		if err != nil {
-			return domain.CalendarEvent{}, err
+			return nil, err
 		}
-		event, err := s.calendar.Create(ctx, v2CreateAppointmentCommand(base, detail.Appointment.GetCustomerId(), services, remindBefore))
+		services, err := s.serviceItemsFromProto(ctx, detail.Appointment.GetServices())
 		if err != nil {
-			return domain.CalendarEvent{}, err
+			return nil, err
 		}
-		return *event, nil
+		return v2CreateAppointmentCommand(base, detail.Appointment.GetCustomerId(), services, remindBefore), nil
 	case *appointmentcontracts.CreateCalendarEventRequest_ManualEvent:
 		location := optionalString(detail.ManualEvent.GetLocation())
-		event, err := s.calendar.Create(ctx, v2CreateManualEventCommand(base, detail.ManualEvent.GetTitle(), detail.ManualEvent.GetDescription(), location))
-		if err != nil {
-			return domain.CalendarEvent{}, err
-		}
-		return *event, nil
+		return v2CreateManualEventCommand(base, detail.ManualEvent.GetTitle(), detail.ManualEvent.GetDescription(), location), nil
 	case *appointmentcontracts.CreateCalendarEventRequest_TimeBlock:
-		event, err := s.calendar.Create(ctx, v2CreateTimeBlockCommand(base, detail.TimeBlock.GetReason()))
-		if err != nil {
-			return domain.CalendarEvent{}, err
-		}
-		return *event, nil
+		return v2CreateTimeBlockCommand(base, detail.TimeBlock.GetReason()), nil
 	default:
-		return domain.CalendarEvent{}, fmt.Errorf("event detail is required")
+		return nil, fmt.Errorf("event detail is required")
 	}
 }
 
@@ -315,6 +750,17 @@ func (s *Server) updateCalendarEventCommand(ctx context.Context, request *appoin
 		visibility := visibilityFromProto(request.GetVisibility())
 		changes.Visibility = &visibility
 	}
+	if hasUpdatePath(paths, "recurrence") {
+		recurrence, err := recurrenceFromProto(request.GetRecurrence())
+		if err != nil {
+			return nil, err
+		}
+		changes.Recurrence = &recurrence
+	}
+	occurrence, err := occurrenceSelectionFromProto(request.GetOccurrenceStartAt(), request.GetScope())
+	if err != nil {
+		return nil, err
+	}
 	detailType, err := updateDetailType(paths)
 	if err != nil {
 		return nil, err
@@ -324,21 +770,36 @@ func (s *Server) updateCalendarEventCommand(ctx context.Context, request *appoin
 		if detailType != "appointment" {
 			return nil, fmt.Errorf("updateMask must include only appointment detail fields")
 		}
-		services, err := s.serviceItemsFromProto(ctx, detail.Appointment.GetServices())
-		if err != nil {
-			return nil, err
-		}
-		return applicationv2.UpdateAppointmentCommand{
+		command := applicationv2.UpdateAppointmentCommand{
 			CalendarEventID: calendarEventID,
+			ExpectedVersion: request.ExpectedVersion,
+			Occurrence:      occurrence,
 			Changes:         changes,
-			Services:        services,
-		}, nil
+		}
+		if hasUpdatePath(paths, "appointment.services") {
+			command.Services, err = s.serviceItemsFromProto(ctx, detail.Appointment.GetServices())
+			if err != nil {
+				return nil, err
+			}
+		}
+		if hasUpdatePath(paths, "appointment.remind_before", "appointment.remindBefore") {
+			if detail.Appointment.RemindBeforeSeconds == nil {
+				return nil, fmt.Errorf("%w: remindBeforeSeconds is required by updateMask", domain.ErrInvalidReminder)
+			}
+			command.RemindBefore, err = reminderBeforeFromProto(detail.Appointment.RemindBeforeSeconds)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return command, nil
 	case *appointmentcontracts.UpdateCalendarEventRequest_ManualEvent:
 		if detailType != "manual_event" {
 			return nil, fmt.Errorf("updateMask must include only manualEvent detail fields")
 		}
 		command := applicationv2.UpdateManualEventCommand{
 			CalendarEventID: calendarEventID,
+			ExpectedVersion: request.ExpectedVersion,
+			Occurrence:      occurrence,
 			Changes:         changes,
 			Title:           maskedString(paths, "manual_event.title", "manualEvent.title", detail.ManualEvent.Title),
 			Description:     maskedString(paths, "manual_event.description", "manualEvent.description", detail.ManualEvent.Description),
@@ -354,6 +815,8 @@ func (s *Server) updateCalendarEventCommand(ctx context.Context, request *appoin
 		}
 		return applicationv2.UpdateTimeBlockCommand{
 			CalendarEventID: calendarEventID,
+			ExpectedVersion: request.ExpectedVersion,
+			Occurrence:      occurrence,
 			Changes:         changes,
 			Reason:          detail.TimeBlock.GetReason(),
 		}, nil
@@ -361,7 +824,7 @@ func (s *Server) updateCalendarEventCommand(ctx context.Context, request *appoin
 		if detailType != "" {
 			return nil, fmt.Errorf("%s detail is required by updateMask", detailType)
 		}
-		return applicationv2.UpdateCalendarFieldsCommand{CalendarEventID: calendarEventID, Changes: changes}, nil
+		return applicationv2.UpdateCalendarFieldsCommand{CalendarEventID: calendarEventID, ExpectedVersion: request.ExpectedVersion, Occurrence: occurrence, Changes: changes}, nil
 	}
 }
 
@@ -383,8 +846,7 @@ func (s *Server) serviceItemsFromProto(ctx context.Context, selections []*appoin
 			if service == nil {
 				return nil, fmt.Errorf("service %s not found", value.CatalogServiceId)
 			}
-			serviceID := service.ID
-			item, err := domain.NewServiceItem(&serviceID, service.Name, index)
+			item, err := domain.NewCatalogServiceItem(service.ID, service.Name, service.Duration, service.Buffer, index)
 			if err != nil {
 				return nil, err
 			}
@@ -421,22 +883,59 @@ func (s *Server) writeProtoJSON(ctx *gin.Context, status int, message proto.Mess
 }
 
 func (s *Server) writeCalendarError(ctx *gin.Context, err error) {
+	var conflictErr *applicationv2.CalendarEventConflictError
+	if errors.As(err, &conflictErr) {
+		s.writeConflictError(ctx, conflictErr)
+		return
+	}
+	s.writeProtoError(ctx, calendarErrorStatus(err), err.Error())
+}
+
+// calendarErrorStatus maps an error of the calendar services to the HTTP status of the response.
+func calendarErrorStatus(err error) int {
+	var invalidErr invalidRequestError
+	var conflictErr *applicationv2.CalendarEventConflictError
 	switch {
-	case errors.Is(err, applicationv2.ErrCalendarEventNotFound):
-		s.writeProtoError(ctx, http.StatusNotFound, err.Error())
+	case errors.As(err, &invalidErr):
+		return http.StatusBadRequest
+	case errors.As(err, &conflictErr):
+		return http.StatusConflict
+	case errors.Is(err, applicationv2.ErrCalendarEventNotFound),
+		errors.Is(err, applicationv2.ErrCalendarNotFound),
+		errors.Is(err, applicationv2.ErrCalendarFeedDisabled),
+		errors.Is(err, applicationv2.ErrWaitlistEntryNotFound),
+		errors.Is(err, applicationv2.ErrWebhookSubscriptionNotFound),
+		errors.Is(err, errServiceNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, applicationv2.ErrCalendarEventVersionConflict):
+		return http.StatusConflict
+	case errors.Is(err, applicationv2.ErrInvalidFeedToken):
+		return http.StatusForbidden
 	case errors.Is(err, applicationv2.ErrAppointmentNotRemindable),
-		errors.Is(err, applicationv2.ErrInvalidReminderRequest):
-		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		errors.Is(err, applicationv2.ErrInvalidReminderRequest),
+		errors.Is(err, applicationv2.ErrInvalidRecurrenceScope),
+		errors.Is(err, applicationv2.ErrInvalidPageRequest),
+		errors.Is(err, applicationv2.ErrInvalidBulkChange),
+		errors.Is(err, applicationv2.ErrInvalidAvailabilityQuery),
+		errors.Is(err, legacydomain.ErrInvalidServiceDuration):
+		return http.StatusBadRequest
 	case errors.Is(err, domain.ErrMissingRequiredData),
 		errors.Is(err, domain.ErrInvalidCalendarID),
+		errors.Is(err, domain.ErrInvalidCalendar),
+		errors.Is(err, domain.ErrCalendarArchived),
 		errors.Is(err, domain.ErrInvalidTimeRange),
 		errors.Is(err, domain.ErrInvalidEventType),
 		errors.Is(err, domain.ErrInvalidEventDetail),
 		errors.Is(err, domain.ErrInvalidVisibility),
-		errors.Is(err, domain.ErrInvalidReminder):
-		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		errors.Is(err, domain.ErrInvalidReminder),
+		errors.Is(err, domain.ErrInvalidRecurrence),
+		errors.Is(err, domain.ErrInvalidOccurrence),
+		errors.Is(err, domain.ErrInvalidAttendance),
+		errors.Is(err, domain.ErrInvalidWaitlistEntry),
+		errors.Is(err, domain.ErrInvalidWebhook):
+		return http.StatusBadRequest
 	default:
-		s.writeProtoError(ctx, http.StatusInternalServerError, err.Error())
+		return http.StatusInternalServerError
 	}
 }
 
@@ -444,59 +943,222 @@ func (s *Server) writeProtoError(ctx *gin.Context, status int, message string) {
 	ctx.JSON(status, gin.H{"message": message})
 }
 
-func calendarEventsListQueryFromProto(ctx *gin.Context) (applicationv2.ListCalendarEventsQuery, error) {
-	var query applicationv2.ListCalendarEventsQuery
-	calendarID, err := domain.NormalizeCalendarID(ctx.Query("calendarId"))
-	if err != nil {
-		return query, err
+func (s *Server) writeConflictError(ctx *gin.Context, err *applicationv2.CalendarEventConflictError) {
+	conflicts := make([]json.RawMessage, 0, len(err.Conflicts))
+	for _, conflict := range calendarEventConflictsProto(err.Conflicts) {
+		payload, marshalErr := protoJSONMarshal.Marshal(conflict)
+		if marshalErr != nil {
+			s.writeProtoError(ctx, http.StatusInternalServerError, marshalErr.Error())
+			return
+		}
+		conflicts = append(conflicts, payload)
+	}
+	ctx.JSON(http.StatusConflict, gin.H{"message": err.Error(), "conflicts": conflicts})
+}
+
+func listCalendarEventsRequestFromQuery(ctx *gin.Context) (*appointmentcontracts.ListCalendarEventsRequest, error) {
+	request := &appointmentcontracts.ListCalendarEventsRequest{
+		CalendarId:  ctx.Query("calendarId"),
+		CalendarIds: ctx.QueryArray("calendarIds"),
+		CustomerId:  ctx.Query("customerId"),
+		Query:       strings.TrimSpace(ctx.Query("query")),
+		PageToken:   strings.TrimSpace(ctx.Query("pageToken")),
 	}
-	query.CalendarID = calendarID
-	query.CustomerID = ctx.Query("customerId")
 	if raw := ctx.Query("startAt"); raw != "" {
 		start, err := time.Parse(time.RFC3339, raw)
 		if err != nil {
-			return query, fmt.Errorf("invalid startAt")
+			return nil, fmt.Errorf("invalid startAt")
 		}
-		query.Start = &start
+		request.StartAt = timestamppb.New(start)
 	}
 	if raw := ctx.Query("endAt"); raw != "" {
 		end, err := time.Parse(time.RFC3339, raw)
 		if err != nil {
-			return query, fmt.Errorf("invalid endAt")
+			return nil, fmt.Errorf("invalid endAt")
 		}
-		query.End = &end
+		request.EndAt = timestamppb.New(end)
 	}
 	for _, raw := range ctx.QueryArray("eventTypes") {
 		eventType, err := calendarEventTypeFromString(raw)
+		if err != nil {
+			return nil, err
+		}
+		request.EventTypes = append(request.EventTypes, calendarEventTypeProto(eventType))
+	}
+	if raw := strings.TrimSpace(ctx.Query("order")); raw != "" {
+		descending, err := calendarEventOrderFromString(raw)
+		if err != nil {
+			return nil, err
+		}
+		request.Order = appointmentcontracts.CalendarEventOrder_CALENDAR_EVENT_ORDER_START_ASC
+		if descending {
+			request.Order = appointmentcontracts.CalendarEventOrder_CALENDAR_EVENT_ORDER_START_DESC
+		}
+	}
+	if raw := strings.TrimSpace(ctx.Query("pageSize")); raw != "" {
+		pageSize, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || pageSize < 1 {
+			return nil, fmt.Errorf("pageSize must be a positive integer")
+		}
+		request.PageSize = int32(pageSize)
+	}
+	return request, nil
+}
+
+// calendarEventsListQuery lists the default calendar when the request names none.
+func calendarEventsListQuery(request *appointmentcontracts.ListCalendarEventsRequest) (applicationv2.ListCalendarEventsQuery, error) {
+	var query applicationv2.ListCalendarEventsQuery
+	calendarIDs := append([]string{}, request.GetCalendarIds()...)
+	if calendarID := request.GetCalendarId(); calendarID != "" || len(calendarIDs) == 0 {
+		calendarIDs = append(calendarIDs, calendarID)
+	}
+	for _, raw := range calendarIDs {
+		calendarID, err := domain.NormalizeCalendarID(raw)
+		if err != nil {
+			return query, err
+		}
+		query.CalendarIDs = append(query.CalendarIDs, calendarID)
+	}
+	query.CustomerID = request.GetCustomerId()
+	if request.GetStartAt() != nil {
+		start := request.GetStartAt().AsTime()
+		query.Start = &start
+	}
+	if request.GetEndAt() != nil {
+		end := request.GetEndAt().AsTime()
+		query.End = &end
+	}
+	for _, raw := range request.GetEventTypes() {
+		eventType, err := calendarEventTypeFromString(raw.String())
 		if err != nil {
 			return query, err
 		}
 		query.EventTypes = append(query.EventTypes, eventType)
 	}
+	query.Text = strings.TrimSpace(request.GetQuery())
+	descending, err := calendarEventOrderFromString(request.GetOrder().String())
+	if err != nil {
+		return query, err
+	}
+	query.Descending = descending
+	if request.GetPageSize() < 0 {
+		return query, fmt.Errorf("pageSize must be a positive integer")
+	}
+	query.PageSize = int(request.GetPageSize())
+	if token := request.GetPageToken(); token != "" {
+		cursor, err := calendarEventCursorFromPageToken(token)
+		if err != nil {
+			return query, err
+		}
+		query.After = cursor
+	}
 	return query, nil
 }
 
+type calendarEventPageCursor struct {
+	Start time.Time `json:"s"`
+	End   time.Time `json:"e"`
+	ID    string    `json:"i"`
+}
+
+// calendarEventPageToken encodes the cursor as an opaque token, or returns an empty token without a cursor.
+func calendarEventPageToken(cursor *applicationv2.CalendarEventCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	payload, err := json.Marshal(calendarEventPageCursor{Start: cursor.Start.UTC(), End: cursor.End.UTC(), ID: cursor.ID})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func calendarEventCursorFromPageToken(token string) (*applicationv2.CalendarEventCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pageToken")
+	}
+	var cursor calendarEventPageCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.ID == "" || cursor.Start.IsZero() {
+		return nil, fmt.Errorf("invalid pageToken")
+	}
+	if _, err := uuid.Parse(cursor.ID); err != nil {
+		return nil, fmt.Errorf("invalid pageToken")
+	}
+	return &applicationv2.CalendarEventCursor{Start: cursor.Start, End: cursor.End, ID: cursor.ID}, nil
+}
+
 func createBaseFromProto(calendarID string, timeRange *appointmentcontracts.TimeRange, title string, description string, visibility appointmentcontracts.CalendarEventVisibility) (calendarEventBase, error) {
 	calendarID, err := domain.NormalizeCalendarID(calendarID)
 	if err != nil {
 		return calendarEventBase{}, err
 	}
-	rangeUpdate, err := timeRangeUpdateFromProto(timeRange)
-	if err != nil {
-		return calendarEventBase{}, err
+	if timeRange.GetStartAt() == nil {
+		return calendarEventBase{}, fmt.Errorf("timeRange.startAt is required")
+	}
+	// A missing end stays zero: appointments compute it from their services.
+	var end time.Time
+	if timeRange.GetEndAt() != nil {
+		end = timeRange.GetEndAt().AsTime()
 	}
 	return calendarEventBase{
 		CalendarID:  calendarID,
-		Start:       rangeUpdate.Start,
-		End:         rangeUpdate.End,
-		Timezone:    rangeUpdate.Timezone,
-		AllDay:      rangeUpdate.AllDay,
+		Start:       timeRange.GetStartAt().AsTime(),
+		End:         end,
+		Timezone:    timeRange.GetTimezone(),
+		AllDay:      timeRange.GetAllDay(),
 		Title:       title,
 		Description: description,
 		Visibility:  visibilityFromProto(visibility),
 	}, nil
 }
 
+// recurrenceFromProto returns nil for a missing recurrence or an empty rule.
+func recurrenceFromProto(recurrence *appointmentcontracts.Recurrence) (*domain.Recurrence, error) {
+	if strings.TrimSpace(recurrence.GetRule()) == "" {
+		return nil, nil
+	}
+	exceptionDates := make([]time.Time, 0, len(recurrence.GetExceptionDates()))
+	for _, exceptionDate := range recurrence.GetExceptionDates() {
+		exceptionDates = append(exceptionDates, exceptionDate.AsTime())
+	}
+	out, err := domain.NewRecurrence(recurrence.GetRule(), exceptionDates)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// occurrenceSelectionFromProto returns nil when the change applies to the whole event.
+func occurrenceSelectionFromProto(start *timestamppb.Timestamp, scope appointmentcontracts.RecurrenceScope) (*applicationv2.OccurrenceSelection, error) {
+	var recurrenceScope applicationv2.RecurrenceScope
+	switch scope {
+	case appointmentcontracts.RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED, appointmentcontracts.RecurrenceScope_RECURRENCE_SCOPE_ALL:
+		return nil, nil
+	case appointmentcontracts.RecurrenceScope_RECURRENCE_SCOPE_THIS:
+		recurrenceScope = applicationv2.RecurrenceScopeThis
+	case appointmentcontracts.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING:
+		recurrenceScope = applicationv2.RecurrenceScopeThisAndFollowing
+	default:
+		return nil, fmt.Errorf("invalid scope %q", scope)
+	}
+	if start == nil {
+		return nil, fmt.Errorf("occurrenceStartAt is required by scope %s", scope)
+	}
+	return &applicationv2.OccurrenceSelection{Start: start.AsTime(), Scope: recurrenceScope}, nil
+}
+
+func recurrenceProto(recurrence *domain.Recurrence) *appointmentcontracts.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+	out := &appointmentcontracts.Recurrence{Rule: recurrence.Rule.String()}
+	for _, exceptionDate := range recurrence.ExceptionDates {
+		out.ExceptionDates = append(out.ExceptionDates, timestamppb.New(exceptionDate))
+	}
+	return out
+}
+
 func timeRangeUpdateFromProto(timeRange *appointmentcontracts.TimeRange) (*applicationv2.TimeRangeUpdate, error) {
 	if timeRange == nil || timeRange.GetStartAt() == nil || timeRange.GetEndAt() == nil {
 		return nil, fmt.Errorf("timeRange.startAt and timeRange.endAt are required")
@@ -512,21 +1174,17 @@ func timeRangeUpdateFromProto(timeRange *appointmentcontracts.TimeRange) (*appli
 func calendarEventProto(view applicationv2.CalendarEventView) *appointmentcontracts.CalendarEvent {
 	event := view.Event
 	out := &appointmentcontracts.CalendarEvent{
-		Id:         event.ID,
-		CalendarId: event.CalendarID,
-		EventType:  calendarEventTypeProto(event.Type),
-		TimeRange: &appointmentcontracts.TimeRange{
-			StartAt:  timestamppb.New(event.Range.Start),
-			EndAt:    timestamppb.New(event.Range.End),
-			Timezone: event.Range.Timezone,
-			AllDay:   event.Range.AllDay,
-		},
+		Id:          event.ID,
+		CalendarId:  event.CalendarID,
+		EventType:   calendarEventTypeProto(event.Type),
+		TimeRange:   timeRangeProto(event.Range),
 		Title:       event.Title,
 		Description: event.Description,
 		Visibility:  visibilityProto(event.Visibility),
 		Version:     event.Version,
 		CreatedAt:   timestamppb.New(event.CreatedAt),
 		UpdatedAt:   timestamppb.New(event.UpdatedAt),
+		Recurrence:  recurrenceProto(event.Recurrence),
 	}
 	if event.Cancellation != nil {
 		out.Cancellation = &appointmentcontracts.CalendarEventCancellation{
@@ -536,18 +1194,12 @@ func calendarEventProto(view applicationv2.CalendarEventView) *appointmentcontra
 	}
 	switch detail := event.Detail.(type) {
 	case domain.Appointment:
-		services := make([]*appointmentcontracts.AppointmentServiceItem, 0, len(detail.Services))
-		for _, item := range detail.Services {
-			services = append(services, &appointmentcontracts.AppointmentServiceItem{
-				ServiceId:   stringValue(item.ServiceID),
-				ServiceName: item.ServiceName,
-				Position:    int32(item.Position),
-			})
-		}
 		out.Detail = &appointmentcontracts.CalendarEvent_Appointment{Appointment: &appointmentcontracts.AppointmentDetail{
-			Customer: &appointmentcontracts.CustomerRef{CustomerId: detail.Customer.ID, DisplayName: detail.Customer.DisplayName},
-			Services: services,
-			Reminder: appointmentReminderProto(view.Reminder),
+			Customer:      &appointmentcontracts.CustomerRef{CustomerId: detail.Customer.ID, DisplayName: detail.Customer.DisplayName},
+			Services:      appointmentServiceItemsProto(detail.Services),
+			Reminders:     appointmentRemindersProto(view.Reminders),
+			Attendance:    appointmentAttendanceProto(detail.Attendance),
+			Notifications: appointmentNotificationSummaryProto(view.Notifications),
 		}}
 	case domain.ManualEvent:
 		out.Detail = &appointmentcontracts.CalendarEvent_ManualEvent{ManualEvent: &appointmentcontracts.ManualEventDetail{
@@ -561,20 +1213,92 @@ func calendarEventProto(view applicationv2.CalendarEventView) *appointmentcontra
 	return out
 }
 
+func appointmentServiceItemsProto(items []domain.ServiceItem) []*appointmentcontracts.AppointmentServiceItem {
+	services := make([]*appointmentcontracts.AppointmentServiceItem, 0, len(items))
+	for _, item := range items {
+		services = append(services, &appointmentcontracts.AppointmentServiceItem{
+			ServiceId:       stringValue(item.ServiceID),
+			ServiceName:     item.ServiceName,
+			Position:        int32(item.Position),
+			DurationMinutes: int32(item.Duration / time.Minute),
+			BufferMinutes:   int32(item.Buffer / time.Minute),
+		})
+	}
+	return services
+}
+
+func appointmentAttendanceProto(attendance *domain.AppointmentAttendance) *appointmentcontracts.AppointmentAttendance {
+	if attendance == nil {
+		return nil
+	}
+	status := appointmentcontracts.AppointmentAttendanceStatus_APPOINTMENT_ATTENDANCE_STATUS_COMPLETED
+	if attendance.Status == domain.AttendanceNoShow {
+		status = appointmentcontracts.AppointmentAttendanceStatus_APPOINTMENT_ATTENDANCE_STATUS_NO_SHOW
+	}
+	return &appointmentcontracts.AppointmentAttendance{
+		Status:     status,
+		RecordedAt: timestamppb.New(attendance.RecordedAt),
+	}
+}
+
+func timeRangeProto(eventRange domain.TimeRange) *appointmentcontracts.TimeRange {
+	return &appointmentcontracts.TimeRange{
+		StartAt:  timestamppb.New(eventRange.Start),
+		EndAt:    timestamppb.New(eventRange.End),
+		Timezone: eventRange.Timezone,
+		AllDay:   eventRange.AllDay,
+	}
+}
+
+func calendarEventConflictsProto(conflicts []applicationv2.CalendarEventConflict) []*appointmentcontracts.CalendarEventConflict {
+	if len(conflicts) == 0 {
+		return nil
+	}
+	out := make([]*appointmentcontracts.CalendarEventConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		out = append(out, &appointmentcontracts.CalendarEventConflict{
+			CalendarEventId: conflict.CalendarEventID,
+			EventType:       calendarEventTypeProto(conflict.Type),
+			TimeRange:       timeRangeProto(conflict.Range),
+		})
+	}
+	return out
+}
+
 func catalogServiceProto(service legacydomain.AppointmentService) *appointmentcontracts.CatalogService {
 	return &appointmentcontracts.CatalogService{
-		Id:    service.ID,
-		Name:  service.Name,
-		Tags:  service.Tags,
-		Color: stringValue(service.Color),
+		Id:              service.ID,
+		Name:            service.Name,
+		Tags:            service.Tags,
+		Color:           stringValue(service.Color),
+		DurationMinutes: int32(service.Duration / time.Minute),
+		BufferMinutes:   int32(service.Buffer / time.Minute),
 	}
 }
 
-func appointmentReminderProto(reminder *domain.AppointmentReminder) *appointmentcontracts.AppointmentReminder {
-	if reminder == nil {
+func minutes(value int32) time.Duration {
+	return time.Duration(value) * time.Minute
+}
+
+func optionalMinutes(value *int32) *time.Duration {
+	if value == nil {
 		return nil
 	}
+	duration := minutes(*value)
+	return &duration
+}
+
+func appointmentRemindersProto(reminders []domain.AppointmentReminder) []*appointmentcontracts.AppointmentReminder {
+	out := make([]*appointmentcontracts.AppointmentReminder, 0, len(reminders))
+	for _, reminder := range reminders {
+		out = append(out, appointmentReminderProto(reminder))
+	}
+	return out
+}
+
+func appointmentReminderProto(reminder domain.AppointmentReminder) *appointmentcontracts.AppointmentReminder {
 	out := &appointmentcontracts.AppointmentReminder{
+		Position:            int32(reminder.Position),
 		Status:              appointmentReminderStatusProto(reminder.Status),
 		RemindBeforeSeconds: int32(reminder.RemindBefore.Seconds()),
 		FailureReason:       stringValue(reminder.FailureReason),
@@ -615,6 +1339,86 @@ func appointmentReminderStatusProto(status domain.ReminderStatus) appointmentcon
 	}
 }
 
+func appointmentNotificationsProto(notifications []domain.AppointmentNotification) []*appointmentcontracts.AppointmentNotification {
+	out := make([]*appointmentcontracts.AppointmentNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		out = append(out, appointmentNotificationProto(notification))
+	}
+	return out
+}
+
+// appointmentNotificationSummaryProto counts the notifications by status; notifications are
+// ordered oldest first, so the latest is the last one.
+func appointmentNotificationSummaryProto(notifications []domain.AppointmentNotification) *appointmentcontracts.AppointmentNotificationSummary {
+	out := &appointmentcontracts.AppointmentNotificationSummary{}
+	for _, notification := range notifications {
+		switch notification.Status {
+		case domain.NotificationStatusPending:
+			out.Pending++
+		case domain.NotificationStatusSent:
+			out.Sent++
+		case domain.NotificationStatusFailed:
+			out.Failed++
+		case domain.NotificationStatusExpired:
+			out.Expired++
+		}
+	}
+	if len(notifications) > 0 {
+		out.Latest = appointmentNotificationProto(notifications[len(notifications)-1])
+	}
+	return out
+}
+
+func appointmentNotificationProto(notification domain.AppointmentNotification) *appointmentcontracts.AppointmentNotification {
+	out := &appointmentcontracts.AppointmentNotification{
+		CorrelationKey: notification.CorrelationKey,
+		Kind:           appointmentNotificationKindProto(notification.Kind),
+		Status:         appointmentNotificationStatusProto(notification.Status),
+		CreatedAt:      timestamppb.New(notification.CreatedAt),
+		ExpiresAt:      timestamppb.New(notification.ExpiresAt),
+		FailureReason:  stringValue(notification.FailureReason),
+		FailureMessage: stringValue(notification.FailureMessage),
+	}
+	if notification.ReminderPosition != nil {
+		position := int32(*notification.ReminderPosition)
+		out.ReminderPosition = &position
+	}
+	if notification.CompletedAt != nil {
+		out.CompletedAt = timestamppb.New(*notification.CompletedAt)
+	}
+	return out
+}
+
+func appointmentNotificationKindProto(kind domain.NotificationKind) appointmentcontracts.AppointmentNotificationKind {
+	switch kind {
+	case domain.NotificationKindConfirmation:
+		return appointmentcontracts.AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_CONFIRMATION
+	case domain.NotificationKindRescheduled:
+		return appointmentcontracts.AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_RESCHEDULED
+	case domain.NotificationKindReminder:
+		return appointmentcontracts.AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_REMINDER
+	case domain.NotificationKindCanceled:
+		return appointmentcontracts.AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_CANCELED
+	default:
+		return appointmentcontracts.AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_UNSPECIFIED
+	}
+}
+
+func appointmentNotificationStatusProto(status domain.NotificationStatus) appointmentcontracts.AppointmentNotificationStatus {
+	switch status {
+	case domain.NotificationStatusPending:
+		return appointmentcontracts.AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_PENDING
+	case domain.NotificationStatusSent:
+		return appointmentcontracts.AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_SENT
+	case domain.NotificationStatusFailed:
+		return appointmentcontracts.AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_FAILED
+	case domain.NotificationStatusExpired:
+		return appointmentcontracts.AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_EXPIRED
+	default:
+		return appointmentcontracts.AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_UNSPECIFIED
+	}
+}
+
 func updatePaths(mask *fieldmaskpb.FieldMask) map[string]struct{} {
 	out := map[string]struct{}{}
 	if mask == nil || len(mask.Paths) == 0 {
@@ -640,20 +1444,23 @@ func validateUpdateMask(paths map[string]struct{}) error {
 		return fmt.Errorf("updateMask is required")
 	}
 	allowed := map[string]struct{}{
-		"time_range":               {},
-		"timeRange":                {},
-		"title":                    {},
-		"description":              {},
-		"visibility":               {},
-		"appointment.services":     {},
-		"manual_event.title":       {},
-		"manualEvent.title":        {},
-		"manual_event.description": {},
-		"manualEvent.description":  {},
-		"manual_event.location":    {},
-		"manualEvent.location":     {},
-		"time_block.reason":        {},
-		"timeBlock.reason":         {},
+		"time_range":                {},
+		"timeRange":                 {},
+		"title":                     {},
+		"description":               {},
+		"visibility":                {},
+		"recurrence":                {},
+		"appointment.services":      {},
+		"appointment.remind_before": {},
+		"appointment.remindBefore":  {},
+		"manual_event.title":        {},
+		"manualEvent.title":         {},
+		"manual_event.description":  {},
+		"manualEvent.description":   {},
+		"manual_event.location":     {},
+		"manualEvent.location":      {},
+		"time_block.reason":         {},
+		"timeBlock.reason":          {},
 	}
 	for path := range paths {
 		if _, ok := allowed[path]; !ok {
@@ -755,6 +1562,18 @@ func calendarEventTypeFromString(value string) (domain.CalendarEventType, error)
 	}
 }
 
+// calendarEventOrderFromString reports whether the order sorts the events from the latest start.
+func calendarEventOrderFromString(value string) (bool, error) {
+	switch value {
+	case "start_asc", "CALENDAR_EVENT_ORDER_START_ASC", "CALENDAR_EVENT_ORDER_UNSPECIFIED":
+		return false, nil
+	case "start_desc", "CALENDAR_EVENT_ORDER_START_DESC":
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid order value %q", value)
+	}
+}
+
 func cancelReasonFromProto(value appointmentcontracts.CancelReason) domain.CancelReason {
 	switch value {
 	case appointmentcontracts.CancelReason_CANCEL_REASON_CUSTOMER_CANCEL:
@@ -786,13 +1605,24 @@ type calendarEventBase struct {
 	Title       string
 	Description string
 	Visibility  domain.Visibility
+	Recurrence  *domain.Recurrence
 }
 
-func reminderBeforeFromProto(_ *int32) time.Duration {
-	return time.Duration(defaultReminderBeforeSeconds) * time.Second
+// reminderBeforeFromProto maps an unset value to nil, so the configured reminders apply, and 0 to no reminders.
+func reminderBeforeFromProto(seconds *int32) ([]time.Duration, error) {
+	switch {
+	case seconds == nil:
+		return nil, nil
+	case *seconds < 0:
+		return nil, fmt.Errorf("%w: remindBeforeSeconds must not be negative", domain.ErrInvalidReminder)
+	case *seconds == 0:
+		return []time.Duration{}, nil
+	default:
+		return []time.Duration{time.Duration(*seconds) * time.Second}, nil
+	}
 }
 
-func v2CreateAppointmentCommand(base calendarEventBase, customerID string, services []domain.ServiceItem, remindBefore time.Duration) applicationv2.CreateAppointmentCommand {
+func v2CreateAppointmentCommand(base calendarEventBase, customerID string, services []domain.ServiceItem, remindBefore []time.Duration) applicationv2.CreateAppointmentCommand {
 	return applicationv2.CreateAppointmentCommand{
 		CalendarID:   base.CalendarID,
 		Start:        base.Start,
@@ -821,6 +1651,7 @@ func v2CreateManualEventCommand(base calendarEventBase, title string, descriptio
 		ManualTitle:   title,
 		ManualDetails: description,
 		Location:      location,
+		Recurrence:    base.Recurrence,
 	}
 }
 
@@ -835,5 +1666,6 @@ func v2CreateTimeBlockCommand(base calendarEventBase, reason string) application
 		Description: base.Description,
 		Visibility:  base.Visibility,
 		Reason:      reason,
+		Recurrence:  base.Recurrence,
 	}
 }
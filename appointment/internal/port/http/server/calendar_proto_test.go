@@ -2,6 +2,9 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -16,19 +19,50 @@ import (
 	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func TestReminderBeforeFromProtoAlwaysUsesBackendDefault(t *testing.T) {
-	frontendValue := int32(0)
-	if value := reminderBeforeFromProto(&frontendValue); value != 24*time.Hour {
-		t.Fatalf("reminder before = %s, want 24h", value)
+func TestReminderBeforeFromProtoHonorsTheRequestedLeadTime(t *testing.T) {
+	if value, err := reminderBeforeFromProto(nil); err != nil || value != nil {
+		t.Fatalf("unset reminder before = %v, %v; want nil so the reminder policy applies", value, err)
+	}
+	disabled := int32(0)
+	if value, err := reminderBeforeFromProto(&disabled); err != nil || value == nil || len(value) != 0 {
+		t.Fatalf("zero reminder before = %v, %v; want no reminders", value, err)
+	}
+	patchTest := int32(48 * 60 * 60)
+	if value, err := reminderBeforeFromProto(&patchTest); err != nil || len(value) != 1 || value[0] != 48*time.Hour {
+		t.Fatalf("reminder before = %v, %v; want 48h", value, err)
+	}
+	negative := int32(-1)
+	if _, err := reminderBeforeFromProto(&negative); !errors.Is(err, domain.ErrInvalidReminder) {
+		t.Fatalf("negative reminder before error = %v, want %v", err, domain.ErrInvalidReminder)
+	}
+}
+
+func TestUpdateCalendarEventCommandMapsRemindBeforeMask(t *testing.T) {
+	seconds := int32(2 * 60 * 60)
+	command, err := (&Server{}).updateCalendarEventCommand(context.Background(), &appointmentcontracts.UpdateCalendarEventRequest{
+		Id:         "event-1",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"appointment.remind_before"}},
+		Detail: &appointmentcontracts.UpdateCalendarEventRequest_Appointment{Appointment: &appointmentcontracts.UpdateAppointmentDetail{
+			RemindBeforeSeconds: &seconds,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("updateCalendarEventCommand() error = %v", err)
+	}
+	update, ok := command.(applicationv2.UpdateAppointmentCommand)
+	if !ok || update.Services != nil || len(update.RemindBefore) != 1 || update.RemindBefore[0] != 2*time.Hour {
+		t.Fatalf("command = %#v, want a 2h reminder and the services kept", command)
 	}
 }
 
 func TestAppointmentReminderProtoMapsLifecycleState(t *testing.T) {
 	scheduledAt := time.Date(2026, 8, 8, 9, 0, 0, 0, time.UTC)
 	sentRequestedAt := scheduledAt.Add(time.Hour)
-	reminder := &domain.AppointmentReminder{
+	reminder := domain.AppointmentReminder{
+		Position:        1,
 		Status:          domain.ReminderStatusSendRequested,
 		RemindBefore:    24 * time.Hour,
 		ScheduledAt:     &scheduledAt,
@@ -39,11 +73,35 @@ func TestAppointmentReminderProtoMapsLifecycleState(t *testing.T) {
 	if mapped.GetStatus() != appointmentcontracts.AppointmentReminderStatus_APPOINTMENT_REMINDER_STATUS_SEND_REQUESTED {
 		t.Fatalf("status = %s", mapped.GetStatus())
 	}
-	if mapped.GetRemindBeforeSeconds() != 86400 || !mapped.GetScheduledAt().AsTime().Equal(scheduledAt) || !mapped.GetSentRequestedAt().AsTime().Equal(sentRequestedAt) {
+	if mapped.GetPosition() != 1 || mapped.GetRemindBeforeSeconds() != 86400 || !mapped.GetScheduledAt().AsTime().Equal(scheduledAt) || !mapped.GetSentRequestedAt().AsTime().Equal(sentRequestedAt) {
 		t.Fatalf("mapped reminder = %#v", mapped)
 	}
 }
 
+func TestAppointmentNotificationSummaryProtoCountsOutcomes(t *testing.T) {
+	createdAt := time.Date(2026, 8, 8, 9, 0, 0, 0, time.UTC)
+	failedAt := createdAt.Add(time.Hour)
+	reason, message := "missing_phone", "customer has no phone number"
+	position := 1
+	notifications := []domain.AppointmentNotification{
+		{CorrelationKey: "confirmation", Kind: domain.NotificationKindConfirmation, Status: domain.NotificationStatusSent, CreatedAt: createdAt, ExpiresAt: createdAt.Add(24 * time.Hour)},
+		{CorrelationKey: "reminder", Kind: domain.NotificationKindReminder, Status: domain.NotificationStatusFailed, ReminderPosition: &position, FailureReason: &reason, FailureMessage: &message, CreatedAt: createdAt, CompletedAt: &failedAt, ExpiresAt: createdAt.Add(24 * time.Hour)},
+	}
+
+	summary := appointmentNotificationSummaryProto(notifications)
+	if summary.GetSent() != 1 || summary.GetFailed() != 1 || summary.GetPending() != 0 || summary.GetExpired() != 0 {
+		t.Fatalf("summary = %#v", summary)
+	}
+	latest := summary.GetLatest()
+	if latest.GetKind() != appointmentcontracts.AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_REMINDER || latest.GetReminderPosition() != 1 ||
+		latest.GetFailureMessage() != message || !latest.GetCompletedAt().AsTime().Equal(failedAt) {
+		t.Fatalf("latest = %#v", latest)
+	}
+	if appointmentNotificationSummaryProto(nil).GetLatest() != nil {
+		t.Fatal("a summary without notifications must not have a latest one")
+	}
+}
+
 func TestUpdateCalendarEventCommandBuildsCommonFieldsCommand(t *testing.T) {
 	command, err := (&Server{}).updateCalendarEventCommand(context.Background(), &appointmentcontracts.UpdateCalendarEventRequest{
 		Id:         "event-1",
@@ -61,6 +119,105 @@ func TestUpdateCalendarEventCommandBuildsCommonFieldsCommand(t *testing.T) {
 	}
 }
 
+func TestUpdateCalendarEventCommandCarriesExpectedVersion(t *testing.T) {
+	expectedVersion := int64(4)
+	command, err := (&Server{}).updateCalendarEventCommand(context.Background(), &appointmentcontracts.UpdateCalendarEventRequest{
+		Id:              "event-1",
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"time_block.reason"}},
+		ExpectedVersion: &expectedVersion,
+		Detail: &appointmentcontracts.UpdateCalendarEventRequest_TimeBlock{
+			TimeBlock: &appointmentcontracts.UpdateTimeBlockDetail{Reason: "closed"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("updateCalendarEventCommand() error = %v", err)
+	}
+	update, ok := command.(applicationv2.UpdateTimeBlockCommand)
+	if !ok {
+		t.Fatalf("command type = %T, want UpdateTimeBlockCommand", command)
+	}
+	if update.ExpectedVersion == nil || *update.ExpectedVersion != 4 {
+		t.Fatalf("expected version = %#v, want 4", update.ExpectedVersion)
+	}
+}
+
+func TestUpdateCalendarEventCommandSelectsRecurringOccurrence(t *testing.T) {
+	occurrenceStart := time.Date(2026, 8, 10, 9, 0, 0, 0, time.UTC)
+	command, err := (&Server{}).updateCalendarEventCommand(context.Background(), &appointmentcontracts.UpdateCalendarEventRequest{
+		Id:                "event-1",
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"recurrence"}},
+		Recurrence:        &appointmentcontracts.Recurrence{Rule: "FREQ=WEEKLY;COUNT=4"},
+		OccurrenceStartAt: timestamppb.New(occurrenceStart),
+		Scope:             appointmentcontracts.RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING,
+	})
+	if err != nil {
+		t.Fatalf("updateCalendarEventCommand() error = %v", err)
+	}
+	update, ok := command.(applicationv2.UpdateCalendarFieldsCommand)
+	if !ok {
+		t.Fatalf("command type = %T, want UpdateCalendarFieldsCommand", command)
+	}
+	if update.Occurrence == nil || update.Occurrence.Scope != applicationv2.RecurrenceScopeThisAndFollowing || !update.Occurrence.Start.Equal(occurrenceStart) {
+		t.Fatalf("occurrence = %#v", update.Occurrence)
+	}
+	if update.Changes.Recurrence == nil || *update.Changes.Recurrence == nil || (*update.Changes.Recurrence).Rule.Count != 4 {
+		t.Fatalf("recurrence change = %#v", update.Changes.Recurrence)
+	}
+
+	_, err = (&Server{}).updateCalendarEventCommand(context.Background(), &appointmentcontracts.UpdateCalendarEventRequest{
+		Id:         "event-1",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		Scope:      appointmentcontracts.RecurrenceScope_RECURRENCE_SCOPE_THIS,
+	})
+	if err == nil {
+		t.Fatal("updateCalendarEventCommand() error = nil, want missing occurrenceStartAt")
+	}
+}
+
+func TestWriteCalendarErrorMapsVersionConflict(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+
+	(&Server{}).writeCalendarError(context, fmt.Errorf("save: %w", applicationv2.ErrCalendarEventVersionConflict))
+
+	if recorder.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusConflict)
+	}
+}
+
+func TestWriteCalendarErrorListsConflictingEvents(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	start := time.Date(2026, 8, 1, 9, 0, 0, 0, time.UTC)
+
+	(&Server{}).writeCalendarError(context, &applicationv2.CalendarEventConflictError{Conflicts: []applicationv2.CalendarEventConflict{{
+		CalendarEventID: "block-1",
+		Type:            domain.CalendarEventTypeTimeBlock,
+		Range:           domain.TimeRange{Start: start, End: start.Add(time.Hour), Timezone: "Europe/Rome"},
+	}}})
+
+	if recorder.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusConflict)
+	}
+	var body struct {
+		Message   string            `json:"message"`
+		Conflicts []json.RawMessage `json:"conflicts"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("response is not json: %v", err)
+	}
+	if len(body.Conflicts) != 1 {
+		t.Fatalf("response = %s", recorder.Body.String())
+	}
+	var conflict appointmentcontracts.CalendarEventConflict
+	if err := protojson.Unmarshal(body.Conflicts[0], &conflict); err != nil {
+		t.Fatalf("conflict is not proto json: %v", err)
+	}
+	if conflict.GetCalendarEventId() != "block-1" || conflict.GetEventType() != appointmentcontracts.CalendarEventType_CALENDAR_EVENT_TYPE_TIME_BLOCK {
+		t.Fatalf("conflict = %#v", &conflict)
+	}
+}
+
 func TestUpdateCalendarEventCommandRejectsMixedDetailMasks(t *testing.T) {
 	_, err := (&Server{}).updateCalendarEventCommand(context.Background(), &appointmentcontracts.UpdateCalendarEventRequest{
 		Id: "event-1",
@@ -94,6 +251,82 @@ func TestUpdateCalendarEventCommandClearsManualLocation(t *testing.T) {
 	}
 }
 
+func TestCalendarEventsListQueryReadsPagination(t *testing.T) {
+	cursor := &applicationv2.CalendarEventCursor{
+		Start: time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 8, 3, 10, 0, 0, 0, time.UTC),
+		ID:    "0b9c4c52-4a2e-4c38-9d7b-3f0a1d2e5c61",
+	}
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	context.Request = httptest.NewRequest("GET", "/v1/calendar-events?customerId=customer-1&query=laser&order=CALENDAR_EVENT_ORDER_START_DESC&pageSize=20&pageToken="+calendarEventPageToken(cursor), nil)
+
+	request, err := listCalendarEventsRequestFromQuery(context)
+	if err != nil {
+		t.Fatalf("listCalendarEventsRequestFromQuery() error = %v", err)
+	}
+	query, err := calendarEventsListQuery(request)
+	if err != nil {
+		t.Fatalf("calendarEventsListQuery() error = %v", err)
+	}
+	if query.Text != "laser" || !query.Descending || query.PageSize != 20 {
+		t.Fatalf("query = %#v, want text, descending order and page size", query)
+	}
+	if query.After == nil || query.After.ID != cursor.ID || !query.After.Start.Equal(cursor.Start) || !query.After.End.Equal(cursor.End) {
+		t.Fatalf("after = %#v, want %#v", query.After, cursor)
+	}
+}
+
+func TestCalendarEventsListQueryRejectsInvalidPageToken(t *testing.T) {
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	context.Request = httptest.NewRequest("GET", "/v1/calendar-events?pageToken=not-a-token", nil)
+
+	request, err := listCalendarEventsRequestFromQuery(context)
+	if err != nil {
+		t.Fatalf("listCalendarEventsRequestFromQuery() error = %v", err)
+	}
+	if _, err := calendarEventsListQuery(request); err == nil {
+		t.Fatal("calendarEventsListQuery() error = nil, want invalid pageToken error")
+	}
+}
+
+func TestBulkChangeEventsCommandFromProtoMapsTheAction(t *testing.T) {
+	start := time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC)
+	request := &appointmentcontracts.BulkChangeCalendarEventsRequest{
+		CalendarId: "main",
+		StartAt:    timestamppb.New(start),
+		EndAt:      timestamppb.New(start.Add(24 * time.Hour)),
+		EventTypes: []appointmentcontracts.CalendarEventType{appointmentcontracts.CalendarEventType_CALENDAR_EVENT_TYPE_APPOINTMENT},
+		Action: &appointmentcontracts.BulkChangeCalendarEventsRequest_Shift{
+			Shift: &appointmentcontracts.BulkShiftAction{ByMinutes: -30},
+		},
+	}
+
+	command, err := bulkChangeEventsCommandFromProto(request)
+	if err != nil {
+		t.Fatalf("bulkChangeEventsCommandFromProto() error = %v", err)
+	}
+	if command.CalendarID != "main" || !command.Start.Equal(start) || len(command.EventTypes) != 1 || command.EventTypes[0] != domain.CalendarEventTypeAppointment {
+		t.Fatalf("command = %#v", command)
+	}
+	if shift, ok := command.Action.(applicationv2.BulkShiftEvents); !ok || shift.By != -30*time.Minute {
+		t.Fatalf("action = %#v, want a 30 minutes shift backwards", command.Action)
+	}
+
+	request.Action = &appointmentcontracts.BulkChangeCalendarEventsRequest_Cancel{Cancel: &appointmentcontracts.BulkCancelAction{}}
+	command, err = bulkChangeEventsCommandFromProto(request)
+	if err != nil {
+		t.Fatalf("bulkChangeEventsCommandFromProto() error = %v", err)
+	}
+	if cancel, ok := command.Action.(applicationv2.BulkCancelEvents); !ok || cancel.Reason != domain.CancelReasonDeleted {
+		t.Fatalf("action = %#v, want a cancellation by the salon", command.Action)
+	}
+
+	request.Action = nil
+	if _, err := bulkChangeEventsCommandFromProto(request); err == nil {
+		t.Fatal("bulkChangeEventsCommandFromProto() error = nil, want a missing action error")
+	}
+}
+
 func TestListServicesRequestFromQuery(t *testing.T) {
 	recorder := httptest.NewRecorder()
 	context, _ := gin.CreateTestContext(recorder)
@@ -117,6 +350,34 @@ func TestListServicesRequestFromQueryRejectsInvalidLimit(t *testing.T) {
 	}
 }
 
+func TestFindAvailableSlotsRequestFromQuery(t *testing.T) {
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	context.Request = httptest.NewRequest("GET", "/v1/available-slots?calendarId=main&startAt=2026-08-03T07:00:00Z&endAt=2026-08-04T07:00:00Z&serviceIds=svc-1&serviceIds=svc-2&durationMinutes=45", nil)
+
+	request, err := findAvailableSlotsRequestFromQuery(context)
+	if err != nil {
+		t.Fatalf("findAvailableSlotsRequestFromQuery() error = %v", err)
+	}
+	if request.GetCalendarId() != "main" || len(request.GetServiceIds()) != 2 || request.GetDurationMinutes() != 45 {
+		t.Fatalf("request = %#v", request)
+	}
+	if !request.GetStartAt().AsTime().Equal(time.Date(2026, 8, 3, 7, 0, 0, 0, time.UTC)) {
+		t.Fatalf("startAt = %s", request.GetStartAt().AsTime())
+	}
+}
+
+func TestFindAvailableSlotsProtoReportsUnknownServiceAsNotFound(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest("GET", "/v1/available-slots?startAt=2026-08-03T07:00:00Z&endAt=2026-08-04T07:00:00Z&serviceIds=missing&durationMinutes=45", nil)
+
+	(&Server{services: application.NewServiceService(&serviceRepositoryStub{})}).findAvailableSlotsProto(context)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusNotFound, recorder.Body.String())
+	}
+}
+
 func TestServiceServiceLimitsAnUnfilteredCatalog(t *testing.T) {
 	repository := &serviceRepositoryStub{}
 	service := application.NewServiceService(repository)
@@ -188,6 +449,71 @@ func TestCreateServiceProtoCreatesAServiceWithoutPrice(t *testing.T) {
 	}
 }
 
+func TestCreateServiceProtoStoresDurationAndBuffer(t *testing.T) {
+	repository := &serviceRepositoryStub{}
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodPost, "/v1/services", strings.NewReader(`{"name":"Facial treatment","durationMinutes":45,"bufferMinutes":10}`))
+	context.Request.Header.Set("Content-Type", "application/json")
+
+	(&Server{services: application.NewServiceService(repository)}).createServiceProto(context)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusCreated, recorder.Body.String())
+	}
+	if repository.saved.Duration != 45*time.Minute || repository.saved.Buffer != 10*time.Minute {
+		t.Fatalf("saved service = %#v", repository.saved)
+	}
+	if !strings.Contains(recorder.Body.String(), `"durationMinutes":45`) {
+		t.Fatalf("response = %s", recorder.Body.String())
+	}
+}
+
+func TestUpdateServiceProtoRejectsNegativeDuration(t *testing.T) {
+	repository := &serviceRepositoryStub{found: &legacydomain.AppointmentService{ID: "service-1", Name: "Facial treatment"}}
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Params = gin.Params{{Key: "id", Value: "service-1"}}
+	context.Request = httptest.NewRequest(http.MethodPatch, "/v1/services/service-1", strings.NewReader(`{"bufferMinutes":-5}`))
+	context.Request.Header.Set("Content-Type", "application/json")
+
+	(&Server{services: application.NewServiceService(repository)}).updateServiceProto(context)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusBadRequest, recorder.Body.String())
+	}
+}
+
+func TestAvailableSlotsQueryUsesTheServiceDurations(t *testing.T) {
+	repository := &serviceRepositoryStub{found: &legacydomain.AppointmentService{ID: "service-1", Name: "Facial treatment", Duration: 45 * time.Minute, Buffer: 15 * time.Minute}}
+	server := &Server{services: application.NewServiceService(repository)}
+
+	query, err := server.availableSlotsQuery(context.Background(), &appointmentcontracts.FindAvailableSlotsRequest{
+		StartAt:    timestamppb.New(time.Date(2026, 8, 3, 7, 0, 0, 0, time.UTC)),
+		EndAt:      timestamppb.New(time.Date(2026, 8, 4, 7, 0, 0, 0, time.UTC)),
+		ServiceIds: []string{"service-1"},
+	})
+	if err != nil {
+		t.Fatalf("availableSlotsQuery() error = %v", err)
+	}
+	if query.Duration != time.Hour {
+		t.Fatalf("duration = %s, want the service duration plus buffer", query.Duration)
+	}
+}
+
+func TestAvailableSlotsQueryDoesNotReportLookupFailuresAsInvalid(t *testing.T) {
+	server := &Server{services: application.NewServiceService(&serviceRepositoryStub{err: errors.New("connection refused")})}
+
+	_, err := server.availableSlotsQuery(context.Background(), &appointmentcontracts.FindAvailableSlotsRequest{
+		StartAt:    timestamppb.New(time.Date(2026, 8, 3, 7, 0, 0, 0, time.UTC)),
+		EndAt:      timestamppb.New(time.Date(2026, 8, 4, 7, 0, 0, 0, time.UTC)),
+		ServiceIds: []string{"service-1"},
+	})
+	if err == nil || calendarErrorStatus(err) != http.StatusInternalServerError {
+		t.Fatalf("availableSlotsQuery() error = %v, want an internal error", err)
+	}
+}
+
 func TestUpdateServiceProtoUpdatesCatalogMetadata(t *testing.T) {
 	initial := legacydomain.AppointmentService{ID: "service-1", Name: "Facial treatment", Tags: []string{"facial"}}
 	repository := &serviceRepositoryStub{found: &initial}
@@ -232,6 +558,7 @@ type serviceRepositoryStub struct {
 	saved         legacydomain.AppointmentService
 	query         string
 	limit         int
+	err           error
 }
 
 func (s *serviceRepositoryStub) SaveService(_ context.Context, service legacydomain.AppointmentService) (legacydomain.AppointmentService, error) {
@@ -250,5 +577,5 @@ func (s *serviceRepositoryStub) SearchServices(_ context.Context, query string,
 }
 
 func (s *serviceRepositoryStub) FindService(_ context.Context, _ string) (*legacydomain.AppointmentService, error) {
-	return s.found, nil
+	return s.found, s.err
 }
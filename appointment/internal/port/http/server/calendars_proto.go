@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) createCalendarProto(ctx *gin.Context) {
+	var request appointmentcontracts.CreateCalendarRequest
+	if !s.readProtoJSON(ctx, &request) {
+		return
+	}
+	response, err := s.CreateCalendar(ctx.Request.Context(), &request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusCreated, response)
+}
+
+func (s *Server) CreateCalendar(ctx context.Context, request *appointmentcontracts.CreateCalendarRequest) (*appointmentcontracts.CreateCalendarResponse, error) {
+	calendar, err := s.calendars.CreateCalendar(ctx, applicationv2.CreateCalendarCommand{
+		Name:  request.GetName(),
+		Color: request.Color,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.CreateCalendarResponse{Calendar: calendarProto(*calendar)}, nil
+}
+
+func (s *Server) listCalendarsProto(ctx *gin.Context) {
+	request, err := listCalendarsRequestFromQuery(ctx)
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := s.ListCalendars(ctx.Request.Context(), request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) ListCalendars(ctx context.Context, request *appointmentcontracts.ListCalendarsRequest) (*appointmentcontracts.ListCalendarsResponse, error) {
+	calendars, err := s.calendars.ListCalendars(ctx, request.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+	response := &appointmentcontracts.ListCalendarsResponse{Calendars: make([]*appointmentcontracts.Calendar, 0, len(calendars))}
+	for _, calendar := range calendars {
+		response.Calendars = append(response.Calendars, calendarProto(calendar))
+	}
+	return response, nil
+}
+
+func (s *Server) updateCalendarProto(ctx *gin.Context) {
+	var request appointmentcontracts.UpdateCalendarRequest
+	if !s.readProtoJSON(ctx, &request) {
+		return
+	}
+	request.Id = ctx.Param("id")
+	response, err := s.UpdateCalendar(ctx.Request.Context(), &request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) UpdateCalendar(ctx context.Context, request *appointmentcontracts.UpdateCalendarRequest) (*appointmentcontracts.UpdateCalendarResponse, error) {
+	calendar, err := s.calendars.UpdateCalendar(ctx, applicationv2.UpdateCalendarCommand{
+		CalendarID: request.GetId(),
+		Name:       request.Name,
+		Color:      request.Color,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.UpdateCalendarResponse{Calendar: calendarProto(*calendar)}, nil
+}
+
+func (s *Server) archiveCalendarProto(ctx *gin.Context) {
+	response, err := s.ArchiveCalendar(ctx.Request.Context(), &appointmentcontracts.ArchiveCalendarRequest{Id: ctx.Param("id")})
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) ArchiveCalendar(ctx context.Context, request *appointmentcontracts.ArchiveCalendarRequest) (*appointmentcontracts.ArchiveCalendarResponse, error) {
+	calendar, err := s.calendars.ArchiveCalendar(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &appointmentcontracts.ArchiveCalendarResponse{Calendar: calendarProto(*calendar)}, nil
+}
+
+func (s *Server) importTimeBlocksProto(ctx *gin.Context) {
+	var request appointmentcontracts.ImportTimeBlocksRequest
+	if !s.readProtoJSON(ctx, &request) {
+		return
+	}
+	request.Id = ctx.Param("id")
+	response, err := s.ImportTimeBlocks(ctx.Request.Context(), &request)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) ImportTimeBlocks(ctx context.Context, request *appointmentcontracts.ImportTimeBlocksRequest) (*appointmentcontracts.ImportTimeBlocksResponse, error) {
+	timeBlocks, err := parseICSTimeBlocks([]byte(request.GetIcs()))
+	if err != nil {
+		return nil, invalidRequest(err)
+	}
+	results, err := s.timeBlockImports.ImportTimeBlocks(ctx, applicationv2.ImportTimeBlocksCommand{
+		CalendarID: request.GetId(),
+		Reason:     request.GetReason(),
+		TimeBlocks: timeBlocks,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &appointmentcontracts.ImportTimeBlocksResponse{Results: make([]*appointmentcontracts.TimeBlockImportResult, 0, len(results))}
+	for _, result := range results {
+		response.Results = append(response.Results, &appointmentcontracts.TimeBlockImportResult{
+			Uid:             result.UID,
+			CalendarEventId: result.CalendarEventID,
+			Status:          timeBlockImportStatusProto(result.Status),
+			Conflicts:       calendarEventConflictsProto(result.Conflicts),
+		})
+	}
+	return response, nil
+}
+
+func listCalendarsRequestFromQuery(ctx *gin.Context) (*appointmentcontracts.ListCalendarsRequest, error) {
+	request := &appointmentcontracts.ListCalendarsRequest{}
+	raw := strings.TrimSpace(ctx.Query("includeArchived"))
+	if raw == "" {
+		return request, nil
+	}
+	includeArchived, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid includeArchived")
+	}
+	request.IncludeArchived = includeArchived
+	return request, nil
+}
+
+func calendarProto(calendar domain.Calendar) *appointmentcontracts.Calendar {
+	out := &appointmentcontracts.Calendar{
+		Id:        calendar.ID,
+		Name:      calendar.Name,
+		Color:     stringValue(calendar.Color),
+		CreatedAt: timestamppb.New(calendar.CreatedAt),
+		UpdatedAt: timestamppb.New(calendar.UpdatedAt),
+	}
+	if calendar.ArchivedAt != nil {
+		out.ArchivedAt = timestamppb.New(*calendar.ArchivedAt)
+	}
+	return out
+}
+
+func timeBlockImportStatusProto(status applicationv2.TimeBlockImportStatus) appointmentcontracts.TimeBlockImportStatus {
+	switch status {
+	case applicationv2.TimeBlockImportCreated:
+		return appointmentcontracts.TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_CREATED
+	case applicationv2.TimeBlockImportUpdated:
+		return appointmentcontracts.TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_UPDATED
+	case applicationv2.TimeBlockImportUnchanged:
+		return appointmentcontracts.TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_UNCHANGED
+	case applicationv2.TimeBlockImportCanceled:
+		return appointmentcontracts.TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_CANCELED
+	case applicationv2.TimeBlockImportSkipped:
+		return appointmentcontracts.TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_SKIPPED
+	default:
+		return appointmentcontracts.TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+const roomCalendarID = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+
+func TestCalendarEventsListQueryAcceptsSeveralCalendars(t *testing.T) {
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/calendar-events?calendarIds=AAAAAAAA-AAAA-AAAA-AAAA-AAAAAAAAAAAA&calendarIds="+domain.DefaultCalendarID, nil)
+
+	request, err := listCalendarEventsRequestFromQuery(context)
+	if err != nil {
+		t.Fatalf("listCalendarEventsRequestFromQuery() error = %v", err)
+	}
+	query, err := calendarEventsListQuery(request)
+	if err != nil {
+		t.Fatalf("calendarEventsListQuery() error = %v", err)
+	}
+	if len(query.CalendarIDs) != 2 || query.CalendarIDs[0] != roomCalendarID || query.CalendarIDs[1] != domain.DefaultCalendarID {
+		t.Fatalf("calendar ids = %#v", query.CalendarIDs)
+	}
+
+	context, _ = gin.CreateTestContext(httptest.NewRecorder())
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/calendar-events", nil)
+	request, err = listCalendarEventsRequestFromQuery(context)
+	if err != nil {
+		t.Fatalf("listCalendarEventsRequestFromQuery() error = %v", err)
+	}
+	query, err = calendarEventsListQuery(request)
+	if err != nil || len(query.CalendarIDs) != 1 || query.CalendarIDs[0] != domain.DefaultCalendarID {
+		t.Fatalf("calendar ids = %#v, %v, want the default calendar", query.CalendarIDs, err)
+	}
+}
+
+func TestCreateCalendarProtoRejectsInvalidColor(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodPost, "/v1/calendars", strings.NewReader(`{"name":"Room 1","color":"red"}`))
+
+	(&Server{calendars: newCalendarRegistryStub(nil)}).createCalendarProto(context)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusBadRequest, recorder.Body.String())
+	}
+}
+
+func TestArchiveCalendarProtoArchivesTheCalendar(t *testing.T) {
+	repository := &calendarRepositoryStub{calendars: map[string]domain.Calendar{roomCalendarID: {ID: roomCalendarID, Name: "Room 1"}}}
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Params = gin.Params{{Key: "id", Value: roomCalendarID}}
+	context.Request = httptest.NewRequest(http.MethodPost, "/v1/calendars/"+roomCalendarID+"/archive", nil)
+
+	(&Server{calendars: newCalendarRegistryStub(repository)}).archiveCalendarProto(context)
+
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"archivedAt"`) {
+		t.Fatalf("status = %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	context, _ = gin.CreateTestContext(recorder)
+	context.Params = gin.Params{{Key: "id", Value: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"}}
+	context.Request = httptest.NewRequest(http.MethodPost, "/v1/calendars/bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb/archive", nil)
+
+	(&Server{calendars: newCalendarRegistryStub(repository)}).archiveCalendarProto(context)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusNotFound, recorder.Body.String())
+	}
+}
+
+func newCalendarRegistryStub(repository *calendarRepositoryStub) *applicationv2.CalendarRegistry {
+	if repository == nil {
+		repository = &calendarRepositoryStub{calendars: map[string]domain.Calendar{}}
+	}
+	return applicationv2.NewCalendarRegistry(repository, calendarClockStub{})
+}
+
+type calendarClockStub struct{}
+
+func (calendarClockStub) Now() time.Time {
+	return time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+}
+
+type calendarRepositoryStub struct {
+	calendars map[string]domain.Calendar
+}
+
+func (r *calendarRepositoryStub) NextCalendarID() string {
+	return roomCalendarID
+}
+
+func (r *calendarRepositoryStub) FindCalendar(_ context.Context, calendarID string) (*domain.Calendar, error) {
+	calendar, ok := r.calendars[calendarID]
+	if !ok {
+		return nil, nil
+	}
+	return &calendar, nil
+}
+
+func (r *calendarRepositoryStub) SaveCalendar(_ context.Context, calendar domain.Calendar) error {
+	r.calendars[calendar.ID] = calendar
+	return nil
+}
+
+func (r *calendarRepositoryStub) ListCalendars(context.Context, bool) ([]domain.Calendar, error) {
+	return nil, nil
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	"github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment/appointmentconnect"
+)
+
+var (
+	_ appointmentconnect.CalendarServiceHandler       = (*Server)(nil)
+	_ appointmentconnect.ServiceCatalogServiceHandler = (*Server)(nil)
+)
+
+// registerCalendarConnectHandlers serves the calendar and service catalog RPCs over the Connect, gRPC and
+// gRPC-Web protocols, next to their JSON routes.
+func registerCalendarConnectHandlers(r gin.IRouter, handler *Server) {
+	interceptors := connect.WithInterceptors(calendarErrorInterceptor())
+	path, calendarHandler := appointmentconnect.NewCalendarServiceHandler(handler, interceptors)
+	r.POST(path+"*procedure", gin.WrapH(calendarHandler))
+	path, catalogHandler := appointmentconnect.NewServiceCatalogServiceHandler(handler, interceptors)
+	r.POST(path+"*procedure", gin.WrapH(catalogHandler))
+}
+
+// calendarErrorInterceptor turns the errors of the calendar services into Connect errors with the code
+// matching the status of the JSON routes.
+func calendarErrorInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			response, err := next(ctx, request)
+			if err != nil {
+				return nil, calendarConnectError(err)
+			}
+			return response, nil
+		}
+	}
+}
+
+// calendarConnectError maps err like writeCalendarError does. Scheduling conflicts are sent as failed
+// preconditions carrying the conflicting events as details.
+func calendarConnectError(err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	var conflictErr *applicationv2.CalendarEventConflictError
+	if errors.As(err, &conflictErr) {
+		out := connect.NewError(connect.CodeFailedPrecondition, err)
+		for _, conflict := range calendarEventConflictsProto(conflictErr.Conflicts) {
+			detail, detailErr := connect.NewErrorDetail(conflict)
+			if detailErr != nil {
+				return connect.NewError(connect.CodeInternal, detailErr)
+			}
+			out.AddDetail(detail)
+		}
+		return out
+	}
+	switch calendarErrorStatus(err) {
+	case http.StatusBadRequest:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case http.StatusForbidden:
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case http.StatusNotFound:
+		return connect.NewError(connect.CodeNotFound, err)
+	case http.StatusConflict:
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
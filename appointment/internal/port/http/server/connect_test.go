@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gin-gonic/gin"
+	"github.com/petretiandrea/beaesthetic-backend/appointment/internal/application"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	legacydomain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+	"github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment/appointmentconnect"
+	"go.uber.org/zap"
+)
+
+func TestConnectServesTheServiceCatalogOverConnectAndGRPC(t *testing.T) {
+	repository := &serviceRepositoryStub{searchResults: []legacydomain.AppointmentService{{ID: "service-1", Name: "Facial treatment"}}}
+	server := httptest.NewUnstartedServer(newConnectTestEngine(&Server{services: application.NewServiceService(repository)}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	for name, option := range map[string]connect.ClientOption{
+		"connect":  connect.WithProtoJSON(),
+		"grpc":     connect.WithGRPC(),
+		"grpc-web": connect.WithGRPCWeb(),
+	} {
+		client := appointmentconnect.NewServiceCatalogServiceClient(server.Client(), server.URL, option)
+		response, err := client.ListServices(context.Background(), &appointmentcontracts.ListServicesRequest{Query: "facial", Limit: 2})
+		if err != nil {
+			t.Fatalf("%s: ListServices() error = %v", name, err)
+		}
+		if len(response.GetServices()) != 1 || response.GetServices()[0].GetId() != "service-1" {
+			t.Fatalf("%s: services = %v", name, response.GetServices())
+		}
+		if repository.query != "facial" || repository.limit != 2 {
+			t.Fatalf("%s: search = (%q, %d), want (facial, 2)", name, repository.query, repository.limit)
+		}
+	}
+}
+
+func TestConnectMapsTheCalendarErrorsToCodes(t *testing.T) {
+	server := httptest.NewServer(newConnectTestEngine(&Server{
+		services:  application.NewServiceService(&serviceRepositoryStub{}),
+		calendars: newCalendarRegistryStub(nil),
+	}))
+	defer server.Close()
+	calendars := appointmentconnect.NewCalendarServiceClient(server.Client(), server.URL)
+	services := appointmentconnect.NewServiceCatalogServiceClient(server.Client(), server.URL)
+
+	_, err := calendars.ArchiveCalendar(context.Background(), &appointmentcontracts.ArchiveCalendarRequest{Id: roomCalendarID})
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("ArchiveCalendar() error = %v, want not found", err)
+	}
+	color := "red"
+	_, err = calendars.CreateCalendar(context.Background(), &appointmentcontracts.CreateCalendarRequest{Name: "Room 1", Color: &color})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("CreateCalendar() error = %v, want invalid argument", err)
+	}
+	_, err = services.UpdateService(context.Background(), &appointmentcontracts.UpdateServiceRequest{Id: "service-1"})
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("UpdateService() error = %v, want not found", err)
+	}
+	_, err = calendars.FindAvailableSlots(context.Background(), &appointmentcontracts.FindAvailableSlotsRequest{DurationMinutes: 30})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("FindAvailableSlots() error = %v, want invalid argument", err)
+	}
+}
+
+func TestCalendarConnectErrorSendsTheConflictsAsDetails(t *testing.T) {
+	start := time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)
+	err := calendarConnectError(&applicationv2.CalendarEventConflictError{Conflicts: []applicationv2.CalendarEventConflict{{
+		CalendarEventID: "event-1",
+		Type:            domain.CalendarEventTypeAppointment,
+		Range:           domain.TimeRange{Start: start, End: start.Add(time.Hour)},
+	}}})
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("error = %v, want failed precondition", err)
+	}
+	if len(connectErr.Details()) != 1 {
+		t.Fatalf("details = %d, want 1", len(connectErr.Details()))
+	}
+	detail, err := connectErr.Details()[0].Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	conflict, ok := detail.(*appointmentcontracts.CalendarEventConflict)
+	if !ok || conflict.GetCalendarEventId() != "event-1" {
+		t.Fatalf("detail = %v, want the conflicting event", detail)
+	}
+
+	for err, code := range map[error]connect.Code{
+		applicationv2.ErrCalendarEventVersionConflict: connect.CodeAborted,
+		applicationv2.ErrInvalidFeedToken:             connect.CodePermissionDenied,
+		invalidRequest(errors.New("bad")):             connect.CodeInvalidArgument,
+		errors.New("boom"):                            connect.CodeInternal,
+	} {
+		if got := connect.CodeOf(calendarConnectError(err)); got != code {
+			t.Errorf("code of %v = %v, want %v", err, got, code)
+		}
+	}
+}
+
+func newConnectTestEngine(handler *Server) *gin.Engine {
+	return New(&HttpHandlers{
+		Calendar: handler,
+		HealthChecker: func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		},
+	}, zap.NewNop())
+}
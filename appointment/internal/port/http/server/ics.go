@@ -0,0 +1,275 @@
+package server
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+const (
+	icsProductID      = "-//beaesthetic//appointment//IT"
+	icsUIDDomain      = "appointment.beaesthetic"
+	icsDateTimeLayout = "20060102T150405"
+	icsDateLayout     = "20060102"
+	// icsLineLimit is the RFC 5545 limit for a content line, in octets, before it must be folded.
+	icsLineLimit = 75
+)
+
+// encodeICS renders the feed as an RFC 5545 VCALENDAR. Recurring events are kept as series with RRULE and
+// EXDATE, canceled events are published with STATUS:CANCELLED and private events only as busy time.
+// Each TZID used by the events is described by a VTIMEZONE.
+func encodeICS(feed applicationv2.CalendarFeed, now time.Time) string {
+	var w icsWriter
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", icsProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	if feed.Name != "" {
+		w.line("X-WR-CALNAME", icsText(feed.Name))
+	}
+	for _, location := range icsTimezones(feed.Events) {
+		w.timezone(location, now.Year())
+	}
+	for _, view := range feed.Events {
+		w.event(view.Event, now)
+	}
+	w.line("END", "VCALENDAR")
+	return w.String()
+}
+
+type icsWriter struct {
+	strings.Builder
+}
+
+func (w *icsWriter) event(event domain.CalendarEvent, now time.Time) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", event.ID+"@"+icsUIDDomain)
+	w.line("DTSTAMP", now.UTC().Format(icsDateTimeLayout+"Z"))
+	w.line("CREATED", event.CreatedAt.UTC().Format(icsDateTimeLayout+"Z"))
+	w.line("LAST-MODIFIED", event.UpdatedAt.UTC().Format(icsDateTimeLayout+"Z"))
+	w.line("SEQUENCE", strconv.FormatInt(event.Version, 10))
+	w.instant("DTSTART", event.Range, event.Range.Start)
+	if event.Range.AllDay {
+		w.instant("DTEND", event.Range, icsAllDayEnd(event.Range))
+	} else {
+		w.instant("DTEND", event.Range, event.Range.End)
+	}
+	if event.Recurrence != nil {
+		w.line("RRULE", icsRecurrenceRule(event.Range, event.Recurrence.Rule))
+		for _, exceptionDate := range event.Recurrence.ExceptionDates {
+			w.instant("EXDATE", event.Range, exceptionDate)
+		}
+	}
+	if event.Visibility == domain.VisibilityPublic {
+		w.line("CLASS", "PUBLIC")
+		w.line("SUMMARY", icsText(event.Title))
+		if event.Description != "" {
+			w.line("DESCRIPTION", icsText(event.Description))
+		}
+		if manualEvent, ok := event.Detail.(domain.ManualEvent); ok && manualEvent.Location != nil {
+			w.line("LOCATION", icsText(*manualEvent.Location))
+		}
+	} else {
+		w.line("CLASS", "PRIVATE")
+	}
+	w.line("TRANSP", "OPAQUE")
+	if event.IsCanceled() {
+		w.line("STATUS", "CANCELLED")
+	} else {
+		w.line("STATUS", "CONFIRMED")
+	}
+	w.line("END", "VEVENT")
+}
+
+// instant writes a date for all-day ranges, a UTC time for UTC ranges and a TZID local time otherwise.
+func (w *icsWriter) instant(name string, eventRange domain.TimeRange, value time.Time) {
+	location := icsLocation(eventRange)
+	switch {
+	case eventRange.AllDay:
+		w.line(name+";VALUE=DATE", value.In(location).Format(icsDateLayout))
+	case location == time.UTC:
+		w.line(name, value.UTC().Format(icsDateTimeLayout+"Z"))
+	default:
+		w.line(name+";TZID="+location.String(), value.In(location).Format(icsDateTimeLayout))
+	}
+}
+
+// timezone writes the VTIMEZONE of location with the daylight saving transitions of year, repeated yearly.
+// A location without transitions in that year gets a single STANDARD observance.
+func (w *icsWriter) timezone(location *time.Location, year int) {
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", location.String())
+	transitions := icsTransitions(location, year)
+	if len(transitions) == 0 {
+		name, offset := time.Date(year, time.January, 1, 0, 0, 0, 0, location).Zone()
+		w.line("BEGIN", "STANDARD")
+		w.line("DTSTART", "19700101T000000")
+		w.line("TZOFFSETFROM", icsOffset(offset))
+		w.line("TZOFFSETTO", icsOffset(offset))
+		w.line("TZNAME", name)
+		w.line("END", "STANDARD")
+	}
+	for _, transition := range transitions {
+		component := "STANDARD"
+		if transition.at.In(location).IsDST() {
+			component = "DAYLIGHT"
+		}
+		name, offset := transition.at.In(location).Zone()
+		// The onset is the wall clock time of the transition before it happens, moved to the same rule in 1970.
+		local := transition.at.In(time.FixedZone("", transition.offsetFrom))
+		ordinal := (local.Day()-1)/7 + 1
+		if local.AddDate(0, 0, 7).Month() != local.Month() {
+			ordinal = -1
+		}
+		onset := icsNthWeekday(1970, local.Month(), local.Weekday(), ordinal)
+		onset = time.Date(onset.Year(), onset.Month(), onset.Day(), local.Hour(), local.Minute(), local.Second(), 0, time.UTC)
+		w.line("BEGIN", component)
+		w.line("DTSTART", onset.Format(icsDateTimeLayout))
+		w.line("RRULE", fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s", local.Month(), ordinal, icsWeekdays[local.Weekday()]))
+		w.line("TZOFFSETFROM", icsOffset(transition.offsetFrom))
+		w.line("TZOFFSETTO", icsOffset(offset))
+		w.line("TZNAME", name)
+		w.line("END", component)
+	}
+	w.line("END", "VTIMEZONE")
+}
+
+// line writes a content line, folding it at icsLineLimit octets without splitting UTF-8 sequences.
+func (w *icsWriter) line(name string, value string) {
+	content := name + ":" + value
+	limit := icsLineLimit
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(content[:cut])
+		w.WriteString("\r\n ")
+		content = content[cut:]
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = icsLineLimit - 1
+	}
+	w.WriteString(content)
+	w.WriteString("\r\n")
+}
+
+func icsLocation(eventRange domain.TimeRange) *time.Location {
+	location, err := time.LoadLocation(eventRange.Timezone)
+	if err != nil || eventRange.Timezone == "" {
+		return time.UTC
+	}
+	return location
+}
+
+// icsTimezones returns the locations of the events written with a TZID, sorted by name.
+func icsTimezones(views []applicationv2.CalendarEventView) []*time.Location {
+	byName := map[string]*time.Location{}
+	for _, view := range views {
+		location := icsLocation(view.Event.Range)
+		if view.Event.Range.AllDay || location == time.UTC {
+			continue
+		}
+		byName[location.String()] = location
+	}
+	locations := make([]*time.Location, 0, len(byName))
+	for _, name := range slices.Sorted(maps.Keys(byName)) {
+		locations = append(locations, byName[name])
+	}
+	return locations
+}
+
+type icsTransition struct {
+	at         time.Time
+	offsetFrom int
+}
+
+// icsTransitions finds the instants of year at which location changes its UTC offset, to the second.
+func icsTransitions(location *time.Location, year int) []icsTransition {
+	var transitions []icsTransition
+	day := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+	for day.Year() == year {
+		next := day.AddDate(0, 0, 1)
+		_, before := day.Zone()
+		if _, after := next.Zone(); after != before {
+			low, high := day.Unix(), next.Unix()
+			for high-low > 1 {
+				middle := low + (high-low)/2
+				if _, offset := time.Unix(middle, 0).In(location).Zone(); offset == before {
+					low = middle
+				} else {
+					high = middle
+				}
+			}
+			transitions = append(transitions, icsTransition{at: time.Unix(high, 0).UTC(), offsetFrom: before})
+		}
+		day = next
+	}
+	return transitions
+}
+
+var icsWeekdays = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// icsNthWeekday returns the ordinal weekday of the month, counting from the end when ordinal is negative.
+func icsNthWeekday(year int, month time.Month, weekday time.Weekday, ordinal int) time.Time {
+	if ordinal < 0 {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		return last.AddDate(0, 0, -((int(last.Weekday())-int(weekday)+7)%7 + 7*(-ordinal-1)))
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return first.AddDate(0, 0, (int(weekday)-int(first.Weekday())+7)%7+7*(ordinal-1))
+}
+
+// icsOffset formats a UTC offset in seconds as ±hhmm, or ±hhmmss when it has seconds.
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	value := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		value += fmt.Sprintf("%02d", seconds%60)
+	}
+	return value
+}
+
+// icsAllDayEnd returns the exclusive end date of an all-day range, rounding a partial last day up.
+func icsAllDayEnd(eventRange domain.TimeRange) time.Time {
+	location := icsLocation(eventRange)
+	last := eventRange.End.Add(-time.Nanosecond).In(location)
+	return time.Date(last.Year(), last.Month(), last.Day()+1, 0, 0, 0, 0, location)
+}
+
+// icsRecurrenceRule renders the rule; all-day series need a date UNTIL like their DTSTART.
+func icsRecurrenceRule(eventRange domain.TimeRange, rule domain.RecurrenceRule) string {
+	value := rule.String()
+	if eventRange.AllDay && rule.Until != nil {
+		until := rule.Until.UTC().Format(icsDateTimeLayout + "Z")
+		value = strings.Replace(value, "UNTIL="+until, "UNTIL="+rule.Until.In(icsLocation(eventRange)).Format(icsDateLayout), 1)
+	}
+	return value
+}
+
+func icsText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+)
+
+var errInvalidICS = errors.New("invalid iCalendar file")
+
+// icsProperty is an unfolded content line split into name, parameters and raw value.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICSTimeBlocks reads the VEVENTs of an RFC 5545 file. Nested components such as VALARM are ignored, and
+// so are overrides of single occurrences (RECURRENCE-ID), which time blocks cannot represent.
+func parseICSTimeBlocks(data []byte) ([]applicationv2.ImportedTimeBlock, error) {
+	var (
+		blocks     []applicationv2.ImportedTimeBlock
+		components []string
+		event      []icsProperty
+	)
+	for _, line := range unfoldICS(string(data)) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		property, err := parseICSProperty(line)
+		if err != nil {
+			return nil, err
+		}
+		switch property.name {
+		case "BEGIN":
+			component := strings.ToUpper(property.value)
+			components = append(components, component)
+			if component == "VEVENT" {
+				event = nil
+			}
+			continue
+		case "END":
+			component := strings.ToUpper(property.value)
+			if len(components) == 0 || components[len(components)-1] != component {
+				return nil, fmt.Errorf("%w: unexpected END:%s", errInvalidICS, property.value)
+			}
+			components = components[:len(components)-1]
+			if component == "VEVENT" {
+				block, ok, err := icsTimeBlock(event)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					blocks = append(blocks, block)
+				}
+			}
+			continue
+		}
+		if len(components) > 0 && components[len(components)-1] == "VEVENT" {
+			event = append(event, property)
+		}
+	}
+	if len(components) > 0 {
+		return nil, fmt.Errorf("%w: missing END:%s", errInvalidICS, components[len(components)-1])
+	}
+	return blocks, nil
+}
+
+// unfoldICS joins continuation lines, which start with a space or a tab, to the line they continue.
+func unfoldICS(data string) []string {
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\n ", "")
+	data = strings.ReplaceAll(data, "\n\t", "")
+	return strings.Split(data, "\n")
+}
+
+func parseICSProperty(line string) (icsProperty, error) {
+	quoted := false
+	separator := -1
+	for index, char := range line {
+		if char == '"' {
+			quoted = !quoted
+		}
+		if char == ':' && !quoted {
+			separator = index
+			break
+		}
+	}
+	if separator <= 0 {
+		return icsProperty{}, fmt.Errorf("%w: malformed line %q", errInvalidICS, line)
+	}
+	parts := strings.Split(line[:separator], ";")
+	property := icsProperty{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: line[separator+1:]}
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		property.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return property, nil
+}
+
+func icsTimeBlock(properties []icsProperty) (applicationv2.ImportedTimeBlock, bool, error) {
+	var (
+		block       applicationv2.ImportedTimeBlock
+		start, end  *icsProperty
+		hasDuration bool
+		duration    time.Duration
+	)
+	for index := range properties {
+		property := &properties[index]
+		switch property.name {
+		case "UID":
+			block.UID = strings.TrimSpace(property.value)
+		case "RECURRENCE-ID":
+			return applicationv2.ImportedTimeBlock{}, false, nil
+		case "SUMMARY":
+			block.Title = unescapeICSText(property.value)
+		case "DESCRIPTION":
+			block.Description = unescapeICSText(property.value)
+		case "STATUS":
+			block.Canceled = strings.EqualFold(property.value, "CANCELLED")
+		case "DTSTART":
+			start = property
+		case "DTEND":
+			end = property
+		case "DURATION":
+			parsed, err := parseICSDuration(property.value)
+			if err != nil {
+				return applicationv2.ImportedTimeBlock{}, false, err
+			}
+			duration, hasDuration = parsed, true
+		case "RRULE":
+			block.RecurrenceRule = property.value
+		case "EXDATE":
+			for _, value := range strings.Split(property.value, ",") {
+				exceptionDate, _, _, err := parseICSTime(property.params, value)
+				if err != nil {
+					return applicationv2.ImportedTimeBlock{}, false, err
+				}
+				block.ExceptionDates = append(block.ExceptionDates, exceptionDate)
+			}
+		}
+	}
+	if block.UID == "" || start == nil {
+		return applicationv2.ImportedTimeBlock{}, false, fmt.Errorf("%w: VEVENT without UID or DTSTART", errInvalidICS)
+	}
+	var err error
+	block.Start, block.Timezone, block.AllDay, err = parseICSTime(start.params, start.value)
+	if err != nil {
+		return applicationv2.ImportedTimeBlock{}, false, err
+	}
+	switch {
+	case end != nil:
+		block.End, _, _, err = parseICSTime(end.params, end.value)
+		if err != nil {
+			return applicationv2.ImportedTimeBlock{}, false, err
+		}
+	case hasDuration:
+		block.End = block.Start.Add(duration)
+	case block.AllDay:
+		block.End = block.Start.AddDate(0, 0, 1)
+	default:
+		return applicationv2.ImportedTimeBlock{}, false, fmt.Errorf("%w: VEVENT %s without DTEND", errInvalidICS, block.UID)
+	}
+	return block, true, nil
+}
+
+// parseICSTime returns UTC instants with a "UTC" timezone, TZID local times with their zone and floating times
+// or dates as UTC wall clocks with no timezone.
+func parseICSTime(params map[string]string, value string) (time.Time, string, bool, error) {
+	value = strings.TrimSpace(value)
+	if params["VALUE"] == "DATE" || len(value) == len(icsDateLayout) {
+		date, err := time.Parse(icsDateLayout, value)
+		if err != nil {
+			return time.Time{}, "", false, fmt.Errorf("%w: invalid date %q", errInvalidICS, value)
+		}
+		return date, "", true, nil
+	}
+	if utc, ok := strings.CutSuffix(value, "Z"); ok {
+		instant, err := time.Parse(icsDateTimeLayout, utc)
+		if err != nil {
+			return time.Time{}, "", false, fmt.Errorf("%w: invalid date-time %q", errInvalidICS, value)
+		}
+		return instant, "UTC", false, nil
+	}
+	location := time.UTC
+	timezone := params["TZID"]
+	if timezone != "" {
+		loaded, err := time.LoadLocation(timezone)
+		if err != nil {
+			return time.Time{}, "", false, fmt.Errorf("%w: unknown TZID %q", errInvalidICS, timezone)
+		}
+		location = loaded
+	}
+	instant, err := time.ParseInLocation(icsDateTimeLayout, value, location)
+	if err != nil {
+		return time.Time{}, "", false, fmt.Errorf("%w: invalid date-time %q", errInvalidICS, value)
+	}
+	return instant, timezone, false, nil
+}
+
+// parseICSDuration reads the dur-value of RFC 5545 section 3.3.6, e.g. "PT1H30M" or "P1D".
+func parseICSDuration(value string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(strings.TrimPrefix(strings.TrimSpace(value), "+"), "P")
+	if !ok || rest == "" {
+		return 0, fmt.Errorf("%w: invalid DURATION %q", errInvalidICS, value)
+	}
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+	var duration time.Duration
+	number := 0
+	digits := false
+	for index := 0; index < len(rest); index++ {
+		char := rest[index]
+		switch {
+		case char >= '0' && char <= '9':
+			number, digits = number*10+int(char-'0'), true
+		case char == 'T':
+			units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+		case digits && units[char] > 0:
+			duration += time.Duration(number) * units[char]
+			number, digits = 0, false
+		default:
+			return 0, fmt.Errorf("%w: invalid DURATION %q", errInvalidICS, value)
+		}
+	}
+	if digits || duration <= 0 {
+		return 0, fmt.Errorf("%w: invalid DURATION %q", errInvalidICS, value)
+	}
+	return duration, nil
+}
+
+func unescapeICSText(value string) string {
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	).Replace(value)
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseICSTimeBlocks(t *testing.T) {
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:holiday-1@example.com\r\n" +
+		"SUMMARY:Closed\\, holidays\r\n" +
+		"DESCRIPTION:Back on\r\n  Monday\r\n" +
+		"DTSTART;VALUE=DATE:20260810\r\n" +
+		"DTEND;VALUE=DATE:20260817\r\n" +
+		"BEGIN:VALARM\r\n" +
+		"DESCRIPTION:Reminder\r\n" +
+		"END:VALARM\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:training\r\n" +
+		"DTSTART;TZID=Europe/Rome:20260901T140000\r\n" +
+		"DURATION:PT1H30M\r\n" +
+		"RRULE:FREQ=WEEKLY;COUNT=4\r\n" +
+		"EXDATE;TZID=Europe/Rome:20260908T140000\r\n" +
+		"STATUS:CANCELLED\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:training\r\n" +
+		"RECURRENCE-ID;TZID=Europe/Rome:20260915T140000\r\n" +
+		"DTSTART:20260915T120000Z\r\n" +
+		"DTEND:20260915T130000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	blocks, err := parseICSTimeBlocks([]byte(ics))
+	if err != nil {
+		t.Fatalf("parseICSTimeBlocks() error = %v", err)
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("blocks = %#v, want the two series without the occurrence override", blocks)
+	}
+	holiday := blocks[0]
+	if holiday.UID != "holiday-1@example.com" || holiday.Title != "Closed, holidays" || holiday.Description != "Back on Monday" {
+		t.Fatalf("holiday = %#v", holiday)
+	}
+	if !holiday.AllDay || holiday.Timezone != "" || !holiday.Start.Equal(time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC)) || !holiday.End.Equal(time.Date(2026, 8, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("holiday range = %v - %v, all day %v", holiday.Start, holiday.End, holiday.AllDay)
+	}
+	training := blocks[1]
+	if training.Timezone != "Europe/Rome" || !training.Start.Equal(time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)) || training.End.Sub(training.Start) != 90*time.Minute {
+		t.Fatalf("training range = %v - %v in %q", training.Start, training.End, training.Timezone)
+	}
+	if training.RecurrenceRule != "FREQ=WEEKLY;COUNT=4" || len(training.ExceptionDates) != 1 || !training.ExceptionDates[0].Equal(time.Date(2026, 9, 8, 12, 0, 0, 0, time.UTC)) || !training.Canceled {
+		t.Fatalf("training = %#v", training)
+	}
+}
+
+func TestParseICSTimeBlocksRejectsMalformedFiles(t *testing.T) {
+	for name, ics := range map[string]string{
+		"unterminated": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:1\n",
+		"no start":     "BEGIN:VEVENT\nUID:1\nEND:VEVENT\n",
+		"unknown zone": "BEGIN:VEVENT\nUID:1\nDTSTART;TZID=Mars/Olympus:20260901T100000\nDTEND:20260901T110000Z\nEND:VEVENT\n",
+		"no end":       "BEGIN:VEVENT\nUID:1\nDTSTART:20260901T100000Z\nEND:VEVENT\n",
+	} {
+		if _, err := parseICSTimeBlocks([]byte(ics)); !errors.Is(err, errInvalidICS) {
+			t.Errorf("%s: error = %v, want errInvalidICS", name, err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+)
+
+func TestEncodeICSRendersPublicPrivateAndCanceledEvents(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	location := "Studio, first floor"
+	public := domain.CalendarEvent{
+		ID:          "event-1",
+		Range:       domain.TimeRange{Start: time.Date(2026, 8, 3, 8, 0, 0, 0, time.UTC), End: time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC), Timezone: "Europe/Rome"},
+		Title:       "Team sync",
+		Description: "Weekly; agenda",
+		Visibility:  domain.VisibilityPublic,
+		Detail:      domain.ManualEvent{Title: "Team sync", Location: &location},
+		Recurrence:  &domain.Recurrence{Rule: domain.RecurrenceRule{Frequency: domain.RecurrenceFrequencyWeekly, Interval: 1}, ExceptionDates: []time.Time{time.Date(2026, 8, 10, 8, 0, 0, 0, time.UTC)}},
+		Version:     2,
+	}
+	private := domain.CalendarEvent{
+		ID:           "event-2",
+		Range:        domain.TimeRange{Start: time.Date(2026, 8, 4, 10, 0, 0, 0, time.UTC), End: time.Date(2026, 8, 4, 11, 0, 0, 0, time.UTC), Timezone: "UTC"},
+		Title:        "Jane Doe",
+		Description:  "Allergic to latex",
+		Visibility:   domain.VisibilityPrivate,
+		Detail:       domain.Appointment{Customer: domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"}},
+		Cancellation: &domain.CalendarEventCancellation{Reason: domain.CancelReasonDeleted, CanceledAt: now},
+		Version:      3,
+	}
+
+	ics := encodeICS(applicationv2.CalendarFeed{Name: "Room 1", Events: []applicationv2.CalendarEventView{{Event: public}, {Event: private}}}, now)
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:Room 1\r\n",
+		"UID:event-1@appointment.beaesthetic\r\n",
+		"DTSTART;TZID=Europe/Rome:20260803T100000\r\n",
+		"DTEND;TZID=Europe/Rome:20260803T110000\r\n",
+		"RRULE:FREQ=WEEKLY\r\n",
+		"EXDATE;TZID=Europe/Rome:20260810T100000\r\n",
+		"SUMMARY:Team sync\r\n",
+		"DESCRIPTION:Weekly\\; agenda\r\n",
+		"LOCATION:Studio\\, first floor\r\n",
+		"DTSTART:20260804T100000Z\r\n",
+		"CLASS:PRIVATE\r\n",
+		"STATUS:CANCELLED\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("ics does not contain %q:\n%s", want, ics)
+		}
+	}
+	if strings.Contains(ics, "Jane Doe") || strings.Contains(ics, "latex") {
+		t.Fatalf("private event leaks its title or description:\n%s", ics)
+	}
+}
+
+func TestEncodeICSRendersAllDayEventsAsDates(t *testing.T) {
+	event := domain.CalendarEvent{
+		ID:         "event-1",
+		Range:      domain.TimeRange{Start: time.Date(2026, 8, 2, 22, 0, 0, 0, time.UTC), End: time.Date(2026, 8, 4, 22, 0, 0, 0, time.UTC), Timezone: "Europe/Rome", AllDay: true},
+		Title:      "Holiday",
+		Visibility: domain.VisibilityPublic,
+		Detail:     domain.TimeBlock{Reason: "holiday"},
+	}
+
+	ics := encodeICS(applicationv2.CalendarFeed{Events: []applicationv2.CalendarEventView{{Event: event}}}, event.Range.Start)
+
+	if !strings.Contains(ics, "DTSTART;VALUE=DATE:20260803\r\n") || !strings.Contains(ics, "DTEND;VALUE=DATE:20260805\r\n") {
+		t.Fatalf("ics = %s, want the local dates of the all-day range", ics)
+	}
+}
+
+func TestEncodeICSDescribesEachTimezoneUsed(t *testing.T) {
+	now := time.Date(2026, 8, 1, 8, 0, 0, 0, time.UTC)
+	rome := domain.TimeRange{Start: time.Date(2026, 8, 3, 8, 0, 0, 0, time.UTC), End: time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC), Timezone: "Europe/Rome"}
+	utc := domain.TimeRange{Start: time.Date(2026, 8, 4, 8, 0, 0, 0, time.UTC), End: time.Date(2026, 8, 4, 9, 0, 0, 0, time.UTC), Timezone: "UTC"}
+	views := []applicationv2.CalendarEventView{
+		{Event: domain.CalendarEvent{ID: "event-1", Range: rome, Detail: domain.TimeBlock{}}},
+		{Event: domain.CalendarEvent{ID: "event-2", Range: rome, Detail: domain.TimeBlock{}}},
+		{Event: domain.CalendarEvent{ID: "event-3", Range: utc, Detail: domain.TimeBlock{}}},
+	}
+
+	ics := encodeICS(applicationv2.CalendarFeed{Events: views}, now)
+
+	if got := strings.Count(ics, "BEGIN:VTIMEZONE\r\n"); got != 1 {
+		t.Fatalf("VTIMEZONE components = %d, want 1 for Europe/Rome only:\n%s", got, ics)
+	}
+	want := "BEGIN:VTIMEZONE\r\n" +
+		"TZID:Europe/Rome\r\n" +
+		"BEGIN:DAYLIGHT\r\n" +
+		"DTSTART:19700329T020000\r\n" +
+		"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU\r\n" +
+		"TZOFFSETFROM:+0100\r\n" +
+		"TZOFFSETTO:+0200\r\n" +
+		"TZNAME:CEST\r\n" +
+		"END:DAYLIGHT\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:19701025T030000\r\n" +
+		"RRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU\r\n" +
+		"TZOFFSETFROM:+0200\r\n" +
+		"TZOFFSETTO:+0100\r\n" +
+		"TZNAME:CET\r\n" +
+		"END:STANDARD\r\n" +
+		"END:VTIMEZONE\r\n"
+	if !strings.Contains(ics, want) {
+		t.Fatalf("ics = %s, want the Europe/Rome VTIMEZONE", ics)
+	}
+	if strings.Index(ics, "BEGIN:VTIMEZONE") > strings.Index(ics, "BEGIN:VEVENT") {
+		t.Fatalf("ics = %s, want the VTIMEZONE before the events", ics)
+	}
+}
+
+func TestEncodeICSDescribesTimezonesWithoutTransitions(t *testing.T) {
+	eventRange := domain.TimeRange{Start: time.Date(2026, 8, 3, 8, 0, 0, 0, time.UTC), End: time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC), Timezone: "Asia/Kolkata"}
+
+	ics := encodeICS(applicationv2.CalendarFeed{Events: []applicationv2.CalendarEventView{{Event: domain.CalendarEvent{ID: "event-1", Range: eventRange, Detail: domain.TimeBlock{}}}}}, eventRange.Start)
+
+	if !strings.Contains(ics, "TZID:Asia/Kolkata\r\nBEGIN:STANDARD\r\nDTSTART:19700101T000000\r\nTZOFFSETFROM:+0530\r\nTZOFFSETTO:+0530\r\nTZNAME:IST\r\nEND:STANDARD\r\n") {
+		t.Fatalf("ics = %s, want a single STANDARD observance", ics)
+	}
+}
+
+func TestICSWriterFoldsLongLines(t *testing.T) {
+	var w icsWriter
+	w.line("DESCRIPTION", strings.Repeat("è", 60))
+
+	for _, line := range strings.Split(strings.TrimSuffix(w.String(), "\r\n"), "\r\n") {
+		if len(line) > icsLineLimit {
+			t.Fatalf("line of %d octets exceeds the limit: %q", len(line), line)
+		}
+	}
+	if unfolded := strings.ReplaceAll(w.String(), "\r\n ", ""); unfolded != "DESCRIPTION:"+strings.Repeat("è", 60)+"\r\n" {
+		t.Fatalf("unfolded = %q", unfolded)
+	}
+}
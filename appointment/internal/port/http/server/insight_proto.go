@@ -0,0 +1,150 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+)
+
+func (s *Server) getCustomerRankingProto(ctx *gin.Context) {
+	page, err := pageRequestFromQuery(ctx)
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	ranking, err := s.insights.CustomerRanking(ctx.Request.Context(), pageQueryFromProto(page))
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	response := &appointmentcontracts.GetCustomerRankingResponse{
+		Items:    make([]*appointmentcontracts.CustomerRankingItem, 0, len(ranking.Items)),
+		NextPage: nextPageProto(ranking.NextPage),
+	}
+	for _, item := range ranking.Items {
+		name, surname := splitCustomerDisplayName(item.CustomerDisplayName)
+		response.Items = append(response.Items, &appointmentcontracts.CustomerRankingItem{
+			CustomerId:           item.CustomerID,
+			CustomerName:         name,
+			CustomerSurname:      surname,
+			NumberOfAppointments: int32(item.Count),
+		})
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) getCustomerCancellationRankingProto(ctx *gin.Context) {
+	page, err := pageRequestFromQuery(ctx)
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	ranking, err := s.insights.CustomerCancellationRanking(ctx.Request.Context(), pageQueryFromProto(page))
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	response := &appointmentcontracts.GetCustomerCancellationRankingResponse{
+		Items:    make([]*appointmentcontracts.CustomerCancellationRankingItem, 0, len(ranking.Items)),
+		NextPage: nextPageProto(ranking.NextPage),
+	}
+	for _, item := range ranking.Items {
+		name, surname := splitCustomerDisplayName(item.CustomerDisplayName)
+		response.Items = append(response.Items, &appointmentcontracts.CustomerCancellationRankingItem{
+			CustomerId:            item.CustomerID,
+			CustomerName:          name,
+			CustomerSurname:       surname,
+			NumberOfCancellations: int32(item.Count),
+		})
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) getCustomerNoShowRankingProto(ctx *gin.Context) {
+	page, err := pageRequestFromQuery(ctx)
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	ranking, err := s.insights.CustomerNoShowRanking(ctx.Request.Context(), pageQueryFromProto(page))
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	response := &appointmentcontracts.GetCustomerNoShowRankingResponse{
+		Items:    make([]*appointmentcontracts.CustomerNoShowRankingItem, 0, len(ranking.Items)),
+		NextPage: nextPageProto(ranking.NextPage),
+	}
+	for _, item := range ranking.Items {
+		name, surname := splitCustomerDisplayName(item.CustomerDisplayName)
+		response.Items = append(response.Items, &appointmentcontracts.CustomerNoShowRankingItem{
+			CustomerId:          item.CustomerID,
+			CustomerName:        name,
+			CustomerSurname:     surname,
+			NumberOfNoShows:     int32(item.NoShows),
+			NumberOfAttendances: int32(item.Attendances),
+			NoShowRate:          item.NoShowRate(),
+		})
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) getInsightOverviewProto(ctx *gin.Context) {
+	overview, err := s.insights.Overview(ctx.Request.Context())
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	response := &appointmentcontracts.GetInsightOverviewResponse{
+		CancellationDayOfWeek: make([]*appointmentcontracts.CancellationDayOfWeekCount, 0, len(overview.CancellationsByWeekday)),
+	}
+	for _, count := range overview.CancellationsByWeekday {
+		response.CancellationDayOfWeek = append(response.CancellationDayOfWeek, &appointmentcontracts.CancellationDayOfWeekCount{
+			DayOfWeek:         strings.ToUpper(count.Weekday.String()),
+			CancellationCount: int32(count.Count),
+		})
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func pageRequestFromQuery(ctx *gin.Context) (*appointmentcontracts.PageRequest, error) {
+	request := &appointmentcontracts.PageRequest{}
+	if page := strings.TrimSpace(ctx.Query("page")); page != "" {
+		value, err := strconv.ParseInt(page, 10, 32)
+		if err != nil || value < 0 {
+			return nil, fmt.Errorf("page must be a non-negative integer")
+		}
+		request.Page = int32(value)
+	}
+	if limit := strings.TrimSpace(ctx.Query("limit")); limit != "" {
+		value, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil || value < 1 {
+			return nil, fmt.Errorf("limit must be a positive integer")
+		}
+		request.Limit = int32(value)
+	}
+	return request, nil
+}
+
+func pageQueryFromProto(page *appointmentcontracts.PageRequest) applicationv2.PageQuery {
+	return applicationv2.PageQuery{Page: int(page.GetPage()), Limit: int(page.GetLimit())}
+}
+
+func nextPageProto(nextPage *int) string {
+	if nextPage == nil {
+		return ""
+	}
+	return strconv.Itoa(*nextPage)
+}
+
+// splitCustomerDisplayName splits the display name snapshotted on appointments, which the customer
+// registry builds as name followed by surname. Names without a space are returned as name only.
+func splitCustomerDisplayName(displayName string) (string, string) {
+	name, surname, _ := strings.Cut(strings.TrimSpace(displayName), " ")
+	return name, strings.TrimSpace(surname)
+}
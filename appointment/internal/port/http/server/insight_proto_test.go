@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+)
+
+func TestGetCustomerRankingProtoPagesAndSplitsDisplayName(t *testing.T) {
+	repository := &insightRepositoryStub{ranking: []applicationv2.CustomerAppointmentCount{
+		{CustomerID: "customer-1", CustomerDisplayName: "Anna Maria Rossi", Count: 4},
+		{CustomerID: "customer-2", CustomerDisplayName: "Luca", Count: 2},
+	}}
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/insights/customer-ranking?page=0&limit=1", nil)
+
+	(&Server{insights: applicationv2.NewInsightService(repository)}).getCustomerRankingProto(context)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusOK, recorder.Body.String())
+	}
+	var response appointmentcontracts.GetCustomerRankingResponse
+	if err := protoJSONUnmarshal.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("response is not proto json: %v", err)
+	}
+	if response.GetNextPage() != "1" || len(response.GetItems()) != 1 {
+		t.Fatalf("response = %s", recorder.Body.String())
+	}
+	item := response.GetItems()[0]
+	if item.GetCustomerName() != "Anna" || item.GetCustomerSurname() != "Maria Rossi" || item.GetNumberOfAppointments() != 4 {
+		t.Fatalf("item = %#v", item)
+	}
+}
+
+func TestGetCustomerRankingProtoRejectsInvalidPage(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/insights/customer-ranking?page=-1", nil)
+
+	(&Server{insights: applicationv2.NewInsightService(&insightRepositoryStub{})}).getCustomerRankingProto(context)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetCustomerNoShowRankingProtoIncludesTheRate(t *testing.T) {
+	repository := &insightRepositoryStub{noShows: []applicationv2.CustomerNoShowCount{
+		{CustomerID: "customer-1", CustomerDisplayName: "Anna Rossi", NoShows: 1, Attendances: 4},
+	}}
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/insights/customer-no-show-ranking", nil)
+
+	(&Server{insights: applicationv2.NewInsightService(repository)}).getCustomerNoShowRankingProto(context)
+
+	var response appointmentcontracts.GetCustomerNoShowRankingResponse
+	if err := protoJSONUnmarshal.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("response is not proto json: %v", err)
+	}
+	if len(response.GetItems()) != 1 || response.GetNextPage() != "" {
+		t.Fatalf("response = %s", recorder.Body.String())
+	}
+	item := response.GetItems()[0]
+	if item.GetCustomerSurname() != "Rossi" || item.GetNumberOfNoShows() != 1 || item.GetNumberOfAttendances() != 4 || item.GetNoShowRate() != 0.25 {
+		t.Fatalf("item = %#v", item)
+	}
+}
+
+func TestGetInsightOverviewProtoUsesUpperCaseWeekdays(t *testing.T) {
+	repository := &insightRepositoryStub{cancellations: []applicationv2.WeekdayCount{{Weekday: time.Friday, Count: 3}}}
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/insights/overview", nil)
+
+	(&Server{insights: applicationv2.NewInsightService(repository)}).getInsightOverviewProto(context)
+
+	var response appointmentcontracts.GetInsightOverviewResponse
+	if err := protoJSONUnmarshal.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("response is not proto json: %v", err)
+	}
+	counts := response.GetCancellationDayOfWeek()
+	if len(counts) != 7 || counts[0].GetDayOfWeek() != "MONDAY" || counts[4].GetDayOfWeek() != "FRIDAY" || counts[4].GetCancellationCount() != 3 {
+		t.Fatalf("response = %s", recorder.Body.String())
+	}
+}
+
+type insightRepositoryStub struct {
+	ranking       []applicationv2.CustomerAppointmentCount
+	noShows       []applicationv2.CustomerNoShowCount
+	cancellations []applicationv2.WeekdayCount
+}
+
+func (r *insightRepositoryStub) RankCustomersByAppointments(_ context.Context, limit int, offset int) ([]applicationv2.CustomerAppointmentCount, error) {
+	end := min(offset+limit, len(r.ranking))
+	if offset >= end {
+		return nil, nil
+	}
+	return r.ranking[offset:end], nil
+}
+
+func (r *insightRepositoryStub) RankCustomersByCancellations(ctx context.Context, limit int, offset int) ([]applicationv2.CustomerAppointmentCount, error) {
+	return r.RankCustomersByAppointments(ctx, limit, offset)
+}
+
+func (r *insightRepositoryStub) RankCustomersByNoShows(_ context.Context, limit int, offset int) ([]applicationv2.CustomerNoShowCount, error) {
+	end := min(offset+limit, len(r.noShows))
+	if offset >= end {
+		return nil, nil
+	}
+	return r.noShows[offset:end], nil
+}
+
+func (r *insightRepositoryStub) CountCustomerCancellationsByWeekday(context.Context) ([]applicationv2.WeekdayCount, error) {
+	return r.cancellations, nil
+}
@@ -24,6 +24,7 @@ func New(handlers *HttpHandlers, log *zap.Logger) *gin.Engine {
 	r.Use(ginErrorLogger(log))
 	if handlers.Calendar != nil {
 		registerCalendarProtoRoutes(r, handlers.Calendar)
+		registerCalendarConnectHandlers(r, handlers.Calendar)
 	}
 	r.GET("/health", handlers.HealthChecker)
 	return r
@@ -57,15 +58,22 @@ func ginErrorLogger(log *zap.Logger) gin.HandlerFunc {
 }
 
 type Server struct {
-	reminders *applicationv2.AppointmentLifecycleService
-	calendar  *applicationv2.CalendarService
-	services  *application.ServiceService
-	log       *zap.Logger
+	reminders        *applicationv2.AppointmentLifecycleService
+	calendar         *applicationv2.CalendarService
+	calendars        *applicationv2.CalendarRegistry
+	feeds            *applicationv2.CalendarFeedService
+	timeBlockImports *applicationv2.TimeBlockImportService
+	services         *application.ServiceService
+	insights         *applicationv2.InsightService
+	availability     *applicationv2.AvailabilityService
+	waitlist         *applicationv2.WaitlistService
+	webhooks         *applicationv2.WebhookService
+	log              *zap.Logger
 }
 
-func NewServer(reminders *applicationv2.AppointmentLifecycleService, calendar *applicationv2.CalendarService, services *application.ServiceService, log *zap.Logger) *Server {
+func NewServer(reminders *applicationv2.AppointmentLifecycleService, calendar *applicationv2.CalendarService, calendars *applicationv2.CalendarRegistry, feeds *applicationv2.CalendarFeedService, timeBlockImports *applicationv2.TimeBlockImportService, services *application.ServiceService, insights *applicationv2.InsightService, availability *applicationv2.AvailabilityService, waitlist *applicationv2.WaitlistService, webhooks *applicationv2.WebhookService, log *zap.Logger) *Server {
 	if log == nil {
 		log = zap.NewNop()
 	}
-	return &Server{reminders: reminders, calendar: calendar, services: services, log: log}
+	return &Server{reminders: reminders, calendar: calendar, calendars: calendars, feeds: feeds, timeBlockImports: timeBlockImports, services: services, insights: insights, availability: availability, waitlist: waitlist, webhooks: webhooks, log: log}
 }
@@ -20,8 +20,29 @@ func TestNewRegistersOnlyCalendarV1Routes(t *testing.T) {
 	for _, path := range []string{
 		"/v1/calendar-events",
 		"/v1/calendar-events/:id",
+		"/v1/calendar-events:bulkChange",
 		"/v1/calendar-events/:calendar_event_id/reminder/resend",
+		"/v1/calendar-events/:id/notifications",
+		"/v1/calendar-events/:calendar_event_id/complete",
+		"/v1/calendar-events/:calendar_event_id/no-show",
+		"/v1/available-slots",
+		"/v1/calendars",
+		"/v1/calendars/:id/archive",
+		"/v1/calendars/:id/time-block-imports",
+		"/v1/calendar-feeds:link",
+		"/v1/calendar-feeds/calendars/:calendar_id/feed.ics",
+		"/v1/calendar-feeds/customers/:customer_id/feed.ics",
 		"/v1/services",
+		"/v1/insights/customer-ranking",
+		"/v1/insights/customer-no-show-ranking",
+		"/v1/insights/overview",
+		"/v1/waitlist-entries",
+		"/v1/waitlist-entries/:id",
+		"/v1/webhook-subscriptions",
+		"/v1/webhook-subscriptions/:id",
+		"/v1/webhook-subscriptions/:id/deliveries",
+		"/beaesthetic.appointment.v1.CalendarService/*procedure",
+		"/beaesthetic.appointment.v1.ServiceCatalogService/*procedure",
 	} {
 		if !hasRoute(engine, path) {
 			t.Errorf("route %s is not registered", path)
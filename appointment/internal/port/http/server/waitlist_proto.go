@@ -0,0 +1,126 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) createWaitlistEntryProto(ctx *gin.Context) {
+	var request appointmentcontracts.CreateWaitlistEntryRequest
+	if !s.readProtoJSON(ctx, &request) {
+		return
+	}
+	timeRange := request.GetTimeRange()
+	if timeRange.GetStartAt() == nil || timeRange.GetEndAt() == nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, "timeRange.startAt and timeRange.endAt are required")
+		return
+	}
+	services, err := s.serviceItemsFromProto(ctx.Request.Context(), request.GetServices())
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	entry, err := s.waitlist.AddEntry(ctx.Request.Context(), applicationv2.AddWaitlistEntryCommand{
+		CalendarID: request.GetCalendarId(),
+		CustomerID: request.GetCustomerId(),
+		Services:   services,
+		Start:      timeRange.GetStartAt().AsTime(),
+		End:        timeRange.GetEndAt().AsTime(),
+		Timezone:   timeRange.GetTimezone(),
+	})
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusCreated, &appointmentcontracts.CreateWaitlistEntryResponse{Entry: waitlistEntryProto(*entry)})
+}
+
+func (s *Server) listWaitlistEntriesProto(ctx *gin.Context) {
+	query, err := waitlistEntriesQueryFromProto(ctx)
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	entries, err := s.waitlist.ListEntries(ctx.Request.Context(), query)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	response := &appointmentcontracts.ListWaitlistEntriesResponse{Entries: make([]*appointmentcontracts.WaitlistEntry, 0, len(entries))}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, waitlistEntryProto(entry))
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) removeWaitlistEntryProto(ctx *gin.Context) {
+	if err := s.waitlist.RemoveEntry(ctx.Request.Context(), ctx.Param("id")); err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.RemoveWaitlistEntryResponse{})
+}
+
+func waitlistEntriesQueryFromProto(ctx *gin.Context) (applicationv2.ListWaitlistEntriesQuery, error) {
+	var query applicationv2.ListWaitlistEntriesQuery
+	for _, raw := range ctx.QueryArray("calendarIds") {
+		calendarID, err := domain.NormalizeCalendarID(raw)
+		if err != nil {
+			return query, err
+		}
+		query.CalendarIDs = append(query.CalendarIDs, calendarID)
+	}
+	if raw := strings.TrimSpace(ctx.Query("includeRemoved")); raw != "" {
+		includeRemoved, err := strconv.ParseBool(raw)
+		if err != nil {
+			return query, fmt.Errorf("invalid includeRemoved")
+		}
+		if includeRemoved {
+			query.Statuses = []domain.WaitlistEntryStatus{domain.WaitlistEntryWaiting, domain.WaitlistEntryOffered, domain.WaitlistEntryRemoved}
+		}
+	}
+	return query, nil
+}
+
+func waitlistEntryProto(entry domain.WaitlistEntry) *appointmentcontracts.WaitlistEntry {
+	out := &appointmentcontracts.WaitlistEntry{
+		Id:         entry.ID,
+		CalendarId: entry.CalendarID,
+		Customer:   &appointmentcontracts.CustomerRef{CustomerId: entry.Customer.ID, DisplayName: entry.Customer.DisplayName},
+		Services:   appointmentServiceItemsProto(entry.Services),
+		TimeRange:  timeRangeProto(entry.Window),
+		Status:     waitlistEntryStatusProto(entry.Status),
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+		UpdatedAt:  timestamppb.New(entry.UpdatedAt),
+	}
+	if offer := entry.Offer; offer != nil {
+		out.Offer = &appointmentcontracts.WaitlistOffer{
+			CalendarEventId: offer.CalendarEventID,
+			TimeRange:       timeRangeProto(domain.TimeRange{Start: offer.Start, End: offer.End, Timezone: entry.Window.Timezone}),
+			OfferedAt:       timestamppb.New(offer.OfferedAt),
+			ExpiresAt:       timestamppb.New(offer.ExpiresAt),
+		}
+	}
+	return out
+}
+
+func waitlistEntryStatusProto(status domain.WaitlistEntryStatus) appointmentcontracts.WaitlistEntryStatus {
+	switch status {
+	case domain.WaitlistEntryWaiting:
+		return appointmentcontracts.WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_WAITING
+	case domain.WaitlistEntryOffered:
+		return appointmentcontracts.WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_OFFERED
+	case domain.WaitlistEntryRemoved:
+		return appointmentcontracts.WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_REMOVED
+	default:
+		return appointmentcontracts.WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+)
+
+func TestWaitlistEntriesQueryListsRemovedEntriesOnlyWhenAsked(t *testing.T) {
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/waitlist-entries?calendarIds=AAAAAAAA-AAAA-AAAA-AAAA-AAAAAAAAAAAA", nil)
+
+	query, err := waitlistEntriesQueryFromProto(context)
+	if err != nil || len(query.CalendarIDs) != 1 || query.CalendarIDs[0] != roomCalendarID || len(query.Statuses) != 0 {
+		t.Fatalf("query = %#v, %v, want the service default statuses", query, err)
+	}
+
+	context, _ = gin.CreateTestContext(httptest.NewRecorder())
+	context.Request = httptest.NewRequest(http.MethodGet, "/v1/waitlist-entries?includeRemoved=true", nil)
+	query, err = waitlistEntriesQueryFromProto(context)
+	if err != nil || len(query.Statuses) != 3 {
+		t.Fatalf("query = %#v, %v, want every status", query, err)
+	}
+}
+
+func TestWaitlistEntryProtoIncludesTheOffer(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	entry := domain.WaitlistEntry{
+		ID:         "entry-1",
+		CalendarID: domain.DefaultCalendarID,
+		Customer:   domain.CustomerRef{ID: "customer-1", DisplayName: "Jane Doe"},
+		Window:     domain.TimeRange{Start: now, End: now.Add(4 * time.Hour), Timezone: "Europe/Rome"},
+		Status:     domain.WaitlistEntryOffered,
+		Offer:      &domain.WaitlistOffer{CalendarEventID: "event-1", Start: now.Add(time.Hour), End: now.Add(2 * time.Hour), OfferedAt: now, ExpiresAt: now.Add(2 * time.Hour)},
+	}
+
+	out := waitlistEntryProto(entry)
+	if out.GetStatus() != appointmentcontracts.WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_OFFERED || out.GetOffer().GetCalendarEventId() != "event-1" {
+		t.Fatalf("entry = %v", out)
+	}
+	if !out.GetOffer().GetTimeRange().GetStartAt().AsTime().Equal(now.Add(time.Hour)) || out.GetOffer().GetTimeRange().GetTimezone() != "Europe/Rome" {
+		t.Fatalf("offer = %v", out.GetOffer())
+	}
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	applicationv2 "github.com/petretiandrea/beaesthetic-backend/appointment/internal/application/v2"
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) createWebhookSubscriptionProto(ctx *gin.Context) {
+	var request appointmentcontracts.CreateWebhookSubscriptionRequest
+	if !s.readProtoJSON(ctx, &request) {
+		return
+	}
+	eventTypes, err := webhookEventTypesFromProto(request.GetEventTypes())
+	if err != nil {
+		s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	subscription, err := s.webhooks.CreateSubscription(ctx.Request.Context(), applicationv2.CreateWebhookSubscriptionCommand{
+		URL:        request.GetUrl(),
+		Secret:     request.GetSecret(),
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusCreated, &appointmentcontracts.CreateWebhookSubscriptionResponse{Subscription: webhookSubscriptionProto(*subscription)})
+}
+
+func (s *Server) listWebhookSubscriptionsProto(ctx *gin.Context) {
+	subscriptions, err := s.webhooks.ListSubscriptions(ctx.Request.Context())
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	response := &appointmentcontracts.ListWebhookSubscriptionsResponse{Subscriptions: make([]*appointmentcontracts.WebhookSubscription, 0, len(subscriptions))}
+	for _, subscription := range subscriptions {
+		response.Subscriptions = append(response.Subscriptions, webhookSubscriptionProto(subscription))
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func (s *Server) getWebhookSubscriptionProto(ctx *gin.Context) {
+	subscription, err := s.webhooks.GetSubscription(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.GetWebhookSubscriptionResponse{Subscription: webhookSubscriptionProto(*subscription)})
+}
+
+func (s *Server) updateWebhookSubscriptionProto(ctx *gin.Context) {
+	var request appointmentcontracts.UpdateWebhookSubscriptionRequest
+	if !s.readProtoJSON(ctx, &request) {
+		return
+	}
+	command := applicationv2.UpdateWebhookSubscriptionCommand{
+		SubscriptionID: ctx.Param("id"),
+		URL:            request.Url,
+		Secret:         request.Secret,
+		Enabled:        request.Enabled,
+	}
+	if filter := request.GetEventTypes(); filter != nil {
+		eventTypes, err := webhookEventTypesFromProto(filter.GetEventTypes())
+		if err != nil {
+			s.writeProtoError(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+		command.EventTypes = &eventTypes
+	}
+	subscription, err := s.webhooks.UpdateSubscription(ctx.Request.Context(), command)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.UpdateWebhookSubscriptionResponse{Subscription: webhookSubscriptionProto(*subscription)})
+}
+
+func (s *Server) deleteWebhookSubscriptionProto(ctx *gin.Context) {
+	if err := s.webhooks.DeleteSubscription(ctx.Request.Context(), ctx.Param("id")); err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, &appointmentcontracts.DeleteWebhookSubscriptionResponse{})
+}
+
+func (s *Server) listWebhookDeliveriesProto(ctx *gin.Context) {
+	limit := 0
+	if raw := strings.TrimSpace(ctx.Query("limit")); raw != "" {
+		value, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || value < 1 {
+			s.writeProtoError(ctx, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		limit = int(value)
+	}
+	deliveries, err := s.webhooks.ListDeliveries(ctx.Request.Context(), ctx.Param("id"), limit)
+	if err != nil {
+		s.writeCalendarError(ctx, err)
+		return
+	}
+	response := &appointmentcontracts.ListWebhookDeliveriesResponse{Deliveries: make([]*appointmentcontracts.WebhookDelivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, webhookDeliveryProto(delivery))
+	}
+	s.writeProtoJSON(ctx, http.StatusOK, response)
+}
+
+func webhookSubscriptionProto(subscription domain.WebhookSubscription) *appointmentcontracts.WebhookSubscription {
+	out := &appointmentcontracts.WebhookSubscription{
+		Id:        subscription.ID,
+		Url:       subscription.URL,
+		Enabled:   subscription.Enabled,
+		CreatedAt: timestamppb.New(subscription.CreatedAt),
+		UpdatedAt: timestamppb.New(subscription.UpdatedAt),
+	}
+	for _, eventType := range subscription.EventTypes {
+		out.EventTypes = append(out.EventTypes, lifecycleEventTypeProto(eventType))
+	}
+	return out
+}
+
+func webhookDeliveryProto(delivery domain.WebhookDelivery) *appointmentcontracts.WebhookDelivery {
+	out := &appointmentcontracts.WebhookDelivery{
+		Id:                 delivery.ID,
+		SubscriptionId:     delivery.SubscriptionID,
+		EventType:          lifecycleEventTypeProto(delivery.EventType),
+		CalendarEventId:    delivery.CalendarEventID,
+		Status:             webhookDeliveryStatusProto(delivery.Status),
+		Attempts:           int32(delivery.Attempts),
+		LastResponseStatus: int32(delivery.LastResponseStatus),
+		LastError:          delivery.LastError,
+		CreatedAt:          timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.LastAttemptAt != nil {
+		out.LastAttemptAt = timestamppb.New(*delivery.LastAttemptAt)
+	}
+	if delivery.DeliveredAt != nil {
+		out.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+	return out
+}
+
+func webhookDeliveryStatusProto(status domain.WebhookDeliveryStatus) appointmentcontracts.WebhookDeliveryStatus {
+	switch status {
+	case domain.WebhookDeliveryPending:
+		return appointmentcontracts.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case domain.WebhookDeliveryDelivered:
+		return appointmentcontracts.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED
+	case domain.WebhookDeliveryFailed:
+		return appointmentcontracts.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return appointmentcontracts.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+func lifecycleEventTypeProto(eventType string) appointmentcontracts.CalendarEventLifecycleType {
+	switch eventType {
+	case "CalendarEventCreated":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_CREATED
+	case "CalendarEventRescheduled":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_RESCHEDULED
+	case "CalendarEventCanceled":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_CANCELED
+	case "CalendarEventCompleted":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_COMPLETED
+	case "CalendarEventNoShow":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_NO_SHOW
+	case "CalendarEventRemindersChanged":
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_REMINDERS_CHANGED
+	default:
+		return appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_UNSPECIFIED
+	}
+}
+
+func webhookEventTypesFromProto(values []appointmentcontracts.CalendarEventLifecycleType) ([]string, error) {
+	eventTypes := make([]string, 0, len(values))
+	for _, value := range values {
+		switch value {
+		case appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_CREATED:
+			eventTypes = append(eventTypes, "CalendarEventCreated")
+		case appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_RESCHEDULED:
+			eventTypes = append(eventTypes, "CalendarEventRescheduled")
+		case appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_CANCELED:
+			eventTypes = append(eventTypes, "CalendarEventCanceled")
+		case appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_COMPLETED:
+			eventTypes = append(eventTypes, "CalendarEventCompleted")
+		case appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_NO_SHOW:
+			eventTypes = append(eventTypes, "CalendarEventNoShow")
+		case appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_REMINDERS_CHANGED:
+			eventTypes = append(eventTypes, "CalendarEventRemindersChanged")
+		default:
+			return nil, fmt.Errorf("invalid eventTypes value %s", value)
+		}
+	}
+	return eventTypes, nil
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	domain "github.com/petretiandrea/beaesthetic-backend/appointment/internal/domain/v2"
+	appointmentcontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/appointment"
+)
+
+func TestWebhookEventTypesRoundTripThroughTheLifecycleEnum(t *testing.T) {
+	for _, eventType := range domain.LifecycleEventTypes {
+		lifecycleType := lifecycleEventTypeProto(eventType)
+		if lifecycleType == appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_UNSPECIFIED {
+			t.Fatalf("%s has no lifecycle type", eventType)
+		}
+		got, err := webhookEventTypesFromProto([]appointmentcontracts.CalendarEventLifecycleType{lifecycleType})
+		if err != nil || len(got) != 1 || got[0] != eventType {
+			t.Fatalf("event types = %v, %v, want %s", got, err, eventType)
+		}
+	}
+	if _, err := webhookEventTypesFromProto([]appointmentcontracts.CalendarEventLifecycleType{appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_UNSPECIFIED}); err == nil {
+		t.Fatal("unspecified event type accepted")
+	}
+}
+
+func TestWebhookSubscriptionProtoOmitsTheSecret(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+	out := webhookSubscriptionProto(domain.WebhookSubscription{
+		ID:         "subscription-1",
+		URL:        "https://example.com/hooks",
+		Secret:     "0123456789abcdef",
+		EventTypes: []string{"CalendarEventCanceled"},
+		Enabled:    true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+	if out.GetUrl() != "https://example.com/hooks" || len(out.GetEventTypes()) != 1 || out.GetEventTypes()[0] != appointmentcontracts.CalendarEventLifecycleType_CALENDAR_EVENT_LIFECYCLE_TYPE_CANCELED {
+		t.Fatalf("subscription = %v", out)
+	}
+}
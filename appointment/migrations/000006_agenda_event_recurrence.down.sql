@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_agenda_events_recurring;
+
+ALTER TABLE agenda_events
+    DROP COLUMN IF EXISTS recurrence_end_at,
+    DROP COLUMN IF EXISTS recurrence_exception_dates,
+    DROP COLUMN IF EXISTS recurrence_rule;
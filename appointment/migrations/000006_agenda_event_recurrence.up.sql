@@ -0,0 +1,8 @@
+ALTER TABLE agenda_events
+    ADD COLUMN IF NOT EXISTS recurrence_rule TEXT NULL,
+    ADD COLUMN IF NOT EXISTS recurrence_exception_dates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS recurrence_end_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_agenda_events_recurring
+    ON agenda_events (calendar_id, start_at)
+    WHERE recurrence_rule IS NOT NULL;
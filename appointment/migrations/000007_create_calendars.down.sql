@@ -0,0 +1,4 @@
+ALTER TABLE agenda_events
+    DROP CONSTRAINT IF EXISTS agenda_events_calendar_id_fkey;
+
+DROP TABLE IF EXISTS calendars;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS calendars (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    color_hex TEXT NULL,
+    archived_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+INSERT INTO calendars (id, name, created_at, updated_at)
+VALUES ('d2a36e25-4824-4167-a062-a5af96f97703', 'Agenda', now(), now())
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE agenda_events
+    ADD CONSTRAINT agenda_events_calendar_id_fkey FOREIGN KEY (calendar_id) REFERENCES calendars(id);
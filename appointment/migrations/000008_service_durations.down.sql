@@ -0,0 +1,7 @@
+ALTER TABLE appointment_service_items
+    DROP COLUMN IF EXISTS buffer_seconds,
+    DROP COLUMN IF EXISTS duration_seconds;
+
+ALTER TABLE appointment_services
+    DROP COLUMN IF EXISTS buffer_seconds,
+    DROP COLUMN IF EXISTS duration_seconds;
@@ -0,0 +1,7 @@
+ALTER TABLE appointment_services
+    ADD COLUMN IF NOT EXISTS duration_seconds INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS buffer_seconds INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE appointment_service_items
+    ADD COLUMN IF NOT EXISTS duration_seconds INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS buffer_seconds INTEGER NOT NULL DEFAULT 0;
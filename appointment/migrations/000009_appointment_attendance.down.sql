@@ -0,0 +1,3 @@
+ALTER TABLE appointments
+    DROP COLUMN IF EXISTS attendance_recorded_at,
+    DROP COLUMN IF EXISTS attendance_status;
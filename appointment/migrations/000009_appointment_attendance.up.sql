@@ -0,0 +1,3 @@
+ALTER TABLE appointments
+    ADD COLUMN IF NOT EXISTS attendance_status TEXT NULL,
+    ADD COLUMN IF NOT EXISTS attendance_recorded_at TIMESTAMPTZ NULL;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id UUID PRIMARY KEY,
+    calendar_id UUID NOT NULL REFERENCES calendars(id),
+    customer_id UUID NOT NULL,
+    customer_display_name TEXT NOT NULL,
+    services JSONB NOT NULL DEFAULT '[]'::jsonb,
+    window_start_at TIMESTAMPTZ NOT NULL,
+    window_end_at TIMESTAMPTZ NOT NULL,
+    timezone TEXT NOT NULL,
+    status TEXT NOT NULL,
+    offer_calendar_event_id TEXT NULL,
+    offer_start_at TIMESTAMPTZ NULL,
+    offer_end_at TIMESTAMPTZ NULL,
+    offered_at TIMESTAMPTZ NULL,
+    offer_expires_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_calendar_status ON waitlist_entries (calendar_id, status, created_at);
@@ -0,0 +1,8 @@
+ALTER TABLE appointment_notifications
+    DROP COLUMN IF EXISTS reminder_position;
+DELETE FROM appointment_reminders WHERE position <> 0;
+ALTER TABLE appointment_reminders
+    DROP CONSTRAINT IF EXISTS appointment_reminders_pkey,
+    ADD PRIMARY KEY (agenda_event_id);
+ALTER TABLE appointment_reminders
+    DROP COLUMN IF EXISTS position;
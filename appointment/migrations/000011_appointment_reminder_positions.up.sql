@@ -0,0 +1,7 @@
+ALTER TABLE appointment_reminders
+    ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE appointment_reminders
+    DROP CONSTRAINT IF EXISTS appointment_reminders_pkey,
+    ADD PRIMARY KEY (agenda_event_id, position);
+ALTER TABLE appointment_notifications
+    ADD COLUMN IF NOT EXISTS reminder_position INTEGER NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE waitlist_entries
+    DROP COLUMN IF EXISTS offered_calendar_event_ids;
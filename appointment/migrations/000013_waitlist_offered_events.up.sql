@@ -0,0 +1,5 @@
+ALTER TABLE waitlist_entries
+    ADD COLUMN IF NOT EXISTS offered_calendar_event_ids TEXT[] NOT NULL DEFAULT '{}';
+UPDATE waitlist_entries
+SET offered_calendar_event_ids = ARRAY[offer_calendar_event_id]
+WHERE offer_calendar_event_id IS NOT NULL;
@@ -125,6 +125,116 @@ func (CalendarEventVisibility) EnumDescriptor() ([]byte, []int) {
 	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{1}
 }
 
+type AppointmentNotificationKind int32
+
+const (
+	AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_UNSPECIFIED  AppointmentNotificationKind = 0
+	AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_CONFIRMATION AppointmentNotificationKind = 1
+	AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_RESCHEDULED  AppointmentNotificationKind = 2
+	AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_REMINDER     AppointmentNotificationKind = 3
+	AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_CANCELED     AppointmentNotificationKind = 4
+)
+
+// Enum value maps for AppointmentNotificationKind.
+var (
+	AppointmentNotificationKind_name = map[int32]string{
+		0: "APPOINTMENT_NOTIFICATION_KIND_UNSPECIFIED",
+		1: "APPOINTMENT_NOTIFICATION_KIND_CONFIRMATION",
+		2: "APPOINTMENT_NOTIFICATION_KIND_RESCHEDULED",
+		3: "APPOINTMENT_NOTIFICATION_KIND_REMINDER",
+		4: "APPOINTMENT_NOTIFICATION_KIND_CANCELED",
+	}
+	AppointmentNotificationKind_value = map[string]int32{
+		"APPOINTMENT_NOTIFICATION_KIND_UNSPECIFIED":  0,
+		"APPOINTMENT_NOTIFICATION_KIND_CONFIRMATION": 1,
+		"APPOINTMENT_NOTIFICATION_KIND_RESCHEDULED":  2,
+		"APPOINTMENT_NOTIFICATION_KIND_REMINDER":     3,
+		"APPOINTMENT_NOTIFICATION_KIND_CANCELED":     4,
+	}
+)
+
+func (x AppointmentNotificationKind) Enum() *AppointmentNotificationKind {
+	p := new(AppointmentNotificationKind)
+	*p = x
+	return p
+}
+
+func (x AppointmentNotificationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentNotificationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[2].Descriptor()
+}
+
+func (AppointmentNotificationKind) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[2]
+}
+
+func (x AppointmentNotificationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentNotificationKind.Descriptor instead.
+func (AppointmentNotificationKind) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{2}
+}
+
+type AppointmentNotificationStatus int32
+
+const (
+	AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_UNSPECIFIED AppointmentNotificationStatus = 0
+	AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_PENDING     AppointmentNotificationStatus = 1
+	AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_SENT        AppointmentNotificationStatus = 2
+	AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_FAILED      AppointmentNotificationStatus = 3
+	AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_EXPIRED     AppointmentNotificationStatus = 4
+)
+
+// Enum value maps for AppointmentNotificationStatus.
+var (
+	AppointmentNotificationStatus_name = map[int32]string{
+		0: "APPOINTMENT_NOTIFICATION_STATUS_UNSPECIFIED",
+		1: "APPOINTMENT_NOTIFICATION_STATUS_PENDING",
+		2: "APPOINTMENT_NOTIFICATION_STATUS_SENT",
+		3: "APPOINTMENT_NOTIFICATION_STATUS_FAILED",
+		4: "APPOINTMENT_NOTIFICATION_STATUS_EXPIRED",
+	}
+	AppointmentNotificationStatus_value = map[string]int32{
+		"APPOINTMENT_NOTIFICATION_STATUS_UNSPECIFIED": 0,
+		"APPOINTMENT_NOTIFICATION_STATUS_PENDING":     1,
+		"APPOINTMENT_NOTIFICATION_STATUS_SENT":        2,
+		"APPOINTMENT_NOTIFICATION_STATUS_FAILED":      3,
+		"APPOINTMENT_NOTIFICATION_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x AppointmentNotificationStatus) Enum() *AppointmentNotificationStatus {
+	p := new(AppointmentNotificationStatus)
+	*p = x
+	return p
+}
+
+func (x AppointmentNotificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentNotificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[3].Descriptor()
+}
+
+func (AppointmentNotificationStatus) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[3]
+}
+
+func (x AppointmentNotificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentNotificationStatus.Descriptor instead.
+func (AppointmentNotificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{3}
+}
+
 type AppointmentReminderStatus int32
 
 const (
@@ -173,11 +283,11 @@ func (x AppointmentReminderStatus) String() string {
 }
 
 func (AppointmentReminderStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[2].Descriptor()
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[4].Descriptor()
 }
 
 func (AppointmentReminderStatus) Type() protoreflect.EnumType {
-	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[2]
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[4]
 }
 
 func (x AppointmentReminderStatus) Number() protoreflect.EnumNumber {
@@ -186,7 +296,7 @@ func (x AppointmentReminderStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AppointmentReminderStatus.Descriptor instead.
 func (AppointmentReminderStatus) EnumDescriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{2}
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{4}
 }
 
 type CancelReason int32
@@ -222,11 +332,11 @@ func (x CancelReason) String() string {
 }
 
 func (CancelReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[3].Descriptor()
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[5].Descriptor()
 }
 
 func (CancelReason) Type() protoreflect.EnumType {
-	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[3]
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[5]
 }
 
 func (x CancelReason) Number() protoreflect.EnumNumber {
@@ -235,7 +345,323 @@ func (x CancelReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CancelReason.Descriptor instead.
 func (CancelReason) EnumDescriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{3}
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{5}
+}
+
+// RecurrenceScope selects the occurrences of a recurring event an update or cancel applies to.
+// Unspecified behaves as RECURRENCE_SCOPE_ALL.
+type RecurrenceScope int32
+
+const (
+	RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED        RecurrenceScope = 0
+	RecurrenceScope_RECURRENCE_SCOPE_ALL                RecurrenceScope = 1
+	RecurrenceScope_RECURRENCE_SCOPE_THIS               RecurrenceScope = 2
+	RecurrenceScope_RECURRENCE_SCOPE_THIS_AND_FOLLOWING RecurrenceScope = 3
+)
+
+// Enum value maps for RecurrenceScope.
+var (
+	RecurrenceScope_name = map[int32]string{
+		0: "RECURRENCE_SCOPE_UNSPECIFIED",
+		1: "RECURRENCE_SCOPE_ALL",
+		2: "RECURRENCE_SCOPE_THIS",
+		3: "RECURRENCE_SCOPE_THIS_AND_FOLLOWING",
+	}
+	RecurrenceScope_value = map[string]int32{
+		"RECURRENCE_SCOPE_UNSPECIFIED":        0,
+		"RECURRENCE_SCOPE_ALL":                1,
+		"RECURRENCE_SCOPE_THIS":               2,
+		"RECURRENCE_SCOPE_THIS_AND_FOLLOWING": 3,
+	}
+)
+
+func (x RecurrenceScope) Enum() *RecurrenceScope {
+	p := new(RecurrenceScope)
+	*p = x
+	return p
+}
+
+func (x RecurrenceScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[6].Descriptor()
+}
+
+func (RecurrenceScope) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[6]
+}
+
+func (x RecurrenceScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceScope.Descriptor instead.
+func (RecurrenceScope) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{6}
+}
+
+type AppointmentAttendanceStatus int32
+
+const (
+	AppointmentAttendanceStatus_APPOINTMENT_ATTENDANCE_STATUS_UNSPECIFIED AppointmentAttendanceStatus = 0
+	AppointmentAttendanceStatus_APPOINTMENT_ATTENDANCE_STATUS_COMPLETED   AppointmentAttendanceStatus = 1
+	AppointmentAttendanceStatus_APPOINTMENT_ATTENDANCE_STATUS_NO_SHOW     AppointmentAttendanceStatus = 2
+)
+
+// Enum value maps for AppointmentAttendanceStatus.
+var (
+	AppointmentAttendanceStatus_name = map[int32]string{
+		0: "APPOINTMENT_ATTENDANCE_STATUS_UNSPECIFIED",
+		1: "APPOINTMENT_ATTENDANCE_STATUS_COMPLETED",
+		2: "APPOINTMENT_ATTENDANCE_STATUS_NO_SHOW",
+	}
+	AppointmentAttendanceStatus_value = map[string]int32{
+		"APPOINTMENT_ATTENDANCE_STATUS_UNSPECIFIED": 0,
+		"APPOINTMENT_ATTENDANCE_STATUS_COMPLETED":   1,
+		"APPOINTMENT_ATTENDANCE_STATUS_NO_SHOW":     2,
+	}
+)
+
+func (x AppointmentAttendanceStatus) Enum() *AppointmentAttendanceStatus {
+	p := new(AppointmentAttendanceStatus)
+	*p = x
+	return p
+}
+
+func (x AppointmentAttendanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppointmentAttendanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[7].Descriptor()
+}
+
+func (AppointmentAttendanceStatus) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[7]
+}
+
+func (x AppointmentAttendanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppointmentAttendanceStatus.Descriptor instead.
+func (AppointmentAttendanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{7}
+}
+
+// CalendarEventOrder sorts the listed events by start; unspecified means ascending.
+type CalendarEventOrder int32
+
+const (
+	CalendarEventOrder_CALENDAR_EVENT_ORDER_UNSPECIFIED CalendarEventOrder = 0
+	CalendarEventOrder_CALENDAR_EVENT_ORDER_START_ASC   CalendarEventOrder = 1
+	CalendarEventOrder_CALENDAR_EVENT_ORDER_START_DESC  CalendarEventOrder = 2
+)
+
+// Enum value maps for CalendarEventOrder.
+var (
+	CalendarEventOrder_name = map[int32]string{
+		0: "CALENDAR_EVENT_ORDER_UNSPECIFIED",
+		1: "CALENDAR_EVENT_ORDER_START_ASC",
+		2: "CALENDAR_EVENT_ORDER_START_DESC",
+	}
+	CalendarEventOrder_value = map[string]int32{
+		"CALENDAR_EVENT_ORDER_UNSPECIFIED": 0,
+		"CALENDAR_EVENT_ORDER_START_ASC":   1,
+		"CALENDAR_EVENT_ORDER_START_DESC":  2,
+	}
+)
+
+func (x CalendarEventOrder) Enum() *CalendarEventOrder {
+	p := new(CalendarEventOrder)
+	*p = x
+	return p
+}
+
+func (x CalendarEventOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalendarEventOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[8].Descriptor()
+}
+
+func (CalendarEventOrder) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[8]
+}
+
+func (x CalendarEventOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalendarEventOrder.Descriptor instead.
+func (CalendarEventOrder) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{8}
+}
+
+type BulkChangeStatus int32
+
+const (
+	BulkChangeStatus_BULK_CHANGE_STATUS_UNSPECIFIED BulkChangeStatus = 0
+	BulkChangeStatus_BULK_CHANGE_STATUS_CANCELED    BulkChangeStatus = 1
+	BulkChangeStatus_BULK_CHANGE_STATUS_SHIFTED     BulkChangeStatus = 2
+	BulkChangeStatus_BULK_CHANGE_STATUS_REJECTED    BulkChangeStatus = 3
+)
+
+// Enum value maps for BulkChangeStatus.
+var (
+	BulkChangeStatus_name = map[int32]string{
+		0: "BULK_CHANGE_STATUS_UNSPECIFIED",
+		1: "BULK_CHANGE_STATUS_CANCELED",
+		2: "BULK_CHANGE_STATUS_SHIFTED",
+		3: "BULK_CHANGE_STATUS_REJECTED",
+	}
+	BulkChangeStatus_value = map[string]int32{
+		"BULK_CHANGE_STATUS_UNSPECIFIED": 0,
+		"BULK_CHANGE_STATUS_CANCELED":    1,
+		"BULK_CHANGE_STATUS_SHIFTED":     2,
+		"BULK_CHANGE_STATUS_REJECTED":    3,
+	}
+)
+
+func (x BulkChangeStatus) Enum() *BulkChangeStatus {
+	p := new(BulkChangeStatus)
+	*p = x
+	return p
+}
+
+func (x BulkChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[9].Descriptor()
+}
+
+func (BulkChangeStatus) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[9]
+}
+
+func (x BulkChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkChangeStatus.Descriptor instead.
+func (BulkChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{9}
+}
+
+type TimeBlockImportStatus int32
+
+const (
+	TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_UNSPECIFIED TimeBlockImportStatus = 0
+	TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_CREATED     TimeBlockImportStatus = 1
+	TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_UPDATED     TimeBlockImportStatus = 2
+	TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_UNCHANGED   TimeBlockImportStatus = 3
+	TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_CANCELED    TimeBlockImportStatus = 4
+	// A canceled event that was never imported.
+	TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_SKIPPED TimeBlockImportStatus = 5
+)
+
+// Enum value maps for TimeBlockImportStatus.
+var (
+	TimeBlockImportStatus_name = map[int32]string{
+		0: "TIME_BLOCK_IMPORT_STATUS_UNSPECIFIED",
+		1: "TIME_BLOCK_IMPORT_STATUS_CREATED",
+		2: "TIME_BLOCK_IMPORT_STATUS_UPDATED",
+		3: "TIME_BLOCK_IMPORT_STATUS_UNCHANGED",
+		4: "TIME_BLOCK_IMPORT_STATUS_CANCELED",
+		5: "TIME_BLOCK_IMPORT_STATUS_SKIPPED",
+	}
+	TimeBlockImportStatus_value = map[string]int32{
+		"TIME_BLOCK_IMPORT_STATUS_UNSPECIFIED": 0,
+		"TIME_BLOCK_IMPORT_STATUS_CREATED":     1,
+		"TIME_BLOCK_IMPORT_STATUS_UPDATED":     2,
+		"TIME_BLOCK_IMPORT_STATUS_UNCHANGED":   3,
+		"TIME_BLOCK_IMPORT_STATUS_CANCELED":    4,
+		"TIME_BLOCK_IMPORT_STATUS_SKIPPED":     5,
+	}
+)
+
+func (x TimeBlockImportStatus) Enum() *TimeBlockImportStatus {
+	p := new(TimeBlockImportStatus)
+	*p = x
+	return p
+}
+
+func (x TimeBlockImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeBlockImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[10].Descriptor()
+}
+
+func (TimeBlockImportStatus) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[10]
+}
+
+func (x TimeBlockImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeBlockImportStatus.Descriptor instead.
+func (TimeBlockImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{10}
+}
+
+type WaitlistEntryStatus int32
+
+const (
+	WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_UNSPECIFIED WaitlistEntryStatus = 0
+	WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_WAITING     WaitlistEntryStatus = 1
+	WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_OFFERED     WaitlistEntryStatus = 2
+	WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_REMOVED     WaitlistEntryStatus = 3
+)
+
+// Enum value maps for WaitlistEntryStatus.
+var (
+	WaitlistEntryStatus_name = map[int32]string{
+		0: "WAITLIST_ENTRY_STATUS_UNSPECIFIED",
+		1: "WAITLIST_ENTRY_STATUS_WAITING",
+		2: "WAITLIST_ENTRY_STATUS_OFFERED",
+		3: "WAITLIST_ENTRY_STATUS_REMOVED",
+	}
+	WaitlistEntryStatus_value = map[string]int32{
+		"WAITLIST_ENTRY_STATUS_UNSPECIFIED": 0,
+		"WAITLIST_ENTRY_STATUS_WAITING":     1,
+		"WAITLIST_ENTRY_STATUS_OFFERED":     2,
+		"WAITLIST_ENTRY_STATUS_REMOVED":     3,
+	}
+)
+
+func (x WaitlistEntryStatus) Enum() *WaitlistEntryStatus {
+	p := new(WaitlistEntryStatus)
+	*p = x
+	return p
+}
+
+func (x WaitlistEntryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistEntryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[11].Descriptor()
+}
+
+func (WaitlistEntryStatus) Type() protoreflect.EnumType {
+	return &file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes[11]
+}
+
+func (x WaitlistEntryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistEntryStatus.Descriptor instead.
+func (WaitlistEntryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{11}
 }
 
 type TimeRange struct {
@@ -306,28 +732,32 @@ func (x *TimeRange) GetAllDay() bool {
 	return false
 }
 
-type CalendarEventCancellation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        CancelReason           `protobuf:"varint,1,opt,name=reason,proto3,enum=beaesthetic.appointment.v1.CancelReason" json:"reason,omitempty"`
-	CanceledAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CalendarEventConflict identifies an existing event that overlaps the written one.
+// Appointments conflict with appointments and time blocks; manual events never conflict.
+// Rejected writes return HTTP 409 with a JSON body {"message": ..., "conflicts": [CalendarEventConflict]}.
+type CalendarEventConflict struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	EventType       CalendarEventType      `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=beaesthetic.appointment.v1.CalendarEventType" json:"event_type,omitempty"`
+	TimeRange       *TimeRange             `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CalendarEventCancellation) Reset() {
-	*x = CalendarEventCancellation{}
+func (x *CalendarEventConflict) Reset() {
+	*x = CalendarEventConflict{}
 	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalendarEventCancellation) String() string {
+func (x *CalendarEventConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalendarEventCancellation) ProtoMessage() {}
+func (*CalendarEventConflict) ProtoMessage() {}
 
-func (x *CalendarEventCancellation) ProtoReflect() protoreflect.Message {
+func (x *CalendarEventConflict) ProtoReflect() protoreflect.Message {
 	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -339,38 +769,155 @@ func (x *CalendarEventCancellation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalendarEventCancellation.ProtoReflect.Descriptor instead.
-func (*CalendarEventCancellation) Descriptor() ([]byte, []int) {
+// Deprecated: Use CalendarEventConflict.ProtoReflect.Descriptor instead.
+func (*CalendarEventConflict) Descriptor() ([]byte, []int) {
 	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CalendarEventCancellation) GetReason() CancelReason {
+func (x *CalendarEventConflict) GetCalendarEventId() string {
 	if x != nil {
-		return x.Reason
+		return x.CalendarEventId
 	}
-	return CancelReason_CANCEL_REASON_UNSPECIFIED
+	return ""
 }
 
-func (x *CalendarEventCancellation) GetCanceledAt() *timestamppb.Timestamp {
+func (x *CalendarEventConflict) GetEventType() CalendarEventType {
 	if x != nil {
-		return x.CanceledAt
+		return x.EventType
+	}
+	return CalendarEventType_CALENDAR_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *CalendarEventConflict) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
 	}
 	return nil
 }
 
-type CalendarEvent struct {
-	state        protoimpl.MessageState     `protogen:"open.v1"`
-	Id           string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CalendarId   string                     `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
-	EventType    CalendarEventType          `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=beaesthetic.appointment.v1.CalendarEventType" json:"event_type,omitempty"`
-	TimeRange    *TimeRange                 `protobuf:"bytes,5,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	Version      int64                      `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	CreatedAt    *timestamppb.Timestamp     `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt    *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Cancellation *CalendarEventCancellation `protobuf:"bytes,10,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
-	Visibility   CalendarEventVisibility    `protobuf:"varint,11,opt,name=visibility,proto3,enum=beaesthetic.appointment.v1.CalendarEventVisibility" json:"visibility,omitempty"`
+// Recurrence repeats a manual event or time block starting from its time_range.
+// rule is an RFC 5545 RRULE restricted to FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT, UNTIL
+// and, for weekly rules, BYDAY without ordinals, e.g. "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10".
+// Occurrences follow the wall clock of time_range.timezone; exception_dates are the starts of removed occurrences.
+type Recurrence struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	Rule           string                   `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	ExceptionDates []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=exception_dates,json=exceptionDates,proto3" json:"exception_dates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Recurrence) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Recurrence) GetExceptionDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.ExceptionDates
+	}
+	return nil
+}
+
+type CalendarEventCancellation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        CancelReason           `protobuf:"varint,1,opt,name=reason,proto3,enum=beaesthetic.appointment.v1.CancelReason" json:"reason,omitempty"`
+	CanceledAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarEventCancellation) Reset() {
+	*x = CalendarEventCancellation{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarEventCancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEventCancellation) ProtoMessage() {}
+
+func (x *CalendarEventCancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEventCancellation.ProtoReflect.Descriptor instead.
+func (*CalendarEventCancellation) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CalendarEventCancellation) GetReason() CancelReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancelReason_CANCEL_REASON_UNSPECIFIED
+}
+
+func (x *CalendarEventCancellation) GetCanceledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
+type CalendarEvent struct {
+	state        protoimpl.MessageState     `protogen:"open.v1"`
+	Id           string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CalendarId   string                     `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	EventType    CalendarEventType          `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=beaesthetic.appointment.v1.CalendarEventType" json:"event_type,omitempty"`
+	TimeRange    *TimeRange                 `protobuf:"bytes,5,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Version      int64                      `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt    *timestamppb.Timestamp     `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Cancellation *CalendarEventCancellation `protobuf:"bytes,10,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	Visibility   CalendarEventVisibility    `protobuf:"varint,11,opt,name=visibility,proto3,enum=beaesthetic.appointment.v1.CalendarEventVisibility" json:"visibility,omitempty"`
 	Title        string                     `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
 	Description  string                     `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	// Set on recurring events. Listed occurrences carry the series id and recurrence with the occurrence time_range.
+	Recurrence *Recurrence `protobuf:"bytes,14,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
 	// Types that are valid to be assigned to Detail:
 	//
 	//	*CalendarEvent_Appointment
@@ -383,7 +930,7 @@ type CalendarEvent struct {
 
 func (x *CalendarEvent) Reset() {
 	*x = CalendarEvent{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[2]
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +942,7 @@ func (x *CalendarEvent) String() string {
 func (*CalendarEvent) ProtoMessage() {}
 
 func (x *CalendarEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[2]
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +955,7 @@ func (x *CalendarEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalendarEvent.ProtoReflect.Descriptor instead.
 func (*CalendarEvent) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{2}
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CalendarEvent) GetId() string {
@@ -488,6 +1035,13 @@ func (x *CalendarEvent) GetDescription() string {
 	return ""
 }
 
+func (x *CalendarEvent) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
 func (x *CalendarEvent) GetDetail() isCalendarEvent_Detail {
 	if x != nil {
 		return x.Detail
@@ -547,17 +1101,22 @@ func (*CalendarEvent_TimeBlock) isCalendarEvent_Detail() {}
 // AppointmentDetail is returned only when event_type is CALENDAR_EVENT_TYPE_APPOINTMENT.
 // The appointment identity is the owning calendar event id.
 type AppointmentDetail struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Customer      *CustomerRef              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
-	Services      []*AppointmentServiceItem `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
-	Reminder      *AppointmentReminder      `protobuf:"bytes,3,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	state    protoimpl.MessageState    `protogen:"open.v1"`
+	Customer *CustomerRef              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Services []*AppointmentServiceItem `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	// Unset until the outcome of the appointment is recorded.
+	Attendance *AppointmentAttendance `protobuf:"bytes,4,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	// Ordered from the earliest to the latest send time.
+	Reminders []*AppointmentReminder `protobuf:"bytes,5,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	// Outcome of the notifications sent to the customer; ListCalendarEventNotifications returns them in full.
+	Notifications *AppointmentNotificationSummary `protobuf:"bytes,6,opt,name=notifications,proto3" json:"notifications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AppointmentDetail) Reset() {
 	*x = AppointmentDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[3]
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +1128,7 @@ func (x *AppointmentDetail) String() string {
 func (*AppointmentDetail) ProtoMessage() {}
 
 func (x *AppointmentDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[3]
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +1141,7 @@ func (x *AppointmentDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppointmentDetail.ProtoReflect.Descriptor instead.
 func (*AppointmentDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{3}
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AppointmentDetail) GetCustomer() *CustomerRef {
@@ -599,89 +1158,54 @@ func (x *AppointmentDetail) GetServices() []*AppointmentServiceItem {
 	return nil
 }
 
-func (x *AppointmentDetail) GetReminder() *AppointmentReminder {
+func (x *AppointmentDetail) GetAttendance() *AppointmentAttendance {
 	if x != nil {
-		return x.Reminder
+		return x.Attendance
 	}
 	return nil
 }
 
-type CustomerRef struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CustomerRef) Reset() {
-	*x = CustomerRef{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CustomerRef) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CustomerRef) ProtoMessage() {}
-
-func (x *CustomerRef) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CustomerRef.ProtoReflect.Descriptor instead.
-func (*CustomerRef) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *CustomerRef) GetCustomerId() string {
+func (x *AppointmentDetail) GetReminders() []*AppointmentReminder {
 	if x != nil {
-		return x.CustomerId
+		return x.Reminders
 	}
-	return ""
+	return nil
 }
 
-func (x *CustomerRef) GetDisplayName() string {
+func (x *AppointmentDetail) GetNotifications() *AppointmentNotificationSummary {
 	if x != nil {
-		return x.DisplayName
+		return x.Notifications
 	}
-	return ""
+	return nil
 }
 
-type AppointmentServiceItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+type AppointmentNotificationSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Pending int32                  `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Sent    int32                  `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	Failed  int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Expired int32                  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	// The most recently requested notification; unset when none was sent.
+	Latest        *AppointmentNotification `protobuf:"bytes,5,opt,name=latest,proto3" json:"latest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppointmentServiceItem) Reset() {
-	*x = AppointmentServiceItem{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[5]
+func (x *AppointmentNotificationSummary) Reset() {
+	*x = AppointmentNotificationSummary{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppointmentServiceItem) String() string {
+func (x *AppointmentNotificationSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppointmentServiceItem) ProtoMessage() {}
+func (*AppointmentNotificationSummary) ProtoMessage() {}
 
-func (x *AppointmentServiceItem) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[5]
+func (x *AppointmentNotificationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,60 +1216,80 @@ func (x *AppointmentServiceItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppointmentServiceItem.ProtoReflect.Descriptor instead.
-func (*AppointmentServiceItem) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AppointmentNotificationSummary.ProtoReflect.Descriptor instead.
+func (*AppointmentNotificationSummary) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AppointmentServiceItem) GetServiceId() string {
+func (x *AppointmentNotificationSummary) GetPending() int32 {
 	if x != nil {
-		return x.ServiceId
+		return x.Pending
 	}
-	return ""
+	return 0
 }
 
-func (x *AppointmentServiceItem) GetServiceName() string {
+func (x *AppointmentNotificationSummary) GetSent() int32 {
 	if x != nil {
-		return x.ServiceName
+		return x.Sent
 	}
-	return ""
+	return 0
 }
 
-func (x *AppointmentServiceItem) GetPosition() int32 {
+func (x *AppointmentNotificationSummary) GetFailed() int32 {
 	if x != nil {
-		return x.Position
+		return x.Failed
 	}
 	return 0
 }
 
-type AppointmentReminder struct {
-	state               protoimpl.MessageState    `protogen:"open.v1"`
-	Status              AppointmentReminderStatus `protobuf:"varint,1,opt,name=status,proto3,enum=beaesthetic.appointment.v1.AppointmentReminderStatus" json:"status,omitempty"`
-	RemindBeforeSeconds int32                     `protobuf:"varint,2,opt,name=remind_before_seconds,json=remindBeforeSeconds,proto3" json:"remind_before_seconds,omitempty"`
-	ScheduledAt         *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
-	SentRequestedAt     *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=sent_requested_at,json=sentRequestedAt,proto3" json:"sent_requested_at,omitempty"`
-	SentAt              *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
-	FailedAt            *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
-	FailureReason       string                    `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *AppointmentNotificationSummary) GetExpired() int32 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
 }
 
-func (x *AppointmentReminder) Reset() {
-	*x = AppointmentReminder{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[6]
+func (x *AppointmentNotificationSummary) GetLatest() *AppointmentNotification {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+// AppointmentNotification is a notification requested for the customer of an appointment
+// and the outcome reported by the notification service.
+type AppointmentNotification struct {
+	state          protoimpl.MessageState        `protogen:"open.v1"`
+	CorrelationKey string                        `protobuf:"bytes,1,opt,name=correlation_key,json=correlationKey,proto3" json:"correlation_key,omitempty"`
+	Kind           AppointmentNotificationKind   `protobuf:"varint,2,opt,name=kind,proto3,enum=beaesthetic.appointment.v1.AppointmentNotificationKind" json:"kind,omitempty"`
+	Status         AppointmentNotificationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=beaesthetic.appointment.v1.AppointmentNotificationStatus" json:"status,omitempty"`
+	// Position of the reminder, set only for reminder notifications.
+	ReminderPosition *int32                 `protobuf:"varint,4,opt,name=reminder_position,json=reminderPosition,proto3,oneof" json:"reminder_position,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once the outcome arrives or the notification expires.
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	FailureReason  string                 `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	FailureMessage string                 `protobuf:"bytes,9,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AppointmentNotification) Reset() {
+	*x = AppointmentNotification{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppointmentReminder) String() string {
+func (x *AppointmentNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppointmentReminder) ProtoMessage() {}
+func (*AppointmentNotification) ProtoMessage() {}
 
-func (x *AppointmentReminder) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[6]
+func (x *AppointmentNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,84 +1300,2480 @@ func (x *AppointmentReminder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppointmentReminder.ProtoReflect.Descriptor instead.
-func (*AppointmentReminder) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AppointmentNotification.ProtoReflect.Descriptor instead.
+func (*AppointmentNotification) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AppointmentReminder) GetStatus() AppointmentReminderStatus {
+func (x *AppointmentNotification) GetCorrelationKey() string {
 	if x != nil {
-		return x.Status
+		return x.CorrelationKey
 	}
-	return AppointmentReminderStatus_APPOINTMENT_REMINDER_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *AppointmentReminder) GetRemindBeforeSeconds() int32 {
+func (x *AppointmentNotification) GetKind() AppointmentNotificationKind {
 	if x != nil {
-		return x.RemindBeforeSeconds
+		return x.Kind
 	}
-	return 0
+	return AppointmentNotificationKind_APPOINTMENT_NOTIFICATION_KIND_UNSPECIFIED
 }
 
-func (x *AppointmentReminder) GetScheduledAt() *timestamppb.Timestamp {
+func (x *AppointmentNotification) GetStatus() AppointmentNotificationStatus {
 	if x != nil {
-		return x.ScheduledAt
+		return x.Status
 	}
-	return nil
+	return AppointmentNotificationStatus_APPOINTMENT_NOTIFICATION_STATUS_UNSPECIFIED
 }
 
-func (x *AppointmentReminder) GetSentRequestedAt() *timestamppb.Timestamp {
+func (x *AppointmentNotification) GetReminderPosition() int32 {
+	if x != nil && x.ReminderPosition != nil {
+		return *x.ReminderPosition
+	}
+	return 0
+}
+
+func (x *AppointmentNotification) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SentRequestedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *AppointmentReminder) GetSentAt() *timestamppb.Timestamp {
+func (x *AppointmentNotification) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SentAt
+		return x.CompletedAt
 	}
 	return nil
 }
 
-func (x *AppointmentReminder) GetFailedAt() *timestamppb.Timestamp {
+func (x *AppointmentNotification) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FailedAt
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *AppointmentReminder) GetFailureReason() string {
+func (x *AppointmentNotification) GetFailureReason() string {
 	if x != nil {
 		return x.FailureReason
 	}
 	return ""
 }
 
-type ManualEventDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+func (x *AppointmentNotification) GetFailureMessage() string {
+	if x != nil {
+		return x.FailureMessage
+	}
+	return ""
+}
+
+type AppointmentAttendance struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Status        AppointmentAttendanceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=beaesthetic.appointment.v1.AppointmentAttendanceStatus" json:"status,omitempty"`
+	RecordedAt    *timestamppb.Timestamp      `protobuf:"bytes,2,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ManualEventDetail) Reset() {
-	*x = ManualEventDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[7]
+func (x *AppointmentAttendance) Reset() {
+	*x = AppointmentAttendance{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ManualEventDetail) String() string {
+func (x *AppointmentAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentAttendance) ProtoMessage() {}
+
+func (x *AppointmentAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentAttendance.ProtoReflect.Descriptor instead.
+func (*AppointmentAttendance) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AppointmentAttendance) GetStatus() AppointmentAttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AppointmentAttendanceStatus_APPOINTMENT_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AppointmentAttendance) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+type CustomerRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerRef) Reset() {
+	*x = CustomerRef{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerRef) ProtoMessage() {}
+
+func (x *CustomerRef) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerRef.ProtoReflect.Descriptor instead.
+func (*CustomerRef) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CustomerRef) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CustomerRef) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type AppointmentServiceItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId   string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Position    int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// Catalog duration and buffer at booking time; zero for custom services.
+	DurationMinutes int32 `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	BufferMinutes   int32 `protobuf:"varint,6,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AppointmentServiceItem) Reset() {
+	*x = AppointmentServiceItem{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentServiceItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentServiceItem) ProtoMessage() {}
+
+func (x *AppointmentServiceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentServiceItem.ProtoReflect.Descriptor instead.
+func (*AppointmentServiceItem) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AppointmentServiceItem) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AppointmentServiceItem) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *AppointmentServiceItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *AppointmentServiceItem) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *AppointmentServiceItem) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+type AppointmentReminder struct {
+	state               protoimpl.MessageState    `protogen:"open.v1"`
+	Status              AppointmentReminderStatus `protobuf:"varint,1,opt,name=status,proto3,enum=beaesthetic.appointment.v1.AppointmentReminderStatus" json:"status,omitempty"`
+	RemindBeforeSeconds int32                     `protobuf:"varint,2,opt,name=remind_before_seconds,json=remindBeforeSeconds,proto3" json:"remind_before_seconds,omitempty"`
+	ScheduledAt         *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	SentRequestedAt     *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=sent_requested_at,json=sentRequestedAt,proto3" json:"sent_requested_at,omitempty"`
+	SentAt              *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	FailedAt            *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	FailureReason       string                    `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Identifies the reminder within its appointment.
+	Position      int32 `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppointmentReminder) Reset() {
+	*x = AppointmentReminder{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentReminder) ProtoMessage() {}
+
+func (x *AppointmentReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentReminder.ProtoReflect.Descriptor instead.
+func (*AppointmentReminder) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AppointmentReminder) GetStatus() AppointmentReminderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AppointmentReminderStatus_APPOINTMENT_REMINDER_STATUS_UNSPECIFIED
+}
+
+func (x *AppointmentReminder) GetRemindBeforeSeconds() int32 {
+	if x != nil {
+		return x.RemindBeforeSeconds
+	}
+	return 0
+}
+
+func (x *AppointmentReminder) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *AppointmentReminder) GetSentRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentRequestedAt
+	}
+	return nil
+}
+
+func (x *AppointmentReminder) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *AppointmentReminder) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *AppointmentReminder) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *AppointmentReminder) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ManualEventDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManualEventDetail) Reset() {
+	*x = ManualEventDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManualEventDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*ManualEventDetail) ProtoMessage() {}
 
-func (x *ManualEventDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[7]
+func (x *ManualEventDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualEventDetail.ProtoReflect.Descriptor instead.
+func (*ManualEventDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ManualEventDetail) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ManualEventDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ManualEventDetail) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type TimeBlockDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeBlockDetail) Reset() {
+	*x = TimeBlockDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeBlockDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeBlockDetail) ProtoMessage() {}
+
+func (x *TimeBlockDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeBlockDetail.ProtoReflect.Descriptor instead.
+func (*TimeBlockDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TimeBlockDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateCalendarEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; omitted values use the service default calendar.
+	CalendarId string `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// Appointments may omit end_at: it is computed from the durations and buffers of the selected catalog services.
+	TimeRange   *TimeRange              `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Visibility  CalendarEventVisibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=beaesthetic.appointment.v1.CalendarEventVisibility" json:"visibility,omitempty"`
+	Title       string                  `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                  `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Supported only by manual events and time blocks; time_range is the first occurrence.
+	Recurrence *Recurrence `protobuf:"bytes,7,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Types that are valid to be assigned to Detail:
+	//
+	//	*CreateCalendarEventRequest_Appointment
+	//	*CreateCalendarEventRequest_ManualEvent
+	//	*CreateCalendarEventRequest_TimeBlock
+	Detail        isCreateCalendarEventRequest_Detail `protobuf_oneof:"detail"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarEventRequest) Reset() {
+	*x = CreateCalendarEventRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarEventRequest) ProtoMessage() {}
+
+func (x *CreateCalendarEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarEventRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateCalendarEventRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *CreateCalendarEventRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *CreateCalendarEventRequest) GetVisibility() CalendarEventVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return CalendarEventVisibility_CALENDAR_EVENT_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateCalendarEventRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateCalendarEventRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCalendarEventRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *CreateCalendarEventRequest) GetDetail() isCreateCalendarEventRequest_Detail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+func (x *CreateCalendarEventRequest) GetAppointment() *CreateAppointmentDetail {
+	if x != nil {
+		if x, ok := x.Detail.(*CreateCalendarEventRequest_Appointment); ok {
+			return x.Appointment
+		}
+	}
+	return nil
+}
+
+func (x *CreateCalendarEventRequest) GetManualEvent() *CreateManualEventDetail {
+	if x != nil {
+		if x, ok := x.Detail.(*CreateCalendarEventRequest_ManualEvent); ok {
+			return x.ManualEvent
+		}
+	}
+	return nil
+}
+
+func (x *CreateCalendarEventRequest) GetTimeBlock() *CreateTimeBlockDetail {
+	if x != nil {
+		if x, ok := x.Detail.(*CreateCalendarEventRequest_TimeBlock); ok {
+			return x.TimeBlock
+		}
+	}
+	return nil
+}
+
+type isCreateCalendarEventRequest_Detail interface {
+	isCreateCalendarEventRequest_Detail()
+}
+
+type CreateCalendarEventRequest_Appointment struct {
+	Appointment *CreateAppointmentDetail `protobuf:"bytes,20,opt,name=appointment,proto3,oneof"`
+}
+
+type CreateCalendarEventRequest_ManualEvent struct {
+	ManualEvent *CreateManualEventDetail `protobuf:"bytes,21,opt,name=manual_event,json=manualEvent,proto3,oneof"`
+}
+
+type CreateCalendarEventRequest_TimeBlock struct {
+	TimeBlock *CreateTimeBlockDetail `protobuf:"bytes,22,opt,name=time_block,json=timeBlock,proto3,oneof"`
+}
+
+func (*CreateCalendarEventRequest_Appointment) isCreateCalendarEventRequest_Detail() {}
+
+func (*CreateCalendarEventRequest_ManualEvent) isCreateCalendarEventRequest_Detail() {}
+
+func (*CreateCalendarEventRequest_TimeBlock) isCreateCalendarEventRequest_Detail() {}
+
+type CreateAppointmentDetail struct {
+	state      protoimpl.MessageState         `protogen:"open.v1"`
+	CustomerId string                         `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Services   []*AppointmentServiceSelection `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	// Lead time of the single reminder, within the bounds configured server-side; 0 books the
+	// appointment without reminders and unset applies the configured reminders.
+	RemindBeforeSeconds *int32 `protobuf:"varint,3,opt,name=remind_before_seconds,json=remindBeforeSeconds,proto3,oneof" json:"remind_before_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateAppointmentDetail) Reset() {
+	*x = CreateAppointmentDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppointmentDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppointmentDetail) ProtoMessage() {}
+
+func (x *CreateAppointmentDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppointmentDetail.ProtoReflect.Descriptor instead.
+func (*CreateAppointmentDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateAppointmentDetail) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentDetail) GetServices() []*AppointmentServiceSelection {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *CreateAppointmentDetail) GetRemindBeforeSeconds() int32 {
+	if x != nil && x.RemindBeforeSeconds != nil {
+		return *x.RemindBeforeSeconds
+	}
+	return 0
+}
+
+type AppointmentServiceSelection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*AppointmentServiceSelection_CatalogServiceId
+	//	*AppointmentServiceSelection_CustomServiceName
+	Value         isAppointmentServiceSelection_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppointmentServiceSelection) Reset() {
+	*x = AppointmentServiceSelection{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentServiceSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentServiceSelection) ProtoMessage() {}
+
+func (x *AppointmentServiceSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentServiceSelection.ProtoReflect.Descriptor instead.
+func (*AppointmentServiceSelection) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AppointmentServiceSelection) GetValue() isAppointmentServiceSelection_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *AppointmentServiceSelection) GetCatalogServiceId() string {
+	if x != nil {
+		if x, ok := x.Value.(*AppointmentServiceSelection_CatalogServiceId); ok {
+			return x.CatalogServiceId
+		}
+	}
+	return ""
+}
+
+func (x *AppointmentServiceSelection) GetCustomServiceName() string {
+	if x != nil {
+		if x, ok := x.Value.(*AppointmentServiceSelection_CustomServiceName); ok {
+			return x.CustomServiceName
+		}
+	}
+	return ""
+}
+
+type isAppointmentServiceSelection_Value interface {
+	isAppointmentServiceSelection_Value()
+}
+
+type AppointmentServiceSelection_CatalogServiceId struct {
+	CatalogServiceId string `protobuf:"bytes,1,opt,name=catalog_service_id,json=catalogServiceId,proto3,oneof"`
+}
+
+type AppointmentServiceSelection_CustomServiceName struct {
+	CustomServiceName string `protobuf:"bytes,2,opt,name=custom_service_name,json=customServiceName,proto3,oneof"`
+}
+
+func (*AppointmentServiceSelection_CatalogServiceId) isAppointmentServiceSelection_Value() {}
+
+func (*AppointmentServiceSelection_CustomServiceName) isAppointmentServiceSelection_Value() {}
+
+type CreateManualEventDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManualEventDetail) Reset() {
+	*x = CreateManualEventDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManualEventDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManualEventDetail) ProtoMessage() {}
+
+func (x *CreateManualEventDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManualEventDetail.ProtoReflect.Descriptor instead.
+func (*CreateManualEventDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateManualEventDetail) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateManualEventDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateManualEventDetail) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type CreateTimeBlockDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeBlockDetail) Reset() {
+	*x = CreateTimeBlockDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeBlockDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeBlockDetail) ProtoMessage() {}
+
+func (x *CreateTimeBlockDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeBlockDetail.ProtoReflect.Descriptor instead.
+func (*CreateTimeBlockDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateTimeBlockDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateCalendarEventResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	// Set only when the server conflict policy accepts overlapping events with a warning.
+	Conflicts     []*CalendarEventConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarEventResponse) Reset() {
+	*x = CreateCalendarEventResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarEventResponse) ProtoMessage() {}
+
+func (x *CreateCalendarEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarEventResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateCalendarEventResponse) GetCalendarEventId() string {
+	if x != nil {
+		return x.CalendarEventId
+	}
+	return ""
+}
+
+func (x *CreateCalendarEventResponse) GetConflicts() []*CalendarEventConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type GetCalendarEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarEventRequest) Reset() {
+	*x = GetCalendarEventRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarEventRequest) ProtoMessage() {}
+
+func (x *GetCalendarEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarEventRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarEventRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCalendarEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCalendarEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarEventResponse) Reset() {
+	*x = GetCalendarEventResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarEventResponse) ProtoMessage() {}
+
+func (x *GetCalendarEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarEventResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarEventResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCalendarEventResponse) GetEvent() *CalendarEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type UpdateCalendarEventResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Set only when the server conflict policy accepts overlapping events with a warning.
+	Conflicts     []*CalendarEventConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCalendarEventResponse) Reset() {
+	*x = UpdateCalendarEventResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCalendarEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCalendarEventResponse) ProtoMessage() {}
+
+func (x *UpdateCalendarEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCalendarEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarEventResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCalendarEventResponse) GetEvent() *CalendarEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventResponse) GetConflicts() []*CalendarEventConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// When start_at and end_at are set, recurring events are expanded into their occurrences inside the window.
+type ListCalendarEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: use calendar_ids. When both are omitted the default calendar is listed.
+	CalendarId string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	CustomerId string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	EventTypes []CalendarEventType    `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=beaesthetic.appointment.v1.CalendarEventType" json:"event_types,omitempty"`
+	// Lists the events of all the given calendars together with calendar_id.
+	CalendarIds []string `protobuf:"bytes,6,rep,name=calendar_ids,json=calendarIds,proto3" json:"calendar_ids,omitempty"`
+	// Maximum number of stored events per page, at most 500. When omitted every matching event is returned.
+	// A recurring event counts once and returns all its occurrences inside the time window.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque cursor from a previous next_page_token; the other fields must not change between pages.
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Case-insensitive text matched against title, description, customer display name and service names.
+	Query         string             `protobuf:"bytes,9,opt,name=query,proto3" json:"query,omitempty"`
+	Order         CalendarEventOrder `protobuf:"varint,10,opt,name=order,proto3,enum=beaesthetic.appointment.v1.CalendarEventOrder" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarEventsRequest) Reset() {
+	*x = ListCalendarEventsRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarEventsRequest) ProtoMessage() {}
+
+func (x *ListCalendarEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarEventsRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListCalendarEventsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *ListCalendarEventsRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ListCalendarEventsRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *ListCalendarEventsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListCalendarEventsRequest) GetEventTypes() []CalendarEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *ListCalendarEventsRequest) GetCalendarIds() []string {
+	if x != nil {
+		return x.CalendarIds
+	}
+	return nil
+}
+
+func (x *ListCalendarEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCalendarEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCalendarEventsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListCalendarEventsRequest) GetOrder() CalendarEventOrder {
+	if x != nil {
+		return x.Order
+	}
+	return CalendarEventOrder_CALENDAR_EVENT_ORDER_UNSPECIFIED
+}
+
+type ListCalendarEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*CalendarEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Set while more events are available; pass it as page_token to read the following page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarEventsResponse) Reset() {
+	*x = ListCalendarEventsResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarEventsResponse) ProtoMessage() {}
+
+func (x *ListCalendarEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarEventsResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListCalendarEventsResponse) GetEvents() []*CalendarEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListCalendarEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateCalendarEvent updates only mutable fields of an existing calendar event.
+// Immutable fields such as calendar_id, event_type, created_at and cancellation cannot be changed here.
+// The selected detail must match the existing event_type; this request never converts an event to another type.
+// Supported update_mask paths:
+// - time_range
+// - title
+// - description
+// - visibility
+// - recurrence (an empty rule makes the event a one-off event)
+// - appointment.services
+// - appointment.remind_before (replaces the reminders and reschedules them)
+// - manual_event.title
+// - manual_event.description
+// - manual_event.location
+// - time_block.reason
+type UpdateCalendarEventRequest struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimeRange   *TimeRange              `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	UpdateMask  *fieldmaskpb.FieldMask  `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Visibility  CalendarEventVisibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=beaesthetic.appointment.v1.CalendarEventVisibility" json:"visibility,omitempty"`
+	Title       *string                 `protobuf:"bytes,6,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                 `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// When set, the update is rejected with a conflict unless it equals the current CalendarEvent.version.
+	ExpectedVersion *int64      `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	Recurrence      *Recurrence `protobuf:"bytes,9,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Start of the occurrence to change, required when scope is THIS or THIS_AND_FOLLOWING.
+	// Those scopes detach the occurrences into a new event, which is returned.
+	OccurrenceStartAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=occurrence_start_at,json=occurrenceStartAt,proto3" json:"occurrence_start_at,omitempty"`
+	Scope             RecurrenceScope        `protobuf:"varint,11,opt,name=scope,proto3,enum=beaesthetic.appointment.v1.RecurrenceScope" json:"scope,omitempty"`
+	// Types that are valid to be assigned to Detail:
+	//
+	//	*UpdateCalendarEventRequest_Appointment
+	//	*UpdateCalendarEventRequest_ManualEvent
+	//	*UpdateCalendarEventRequest_TimeBlock
+	Detail        isUpdateCalendarEventRequest_Detail `protobuf_oneof:"detail"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCalendarEventRequest) Reset() {
+	*x = UpdateCalendarEventRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCalendarEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCalendarEventRequest) ProtoMessage() {}
+
+func (x *UpdateCalendarEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCalendarEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarEventRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateCalendarEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCalendarEventRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventRequest) GetVisibility() CalendarEventVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return CalendarEventVisibility_CALENDAR_EVENT_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdateCalendarEventRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateCalendarEventRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCalendarEventRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateCalendarEventRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventRequest) GetOccurrenceStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceStartAt
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+func (x *UpdateCalendarEventRequest) GetDetail() isUpdateCalendarEventRequest_Detail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventRequest) GetAppointment() *UpdateAppointmentDetail {
+	if x != nil {
+		if x, ok := x.Detail.(*UpdateCalendarEventRequest_Appointment); ok {
+			return x.Appointment
+		}
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventRequest) GetManualEvent() *UpdateManualEventDetail {
+	if x != nil {
+		if x, ok := x.Detail.(*UpdateCalendarEventRequest_ManualEvent); ok {
+			return x.ManualEvent
+		}
+	}
+	return nil
+}
+
+func (x *UpdateCalendarEventRequest) GetTimeBlock() *UpdateTimeBlockDetail {
+	if x != nil {
+		if x, ok := x.Detail.(*UpdateCalendarEventRequest_TimeBlock); ok {
+			return x.TimeBlock
+		}
+	}
+	return nil
+}
+
+type isUpdateCalendarEventRequest_Detail interface {
+	isUpdateCalendarEventRequest_Detail()
+}
+
+type UpdateCalendarEventRequest_Appointment struct {
+	Appointment *UpdateAppointmentDetail `protobuf:"bytes,20,opt,name=appointment,proto3,oneof"`
+}
+
+type UpdateCalendarEventRequest_ManualEvent struct {
+	ManualEvent *UpdateManualEventDetail `protobuf:"bytes,21,opt,name=manual_event,json=manualEvent,proto3,oneof"`
+}
+
+type UpdateCalendarEventRequest_TimeBlock struct {
+	TimeBlock *UpdateTimeBlockDetail `protobuf:"bytes,22,opt,name=time_block,json=timeBlock,proto3,oneof"`
+}
+
+func (*UpdateCalendarEventRequest_Appointment) isUpdateCalendarEventRequest_Detail() {}
+
+func (*UpdateCalendarEventRequest_ManualEvent) isUpdateCalendarEventRequest_Detail() {}
+
+func (*UpdateCalendarEventRequest_TimeBlock) isUpdateCalendarEventRequest_Detail() {}
+
+type UpdateAppointmentDetail struct {
+	state    protoimpl.MessageState         `protogen:"open.v1"`
+	Services []*AppointmentServiceSelection `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	// Same meaning as CreateAppointmentDetail.remind_before_seconds; required by appointment.remind_before.
+	RemindBeforeSeconds *int32 `protobuf:"varint,2,opt,name=remind_before_seconds,json=remindBeforeSeconds,proto3,oneof" json:"remind_before_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentDetail) Reset() {
+	*x = UpdateAppointmentDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentDetail) ProtoMessage() {}
+
+func (x *UpdateAppointmentDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentDetail.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateAppointmentDetail) GetServices() []*AppointmentServiceSelection {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *UpdateAppointmentDetail) GetRemindBeforeSeconds() int32 {
+	if x != nil && x.RemindBeforeSeconds != nil {
+		return *x.RemindBeforeSeconds
+	}
+	return 0
+}
+
+type UpdateManualEventDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         *string                `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Location      *string                `protobuf:"bytes,3,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateManualEventDetail) Reset() {
+	*x = UpdateManualEventDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManualEventDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManualEventDetail) ProtoMessage() {}
+
+func (x *UpdateManualEventDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManualEventDetail.ProtoReflect.Descriptor instead.
+func (*UpdateManualEventDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateManualEventDetail) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateManualEventDetail) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateManualEventDetail) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+type UpdateTimeBlockDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimeBlockDetail) Reset() {
+	*x = UpdateTimeBlockDetail{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimeBlockDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimeBlockDetail) ProtoMessage() {}
+
+func (x *UpdateTimeBlockDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimeBlockDetail.ProtoReflect.Descriptor instead.
+func (*UpdateTimeBlockDetail) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateTimeBlockDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelCalendarEventRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason CancelReason           `protobuf:"varint,2,opt,name=reason,proto3,enum=beaesthetic.appointment.v1.CancelReason" json:"reason,omitempty"`
+	// When set, the cancellation is rejected with a conflict unless it equals the current CalendarEvent.version.
+	ExpectedVersion *int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// Start of the occurrence to cancel, required when scope is THIS or THIS_AND_FOLLOWING.
+	OccurrenceStartAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurrence_start_at,json=occurrenceStartAt,proto3" json:"occurrence_start_at,omitempty"`
+	Scope             RecurrenceScope        `protobuf:"varint,5,opt,name=scope,proto3,enum=beaesthetic.appointment.v1.RecurrenceScope" json:"scope,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelCalendarEventRequest) Reset() {
+	*x = CancelCalendarEventRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCalendarEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCalendarEventRequest) ProtoMessage() {}
+
+func (x *CancelCalendarEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCalendarEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelCalendarEventRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CancelCalendarEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelCalendarEventRequest) GetReason() CancelReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancelReason_CANCEL_REASON_UNSPECIFIED
+}
+
+func (x *CancelCalendarEventRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *CancelCalendarEventRequest) GetOccurrenceStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceStartAt
+	}
+	return nil
+}
+
+func (x *CancelCalendarEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_UNSPECIFIED
+}
+
+type CancelCalendarEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCalendarEventResponse) Reset() {
+	*x = CancelCalendarEventResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCalendarEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCalendarEventResponse) ProtoMessage() {}
+
+func (x *CancelCalendarEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCalendarEventResponse.ProtoReflect.Descriptor instead.
+func (*CancelCalendarEventResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{30}
+}
+
+// BulkChangeCalendarEvents cancels or shifts, in one transaction, every active event of a calendar
+// overlapping [start_at, end_at). Occurrences of recurring events are changed one by one. Events the action
+// cannot apply to, for example a shift into a conflict, are reported as rejected and left unchanged.
+type BulkChangeCalendarEventsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// Restricts the changed events; empty changes every type.
+	EventTypes []CalendarEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=beaesthetic.appointment.v1.CalendarEventType" json:"event_types,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*BulkChangeCalendarEventsRequest_Cancel
+	//	*BulkChangeCalendarEventsRequest_Shift
+	Action        isBulkChangeCalendarEventsRequest_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkChangeCalendarEventsRequest) Reset() {
+	*x = BulkChangeCalendarEventsRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkChangeCalendarEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkChangeCalendarEventsRequest) ProtoMessage() {}
+
+func (x *BulkChangeCalendarEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkChangeCalendarEventsRequest.ProtoReflect.Descriptor instead.
+func (*BulkChangeCalendarEventsRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BulkChangeCalendarEventsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *BulkChangeCalendarEventsRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *BulkChangeCalendarEventsRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *BulkChangeCalendarEventsRequest) GetEventTypes() []CalendarEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *BulkChangeCalendarEventsRequest) GetAction() isBulkChangeCalendarEventsRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *BulkChangeCalendarEventsRequest) GetCancel() *BulkCancelAction {
+	if x != nil {
+		if x, ok := x.Action.(*BulkChangeCalendarEventsRequest_Cancel); ok {
+			return x.Cancel
+		}
+	}
+	return nil
+}
+
+func (x *BulkChangeCalendarEventsRequest) GetShift() *BulkShiftAction {
+	if x != nil {
+		if x, ok := x.Action.(*BulkChangeCalendarEventsRequest_Shift); ok {
+			return x.Shift
+		}
+	}
+	return nil
+}
+
+type isBulkChangeCalendarEventsRequest_Action interface {
+	isBulkChangeCalendarEventsRequest_Action()
+}
+
+type BulkChangeCalendarEventsRequest_Cancel struct {
+	Cancel *BulkCancelAction `protobuf:"bytes,5,opt,name=cancel,proto3,oneof"`
+}
+
+type BulkChangeCalendarEventsRequest_Shift struct {
+	Shift *BulkShiftAction `protobuf:"bytes,6,opt,name=shift,proto3,oneof"`
+}
+
+func (*BulkChangeCalendarEventsRequest_Cancel) isBulkChangeCalendarEventsRequest_Action() {}
+
+func (*BulkChangeCalendarEventsRequest_Shift) isBulkChangeCalendarEventsRequest_Action() {}
+
+type BulkCancelAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unspecified cancels with CANCEL_REASON_DELETED, which notifies the customers of appointments.
+	Reason        CancelReason `protobuf:"varint,1,opt,name=reason,proto3,enum=beaesthetic.appointment.v1.CancelReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCancelAction) Reset() {
+	*x = BulkCancelAction{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCancelAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCancelAction) ProtoMessage() {}
+
+func (x *BulkCancelAction) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCancelAction.ProtoReflect.Descriptor instead.
+func (*BulkCancelAction) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BulkCancelAction) GetReason() CancelReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancelReason_CANCEL_REASON_UNSPECIFIED
+}
+
+type BulkShiftAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Minutes to move the events by, negative to bring them forward.
+	ByMinutes     int32 `protobuf:"varint,1,opt,name=by_minutes,json=byMinutes,proto3" json:"by_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkShiftAction) Reset() {
+	*x = BulkShiftAction{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkShiftAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkShiftAction) ProtoMessage() {}
+
+func (x *BulkShiftAction) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkShiftAction.ProtoReflect.Descriptor instead.
+func (*BulkShiftAction) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BulkShiftAction) GetByMinutes() int32 {
+	if x != nil {
+		return x.ByMinutes
+	}
+	return 0
+}
+
+type BulkChangeCalendarEventResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	// Start of the event, or of the occurrence, before the change.
+	StartAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	Status  BulkChangeStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=beaesthetic.appointment.v1.BulkChangeStatus" json:"status,omitempty"`
+	// The changed event; a shifted occurrence is detached from its series into a new event.
+	Event *CalendarEvent `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	// Why a rejected event was left unchanged.
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkChangeCalendarEventResult) Reset() {
+	*x = BulkChangeCalendarEventResult{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkChangeCalendarEventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkChangeCalendarEventResult) ProtoMessage() {}
+
+func (x *BulkChangeCalendarEventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkChangeCalendarEventResult.ProtoReflect.Descriptor instead.
+func (*BulkChangeCalendarEventResult) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BulkChangeCalendarEventResult) GetCalendarEventId() string {
+	if x != nil {
+		return x.CalendarEventId
+	}
+	return ""
+}
+
+func (x *BulkChangeCalendarEventResult) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *BulkChangeCalendarEventResult) GetStatus() BulkChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BulkChangeStatus_BULK_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *BulkChangeCalendarEventResult) GetEvent() *CalendarEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *BulkChangeCalendarEventResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkChangeCalendarEventsResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Results       []*BulkChangeCalendarEventResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkChangeCalendarEventsResponse) Reset() {
+	*x = BulkChangeCalendarEventsResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkChangeCalendarEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkChangeCalendarEventsResponse) ProtoMessage() {}
+
+func (x *BulkChangeCalendarEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkChangeCalendarEventsResponse.ProtoReflect.Descriptor instead.
+func (*BulkChangeCalendarEventsResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BulkChangeCalendarEventsResponse) GetResults() []*BulkChangeCalendarEventResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The outcome of an appointment can be recorded once its start time has passed, unless it was canceled.
+// Recording the other outcome later corrects the previous one.
+type MarkCalendarEventCompletedRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	// When set, the request is rejected with a conflict unless it equals the current CalendarEvent.version.
+	ExpectedVersion *int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkCalendarEventCompletedRequest) Reset() {
+	*x = MarkCalendarEventCompletedRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkCalendarEventCompletedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCalendarEventCompletedRequest) ProtoMessage() {}
+
+func (x *MarkCalendarEventCompletedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCalendarEventCompletedRequest.ProtoReflect.Descriptor instead.
+func (*MarkCalendarEventCompletedRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MarkCalendarEventCompletedRequest) GetCalendarEventId() string {
+	if x != nil {
+		return x.CalendarEventId
+	}
+	return ""
+}
+
+func (x *MarkCalendarEventCompletedRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type MarkCalendarEventCompletedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkCalendarEventCompletedResponse) Reset() {
+	*x = MarkCalendarEventCompletedResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkCalendarEventCompletedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCalendarEventCompletedResponse) ProtoMessage() {}
+
+func (x *MarkCalendarEventCompletedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCalendarEventCompletedResponse.ProtoReflect.Descriptor instead.
+func (*MarkCalendarEventCompletedResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarkCalendarEventCompletedResponse) GetEvent() *CalendarEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type MarkCalendarEventNoShowRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	// When set, the request is rejected with a conflict unless it equals the current CalendarEvent.version.
+	ExpectedVersion *int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkCalendarEventNoShowRequest) Reset() {
+	*x = MarkCalendarEventNoShowRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkCalendarEventNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCalendarEventNoShowRequest) ProtoMessage() {}
+
+func (x *MarkCalendarEventNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCalendarEventNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkCalendarEventNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MarkCalendarEventNoShowRequest) GetCalendarEventId() string {
+	if x != nil {
+		return x.CalendarEventId
+	}
+	return ""
+}
+
+func (x *MarkCalendarEventNoShowRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type MarkCalendarEventNoShowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkCalendarEventNoShowResponse) Reset() {
+	*x = MarkCalendarEventNoShowResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkCalendarEventNoShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCalendarEventNoShowResponse) ProtoMessage() {}
+
+func (x *MarkCalendarEventNoShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCalendarEventNoShowResponse.ProtoReflect.Descriptor instead.
+func (*MarkCalendarEventNoShowResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MarkCalendarEventNoShowResponse) GetEvent() *CalendarEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// FindAvailableSlots returns the start times in [start_at, end_at) where a booking of the requested length
+// fits inside the opening hours without overlapping appointments or time blocks.
+type FindAvailableSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; omitted values use the service default calendar.
+	CalendarId string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	ServiceIds []string               `protobuf:"bytes,4,rep,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	// Length of the booking in minutes; when omitted, the durations and buffers of service_ids.
+	DurationMinutes int32 `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindAvailableSlotsRequest) Reset() {
+	*x = FindAvailableSlotsRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableSlotsRequest) ProtoMessage() {}
+
+func (x *FindAvailableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*FindAvailableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FindAvailableSlotsRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *FindAvailableSlotsRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *FindAvailableSlotsRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *FindAvailableSlotsRequest) GetServiceIds() []string {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *FindAvailableSlotsRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type AvailableSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailableSlot) Reset() {
+	*x = AvailableSlot{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableSlot) ProtoMessage() {}
+
+func (x *AvailableSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableSlot.ProtoReflect.Descriptor instead.
+func (*AvailableSlot) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AvailableSlot) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *AvailableSlot) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+type FindAvailableSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*AvailableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindAvailableSlotsResponse) Reset() {
+	*x = FindAvailableSlotsResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableSlotsResponse) ProtoMessage() {}
+
+func (x *FindAvailableSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableSlotsResponse.ProtoReflect.Descriptor instead.
+func (*FindAvailableSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FindAvailableSlotsResponse) GetSlots() []*AvailableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type RequestReminderResendRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Position of the reminder to resend; unset resends the first reminder.
+	ReminderPosition *int32 `protobuf:"varint,3,opt,name=reminder_position,json=reminderPosition,proto3,oneof" json:"reminder_position,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RequestReminderResendRequest) Reset() {
+	*x = RequestReminderResendRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReminderResendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReminderResendRequest) ProtoMessage() {}
+
+func (x *RequestReminderResendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReminderResendRequest.ProtoReflect.Descriptor instead.
+func (*RequestReminderResendRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RequestReminderResendRequest) GetCalendarEventId() string {
+	if x != nil {
+		return x.CalendarEventId
+	}
+	return ""
+}
+
+func (x *RequestReminderResendRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RequestReminderResendRequest) GetReminderPosition() int32 {
+	if x != nil && x.ReminderPosition != nil {
+		return *x.ReminderPosition
+	}
+	return 0
+}
+
+type RequestReminderResendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReminderResendResponse) Reset() {
+	*x = RequestReminderResendResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReminderResendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReminderResendResponse) ProtoMessage() {}
+
+func (x *RequestReminderResendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,54 +3784,85 @@ func (x *ManualEventDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ManualEventDetail.ProtoReflect.Descriptor instead.
-func (*ManualEventDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RequestReminderResendResponse.ProtoReflect.Descriptor instead.
+func (*RequestReminderResendResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ManualEventDetail) GetTitle() string {
+func (x *RequestReminderResendResponse) GetEvent() *CalendarEvent {
 	if x != nil {
-		return x.Title
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-func (x *ManualEventDetail) GetDescription() string {
+type ListCalendarEventNotificationsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCalendarEventNotificationsRequest) Reset() {
+	*x = ListCalendarEventNotificationsRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarEventNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarEventNotificationsRequest) ProtoMessage() {}
+
+func (x *ListCalendarEventNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[45]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ManualEventDetail) GetLocation() string {
+// Deprecated: Use ListCalendarEventNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarEventNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCalendarEventNotificationsRequest) GetCalendarEventId() string {
 	if x != nil {
-		return x.Location
+		return x.CalendarEventId
 	}
 	return ""
 }
 
-type TimeBlockDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+type ListCalendarEventNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered from the oldest to the latest request.
+	Notifications []*AppointmentNotification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeBlockDetail) Reset() {
-	*x = TimeBlockDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[8]
+func (x *ListCalendarEventNotificationsResponse) Reset() {
+	*x = ListCalendarEventNotificationsResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeBlockDetail) String() string {
+func (x *ListCalendarEventNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeBlockDetail) ProtoMessage() {}
+func (*ListCalendarEventNotificationsResponse) ProtoMessage() {}
 
-func (x *TimeBlockDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[8]
+func (x *ListCalendarEventNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,51 +3873,48 @@ func (x *TimeBlockDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeBlockDetail.ProtoReflect.Descriptor instead.
-func (*TimeBlockDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListCalendarEventNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarEventNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *TimeBlockDetail) GetReason() string {
+func (x *ListCalendarEventNotificationsResponse) GetNotifications() []*AppointmentNotification {
 	if x != nil {
-		return x.Reason
+		return x.Notifications
 	}
-	return ""
+	return nil
 }
 
-type CreateCalendarEventRequest struct {
+// Calendar is a bookable agenda of the salon, such as an operator, a room or a solarium bed.
+type Calendar struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional while appointment owns one calendar; omitted values use the service default calendar.
-	CalendarId  string                  `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
-	TimeRange   *TimeRange              `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	Visibility  CalendarEventVisibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=beaesthetic.appointment.v1.CalendarEventVisibility" json:"visibility,omitempty"`
-	Title       string                  `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Description string                  `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	// Types that are valid to be assigned to Detail:
-	//
-	//	*CreateCalendarEventRequest_Appointment
-	//	*CreateCalendarEventRequest_ManualEvent
-	//	*CreateCalendarEventRequest_TimeBlock
-	Detail        isCreateCalendarEventRequest_Detail `protobuf_oneof:"detail"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Hex color in the #RRGGBB form; empty when unset.
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// Set once the calendar is archived; archived calendars reject new events.
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCalendarEventRequest) Reset() {
-	*x = CreateCalendarEventRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[9]
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCalendarEventRequest) String() string {
+func (x *Calendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCalendarEventRequest) ProtoMessage() {}
+func (*Calendar) ProtoMessage() {}
 
-func (x *CreateCalendarEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[9]
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,127 +3925,262 @@ func (x *CreateCalendarEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCalendarEventRequest.ProtoReflect.Descriptor instead.
-func (*CreateCalendarEventRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateCalendarEventRequest) GetCalendarId() string {
+func (x *Calendar) GetId() string {
 	if x != nil {
-		return x.CalendarId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateCalendarEventRequest) GetTimeRange() *TimeRange {
+func (x *Calendar) GetName() string {
 	if x != nil {
-		return x.TimeRange
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateCalendarEventRequest) GetVisibility() CalendarEventVisibility {
+func (x *Calendar) GetColor() string {
 	if x != nil {
-		return x.Visibility
+		return x.Color
 	}
-	return CalendarEventVisibility_CALENDAR_EVENT_VISIBILITY_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateCalendarEventRequest) GetTitle() string {
+func (x *Calendar) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Title
+		return x.ArchivedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCalendarEventRequest) GetDescription() string {
+func (x *Calendar) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Description
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCalendarEventRequest) GetDetail() isCreateCalendarEventRequest_Detail {
+func (x *Calendar) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Detail
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *CreateCalendarEventRequest) GetAppointment() *CreateAppointmentDetail {
+type CreateCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,2,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarRequest) Reset() {
+	*x = CreateCalendarRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarRequest) ProtoMessage() {}
+
+func (x *CreateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[48]
 	if x != nil {
-		if x, ok := x.Detail.(*CreateCalendarEventRequest_Appointment); ok {
-			return x.Appointment
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCalendarEventRequest) GetManualEvent() *CreateManualEventDetail {
+// Deprecated: Use CreateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateCalendarRequest) GetName() string {
 	if x != nil {
-		if x, ok := x.Detail.(*CreateCalendarEventRequest_ManualEvent); ok {
-			return x.ManualEvent
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+type CreateCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarResponse) Reset() {
+	*x = CreateCalendarResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarResponse) ProtoMessage() {}
+
+func (x *CreateCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateCalendarResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
 	}
 	return nil
 }
 
-func (x *CreateCalendarEventRequest) GetTimeBlock() *CreateTimeBlockDetail {
+type ListCalendarsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCalendarsRequest) Reset() {
+	*x = ListCalendarsRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarsRequest) ProtoMessage() {}
+
+func (x *ListCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[50]
 	if x != nil {
-		if x, ok := x.Detail.(*CreateCalendarEventRequest_TimeBlock); ok {
-			return x.TimeBlock
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListCalendarsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListCalendarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendars     []*Calendar            `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type isCreateCalendarEventRequest_Detail interface {
-	isCreateCalendarEventRequest_Detail()
+func (x *ListCalendarsResponse) Reset() {
+	*x = ListCalendarsResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type CreateCalendarEventRequest_Appointment struct {
-	Appointment *CreateAppointmentDetail `protobuf:"bytes,20,opt,name=appointment,proto3,oneof"`
+func (x *ListCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CreateCalendarEventRequest_ManualEvent struct {
-	ManualEvent *CreateManualEventDetail `protobuf:"bytes,21,opt,name=manual_event,json=manualEvent,proto3,oneof"`
-}
+func (*ListCalendarsResponse) ProtoMessage() {}
 
-type CreateCalendarEventRequest_TimeBlock struct {
-	TimeBlock *CreateTimeBlockDetail `protobuf:"bytes,22,opt,name=time_block,json=timeBlock,proto3,oneof"`
+func (x *ListCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*CreateCalendarEventRequest_Appointment) isCreateCalendarEventRequest_Detail() {}
-
-func (*CreateCalendarEventRequest_ManualEvent) isCreateCalendarEventRequest_Detail() {}
+// Deprecated: Use ListCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{51}
+}
 
-func (*CreateCalendarEventRequest_TimeBlock) isCreateCalendarEventRequest_Detail() {}
+func (x *ListCalendarsResponse) GetCalendars() []*Calendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
 
-type CreateAppointmentDetail struct {
-	state      protoimpl.MessageState         `protogen:"open.v1"`
-	CustomerId string                         `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Services   []*AppointmentServiceSelection `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
-	// Ignored by the backend; reminder timing is configured server-side.
-	RemindBeforeSeconds *int32 `protobuf:"varint,3,opt,name=remind_before_seconds,json=remindBeforeSeconds,proto3,oneof" json:"remind_before_seconds,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// UpdateCalendarRequest changes only the fields that are set; an empty color removes it.
+type UpdateCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAppointmentDetail) Reset() {
-	*x = CreateAppointmentDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[10]
+func (x *UpdateCalendarRequest) Reset() {
+	*x = UpdateCalendarRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAppointmentDetail) String() string {
+func (x *UpdateCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAppointmentDetail) ProtoMessage() {}
+func (*UpdateCalendarRequest) ProtoMessage() {}
 
-func (x *CreateAppointmentDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[10]
+func (x *UpdateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,58 +4191,54 @@ func (x *CreateAppointmentDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAppointmentDetail.ProtoReflect.Descriptor instead.
-func (*CreateAppointmentDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateAppointmentDetail) GetCustomerId() string {
+func (x *UpdateCalendarRequest) GetId() string {
 	if x != nil {
-		return x.CustomerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateAppointmentDetail) GetServices() []*AppointmentServiceSelection {
-	if x != nil {
-		return x.Services
+func (x *UpdateCalendarRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateAppointmentDetail) GetRemindBeforeSeconds() int32 {
-	if x != nil && x.RemindBeforeSeconds != nil {
-		return *x.RemindBeforeSeconds
+func (x *UpdateCalendarRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
 	}
-	return 0
+	return ""
 }
 
-type AppointmentServiceSelection struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Value:
-	//
-	//	*AppointmentServiceSelection_CatalogServiceId
-	//	*AppointmentServiceSelection_CustomServiceName
-	Value         isAppointmentServiceSelection_Value `protobuf_oneof:"value"`
+type UpdateCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppointmentServiceSelection) Reset() {
-	*x = AppointmentServiceSelection{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[11]
+func (x *UpdateCalendarResponse) Reset() {
+	*x = UpdateCalendarResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppointmentServiceSelection) String() string {
+func (x *UpdateCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppointmentServiceSelection) ProtoMessage() {}
+func (*UpdateCalendarResponse) ProtoMessage() {}
 
-func (x *AppointmentServiceSelection) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[11]
+func (x *UpdateCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,76 +4249,131 @@ func (x *AppointmentServiceSelection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppointmentServiceSelection.ProtoReflect.Descriptor instead.
-func (*AppointmentServiceSelection) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateCalendarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *AppointmentServiceSelection) GetValue() isAppointmentServiceSelection_Value {
+func (x *UpdateCalendarResponse) GetCalendar() *Calendar {
 	if x != nil {
-		return x.Value
+		return x.Calendar
 	}
 	return nil
 }
 
-func (x *AppointmentServiceSelection) GetCatalogServiceId() string {
+type ArchiveCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCalendarRequest) Reset() {
+	*x = ArchiveCalendarRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCalendarRequest) ProtoMessage() {}
+
+func (x *ArchiveCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[54]
 	if x != nil {
-		if x, ok := x.Value.(*AppointmentServiceSelection_CatalogServiceId); ok {
-			return x.CatalogServiceId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AppointmentServiceSelection) GetCustomServiceName() string {
+// Deprecated: Use ArchiveCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ArchiveCalendarRequest) GetId() string {
 	if x != nil {
-		if x, ok := x.Value.(*AppointmentServiceSelection_CustomServiceName); ok {
-			return x.CustomServiceName
-		}
+		return x.Id
 	}
 	return ""
 }
 
-type isAppointmentServiceSelection_Value interface {
-	isAppointmentServiceSelection_Value()
+type ArchiveCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type AppointmentServiceSelection_CatalogServiceId struct {
-	CatalogServiceId string `protobuf:"bytes,1,opt,name=catalog_service_id,json=catalogServiceId,proto3,oneof"`
+func (x *ArchiveCalendarResponse) Reset() {
+	*x = ArchiveCalendarResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type AppointmentServiceSelection_CustomServiceName struct {
-	CustomServiceName string `protobuf:"bytes,2,opt,name=custom_service_name,json=customServiceName,proto3,oneof"`
+func (x *ArchiveCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppointmentServiceSelection_CatalogServiceId) isAppointmentServiceSelection_Value() {}
+func (*ArchiveCalendarResponse) ProtoMessage() {}
 
-func (*AppointmentServiceSelection_CustomServiceName) isAppointmentServiceSelection_Value() {}
+func (x *ArchiveCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type CreateManualEventDetail struct {
+// Deprecated: Use ArchiveCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ArchiveCalendarResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+// GetCalendarFeedLinkRequest selects the iCalendar subscription feed of a calendar or of a customer's
+// appointments; exactly one of the ids must be set.
+type GetCalendarFeedLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateManualEventDetail) Reset() {
-	*x = CreateManualEventDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[12]
+func (x *GetCalendarFeedLinkRequest) Reset() {
+	*x = GetCalendarFeedLinkRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateManualEventDetail) String() string {
+func (x *GetCalendarFeedLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateManualEventDetail) ProtoMessage() {}
+func (*GetCalendarFeedLinkRequest) ProtoMessage() {}
 
-func (x *CreateManualEventDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[12]
+func (x *GetCalendarFeedLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,54 +4384,49 @@ func (x *CreateManualEventDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateManualEventDetail.ProtoReflect.Descriptor instead.
-func (*CreateManualEventDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *CreateManualEventDetail) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use GetCalendarFeedLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedLinkRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateManualEventDetail) GetDescription() string {
+func (x *GetCalendarFeedLinkRequest) GetCalendarId() string {
 	if x != nil {
-		return x.Description
+		return x.CalendarId
 	}
 	return ""
 }
 
-func (x *CreateManualEventDetail) GetLocation() string {
+func (x *GetCalendarFeedLinkRequest) GetCustomerId() string {
 	if x != nil {
-		return x.Location
+		return x.CustomerId
 	}
 	return ""
 }
 
-type CreateTimeBlockDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+type GetCalendarFeedLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path of the read-only .ics feed, including the access token; relative to the API host.
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTimeBlockDetail) Reset() {
-	*x = CreateTimeBlockDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[13]
+func (x *GetCalendarFeedLinkResponse) Reset() {
+	*x = GetCalendarFeedLinkResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTimeBlockDetail) String() string {
+func (x *GetCalendarFeedLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTimeBlockDetail) ProtoMessage() {}
+func (*GetCalendarFeedLinkResponse) ProtoMessage() {}
 
-func (x *CreateTimeBlockDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[13]
+func (x *GetCalendarFeedLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,40 +4437,53 @@ func (x *CreateTimeBlockDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTimeBlockDetail.ProtoReflect.Descriptor instead.
-func (*CreateTimeBlockDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetCalendarFeedLinkResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedLinkResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateTimeBlockDetail) GetReason() string {
+func (x *GetCalendarFeedLinkResponse) GetPath() string {
 	if x != nil {
-		return x.Reason
+		return x.Path
 	}
 	return ""
 }
 
-type CreateCalendarEventResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetCalendarFeedLinkResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-func (x *CreateCalendarEventResponse) Reset() {
-	*x = CreateCalendarEventResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[14]
+// ImportTimeBlocksRequest imports the VEVENTs of an iCalendar file as time blocks of the calendar.
+// Events are matched by UID, so importing the same file again updates the time blocks instead of duplicating them.
+type ImportTimeBlocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Content of the .ics file.
+	Ics string `protobuf:"bytes,2,opt,name=ics,proto3" json:"ics,omitempty"`
+	// Reason of the imported time blocks; defaults to "imported".
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTimeBlocksRequest) Reset() {
+	*x = ImportTimeBlocksRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCalendarEventResponse) String() string {
+func (x *ImportTimeBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCalendarEventResponse) ProtoMessage() {}
+func (*ImportTimeBlocksRequest) ProtoMessage() {}
 
-func (x *CreateCalendarEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[14]
+func (x *ImportTimeBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,40 +4494,58 @@ func (x *CreateCalendarEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCalendarEventResponse.ProtoReflect.Descriptor instead.
-func (*CreateCalendarEventResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ImportTimeBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ImportTimeBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *CreateCalendarEventResponse) GetCalendarEventId() string {
+func (x *ImportTimeBlocksRequest) GetId() string {
 	if x != nil {
-		return x.CalendarEventId
+		return x.Id
 	}
 	return ""
 }
 
-type GetCalendarEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ImportTimeBlocksRequest) GetIcs() string {
+	if x != nil {
+		return x.Ics
+	}
+	return ""
+}
+
+func (x *ImportTimeBlocksRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TimeBlockImportResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uid             string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CalendarEventId string                 `protobuf:"bytes,2,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	Status          TimeBlockImportStatus  `protobuf:"varint,3,opt,name=status,proto3,enum=beaesthetic.appointment.v1.TimeBlockImportStatus" json:"status,omitempty"`
+	// Appointments overlapping the imported time block; the import never rejects them.
+	Conflicts     []*CalendarEventConflict `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCalendarEventRequest) Reset() {
-	*x = GetCalendarEventRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[15]
+func (x *TimeBlockImportResult) Reset() {
+	*x = TimeBlockImportResult{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCalendarEventRequest) String() string {
+func (x *TimeBlockImportResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCalendarEventRequest) ProtoMessage() {}
+func (*TimeBlockImportResult) ProtoMessage() {}
 
-func (x *GetCalendarEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[15]
+func (x *TimeBlockImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1376,40 +4556,61 @@ func (x *GetCalendarEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCalendarEventRequest.ProtoReflect.Descriptor instead.
-func (*GetCalendarEventRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TimeBlockImportResult.ProtoReflect.Descriptor instead.
+func (*TimeBlockImportResult) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TimeBlockImportResult) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TimeBlockImportResult) GetCalendarEventId() string {
+	if x != nil {
+		return x.CalendarEventId
+	}
+	return ""
+}
+
+func (x *TimeBlockImportResult) GetStatus() TimeBlockImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TimeBlockImportStatus_TIME_BLOCK_IMPORT_STATUS_UNSPECIFIED
 }
 
-func (x *GetCalendarEventRequest) GetId() string {
+func (x *TimeBlockImportResult) GetConflicts() []*CalendarEventConflict {
 	if x != nil {
-		return x.Id
+		return x.Conflicts
 	}
-	return ""
+	return nil
 }
 
-type GetCalendarEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+type ImportTimeBlocksResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*TimeBlockImportResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCalendarEventResponse) Reset() {
-	*x = GetCalendarEventResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[16]
+func (x *ImportTimeBlocksResponse) Reset() {
+	*x = ImportTimeBlocksResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCalendarEventResponse) String() string {
+func (x *ImportTimeBlocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCalendarEventResponse) ProtoMessage() {}
+func (*ImportTimeBlocksResponse) ProtoMessage() {}
 
-func (x *GetCalendarEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[16]
+func (x *ImportTimeBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,40 +4621,47 @@ func (x *GetCalendarEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCalendarEventResponse.ProtoReflect.Descriptor instead.
-func (*GetCalendarEventResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImportTimeBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ImportTimeBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetCalendarEventResponse) GetEvent() *CalendarEvent {
+func (x *ImportTimeBlocksResponse) GetResults() []*TimeBlockImportResult {
 	if x != nil {
-		return x.Event
+		return x.Results
 	}
 	return nil
 }
 
-type UpdateCalendarEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+type CatalogService struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tags  []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Color string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	// Default length of a booking; zero when unknown.
+	DurationMinutes int32 `protobuf:"varint,6,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// Cleanup time kept free after the service.
+	BufferMinutes int32 `protobuf:"varint,7,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCalendarEventResponse) Reset() {
-	*x = UpdateCalendarEventResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[17]
+func (x *CatalogService) Reset() {
+	*x = CatalogService{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCalendarEventResponse) String() string {
+func (x *CatalogService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCalendarEventResponse) ProtoMessage() {}
+func (*CatalogService) ProtoMessage() {}
 
-func (x *UpdateCalendarEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[17]
+func (x *CatalogService) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,45 +4672,79 @@ func (x *UpdateCalendarEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCalendarEventResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCalendarEventResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CatalogService.ProtoReflect.Descriptor instead.
+func (*CatalogService) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *UpdateCalendarEventResponse) GetEvent() *CalendarEvent {
+func (x *CatalogService) GetId() string {
 	if x != nil {
-		return x.Event
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CatalogService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogService) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-type ListCalendarEventsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional while appointment owns one calendar; omitted values use the service default calendar.
-	CalendarId    string                 `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
-	StartAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	EndAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
-	CustomerId    string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	EventTypes    []CalendarEventType    `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=beaesthetic.appointment.v1.CalendarEventType" json:"event_types,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CatalogService) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
 }
 
-func (x *ListCalendarEventsRequest) Reset() {
-	*x = ListCalendarEventsRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[18]
+func (x *CatalogService) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *CatalogService) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+type CreateServiceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tags            []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Color           *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	BufferMinutes   int32                  `protobuf:"varint,5,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateServiceRequest) Reset() {
+	*x = CreateServiceRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCalendarEventsRequest) String() string {
+func (x *CreateServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCalendarEventsRequest) ProtoMessage() {}
+func (*CreateServiceRequest) ProtoMessage() {}
 
-func (x *ListCalendarEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[18]
+func (x *CreateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,68 +4755,68 @@ func (x *ListCalendarEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCalendarEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListCalendarEventsRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateServiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ListCalendarEventsRequest) GetCalendarId() string {
+func (x *CreateServiceRequest) GetName() string {
 	if x != nil {
-		return x.CalendarId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListCalendarEventsRequest) GetStartAt() *timestamppb.Timestamp {
+func (x *CreateServiceRequest) GetTags() []string {
 	if x != nil {
-		return x.StartAt
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *ListCalendarEventsRequest) GetEndAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndAt
+func (x *CreateServiceRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCalendarEventsRequest) GetCustomerId() string {
+func (x *CreateServiceRequest) GetDurationMinutes() int32 {
 	if x != nil {
-		return x.CustomerId
+		return x.DurationMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *ListCalendarEventsRequest) GetEventTypes() []CalendarEventType {
+func (x *CreateServiceRequest) GetBufferMinutes() int32 {
 	if x != nil {
-		return x.EventTypes
+		return x.BufferMinutes
 	}
-	return nil
+	return 0
 }
 
-type ListCalendarEventsResponse struct {
+type CreateServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*CalendarEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Service       *CatalogService        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCalendarEventsResponse) Reset() {
-	*x = ListCalendarEventsResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[19]
+func (x *CreateServiceResponse) Reset() {
+	*x = CreateServiceResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCalendarEventsResponse) String() string {
+func (x *CreateServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCalendarEventsResponse) ProtoMessage() {}
+func (*CreateServiceResponse) ProtoMessage() {}
 
-func (x *ListCalendarEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[19]
+func (x *CreateServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,64 +4827,44 @@ func (x *ListCalendarEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCalendarEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListCalendarEventsResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CreateServiceResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListCalendarEventsResponse) GetEvents() []*CalendarEvent {
+func (x *CreateServiceResponse) GetService() *CatalogService {
 	if x != nil {
-		return x.Events
+		return x.Service
 	}
 	return nil
 }
 
-// UpdateCalendarEvent updates only mutable fields of an existing calendar event.
-// Immutable fields such as calendar_id, event_type, created_at and cancellation cannot be changed here.
-// The selected detail must match the existing event_type; this request never converts an event to another type.
-// Supported update_mask paths:
-// - time_range
-// - title
-// - description
-// - visibility
-// - appointment.services
-// - manual_event.title
-// - manual_event.description
-// - manual_event.location
-// - time_block.reason
-type UpdateCalendarEventRequest struct {
-	state       protoimpl.MessageState  `protogen:"open.v1"`
-	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TimeRange   *TimeRange              `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	UpdateMask  *fieldmaskpb.FieldMask  `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	Visibility  CalendarEventVisibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=beaesthetic.appointment.v1.CalendarEventVisibility" json:"visibility,omitempty"`
-	Title       *string                 `protobuf:"bytes,6,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description *string                 `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// Types that are valid to be assigned to Detail:
-	//
-	//	*UpdateCalendarEventRequest_Appointment
-	//	*UpdateCalendarEventRequest_ManualEvent
-	//	*UpdateCalendarEventRequest_TimeBlock
-	Detail        isUpdateCalendarEventRequest_Detail `protobuf_oneof:"detail"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateServiceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags            []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Color           *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	DurationMinutes *int32                 `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3,oneof" json:"duration_minutes,omitempty"`
+	BufferMinutes   *int32                 `protobuf:"varint,5,opt,name=buffer_minutes,json=bufferMinutes,proto3,oneof" json:"buffer_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateCalendarEventRequest) Reset() {
-	*x = UpdateCalendarEventRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[20]
+func (x *UpdateServiceRequest) Reset() {
+	*x = UpdateServiceRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCalendarEventRequest) String() string {
+func (x *UpdateServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCalendarEventRequest) ProtoMessage() {}
+func (*UpdateServiceRequest) ProtoMessage() {}
 
-func (x *UpdateCalendarEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[20]
+func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1653,131 +4875,164 @@ func (x *UpdateCalendarEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCalendarEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCalendarEventRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *UpdateCalendarEventRequest) GetId() string {
+func (x *UpdateServiceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateCalendarEventRequest) GetTimeRange() *TimeRange {
+func (x *UpdateServiceRequest) GetTags() []string {
 	if x != nil {
-		return x.TimeRange
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *UpdateCalendarEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
-	if x != nil {
-		return x.UpdateMask
+func (x *UpdateServiceRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateCalendarEventRequest) GetVisibility() CalendarEventVisibility {
-	if x != nil {
-		return x.Visibility
+func (x *UpdateServiceRequest) GetDurationMinutes() int32 {
+	if x != nil && x.DurationMinutes != nil {
+		return *x.DurationMinutes
 	}
-	return CalendarEventVisibility_CALENDAR_EVENT_VISIBILITY_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateCalendarEventRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *UpdateServiceRequest) GetBufferMinutes() int32 {
+	if x != nil && x.BufferMinutes != nil {
+		return *x.BufferMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCalendarEventRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+type UpdateServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *CatalogService        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCalendarEventRequest) GetDetail() isUpdateCalendarEventRequest_Detail {
-	if x != nil {
-		return x.Detail
-	}
-	return nil
+func (x *UpdateServiceResponse) Reset() {
+	*x = UpdateServiceResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCalendarEventRequest) GetAppointment() *UpdateAppointmentDetail {
+func (x *UpdateServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceResponse) ProtoMessage() {}
+
+func (x *UpdateServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[65]
 	if x != nil {
-		if x, ok := x.Detail.(*UpdateCalendarEventRequest_Appointment); ok {
-			return x.Appointment
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateCalendarEventRequest) GetManualEvent() *UpdateManualEventDetail {
-	if x != nil {
-		if x, ok := x.Detail.(*UpdateCalendarEventRequest_ManualEvent); ok {
-			return x.ManualEvent
-		}
-	}
-	return nil
+// Deprecated: Use UpdateServiceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateServiceResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *UpdateCalendarEventRequest) GetTimeBlock() *UpdateTimeBlockDetail {
+func (x *UpdateServiceResponse) GetService() *CatalogService {
 	if x != nil {
-		if x, ok := x.Detail.(*UpdateCalendarEventRequest_TimeBlock); ok {
-			return x.TimeBlock
-		}
+		return x.Service
 	}
 	return nil
 }
 
-type isUpdateCalendarEventRequest_Detail interface {
-	isUpdateCalendarEventRequest_Detail()
+type SearchServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type UpdateCalendarEventRequest_Appointment struct {
-	Appointment *UpdateAppointmentDetail `protobuf:"bytes,20,opt,name=appointment,proto3,oneof"`
+func (x *SearchServicesRequest) Reset() {
+	*x = SearchServicesRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type UpdateCalendarEventRequest_ManualEvent struct {
-	ManualEvent *UpdateManualEventDetail `protobuf:"bytes,21,opt,name=manual_event,json=manualEvent,proto3,oneof"`
+func (x *SearchServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type UpdateCalendarEventRequest_TimeBlock struct {
-	TimeBlock *UpdateTimeBlockDetail `protobuf:"bytes,22,opt,name=time_block,json=timeBlock,proto3,oneof"`
+func (*SearchServicesRequest) ProtoMessage() {}
+
+func (x *SearchServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*UpdateCalendarEventRequest_Appointment) isUpdateCalendarEventRequest_Detail() {}
+// Deprecated: Use SearchServicesRequest.ProtoReflect.Descriptor instead.
+func (*SearchServicesRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{66}
+}
 
-func (*UpdateCalendarEventRequest_ManualEvent) isUpdateCalendarEventRequest_Detail() {}
+func (x *SearchServicesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
 
-func (*UpdateCalendarEventRequest_TimeBlock) isUpdateCalendarEventRequest_Detail() {}
+func (x *SearchServicesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
 
-type UpdateAppointmentDetail struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Services      []*AppointmentServiceSelection `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+type SearchServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*CatalogService      `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAppointmentDetail) Reset() {
-	*x = UpdateAppointmentDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[21]
+func (x *SearchServicesResponse) Reset() {
+	*x = SearchServicesResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppointmentDetail) String() string {
+func (x *SearchServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppointmentDetail) ProtoMessage() {}
+func (*SearchServicesResponse) ProtoMessage() {}
 
-func (x *UpdateAppointmentDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[21]
+func (x *SearchServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,42 +5043,42 @@ func (x *UpdateAppointmentDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppointmentDetail.ProtoReflect.Descriptor instead.
-func (*UpdateAppointmentDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SearchServicesResponse.ProtoReflect.Descriptor instead.
+func (*SearchServicesResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UpdateAppointmentDetail) GetServices() []*AppointmentServiceSelection {
+func (x *SearchServicesResponse) GetServices() []*CatalogService {
 	if x != nil {
 		return x.Services
 	}
 	return nil
 }
 
-type UpdateManualEventDetail struct {
+// ListServicesRequest filters the appointment service catalog when query is set.
+type ListServicesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         *string                `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Location      *string                `protobuf:"bytes,3,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateManualEventDetail) Reset() {
-	*x = UpdateManualEventDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[22]
+func (x *ListServicesRequest) Reset() {
+	*x = ListServicesRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateManualEventDetail) String() string {
+func (x *ListServicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateManualEventDetail) ProtoMessage() {}
+func (*ListServicesRequest) ProtoMessage() {}
 
-func (x *UpdateManualEventDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[22]
+func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1834,54 +5089,47 @@ func (x *UpdateManualEventDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateManualEventDetail.ProtoReflect.Descriptor instead.
-func (*UpdateManualEventDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *UpdateManualEventDetail) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UpdateManualEventDetail) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ListServicesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
-func (x *UpdateManualEventDetail) GetLocation() string {
-	if x != nil && x.Location != nil {
-		return *x.Location
+func (x *ListServicesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type UpdateTimeBlockDetail struct {
+type ListServicesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Services      []*CatalogService      `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTimeBlockDetail) Reset() {
-	*x = UpdateTimeBlockDetail{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[23]
+func (x *ListServicesResponse) Reset() {
+	*x = ListServicesResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTimeBlockDetail) String() string {
+func (x *ListServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTimeBlockDetail) ProtoMessage() {}
+func (*ListServicesResponse) ProtoMessage() {}
 
-func (x *UpdateTimeBlockDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[23]
+func (x *ListServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1892,41 +5140,43 @@ func (x *UpdateTimeBlockDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTimeBlockDetail.ProtoReflect.Descriptor instead.
-func (*UpdateTimeBlockDetail) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListServicesResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateTimeBlockDetail) GetReason() string {
+func (x *ListServicesResponse) GetServices() []*CatalogService {
 	if x != nil {
-		return x.Reason
+		return x.Services
 	}
-	return ""
+	return nil
 }
 
-type CancelCalendarEventRequest struct {
+// PageRequest selects a zero-based page of at most limit items.
+// Responses set next_page to the following page number while more items are available.
+type PageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Reason        CancelReason           `protobuf:"varint,2,opt,name=reason,proto3,enum=beaesthetic.appointment.v1.CancelReason" json:"reason,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelCalendarEventRequest) Reset() {
-	*x = CancelCalendarEventRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[24]
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelCalendarEventRequest) String() string {
+func (x *PageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelCalendarEventRequest) ProtoMessage() {}
+func (*PageRequest) ProtoMessage() {}
 
-func (x *CancelCalendarEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[24]
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,46 +5187,94 @@ func (x *CancelCalendarEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelCalendarEventRequest.ProtoReflect.Descriptor instead.
-func (*CancelCalendarEventRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CancelCalendarEventRequest) GetId() string {
+func (x *PageRequest) GetPage() int32 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CancelCalendarEventRequest) GetReason() CancelReason {
+func (x *PageRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Limit
 	}
-	return CancelReason_CANCEL_REASON_UNSPECIFIED
+	return 0
 }
 
-type CancelCalendarEventResponse struct {
+type GetCustomerRankingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelCalendarEventResponse) Reset() {
-	*x = CancelCalendarEventResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[25]
+func (x *GetCustomerRankingRequest) Reset() {
+	*x = GetCustomerRankingRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelCalendarEventResponse) String() string {
+func (x *GetCustomerRankingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelCalendarEventResponse) ProtoMessage() {}
+func (*GetCustomerRankingRequest) ProtoMessage() {}
 
-func (x *CancelCalendarEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[25]
+func (x *GetCustomerRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRankingRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetCustomerRankingRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type CustomerRankingItem struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId           string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	CustomerName         string                 `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerSurname      string                 `protobuf:"bytes,3,opt,name=customer_surname,json=customerSurname,proto3" json:"customer_surname,omitempty"`
+	NumberOfAppointments int32                  `protobuf:"varint,4,opt,name=number_of_appointments,json=numberOfAppointments,proto3" json:"number_of_appointments,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CustomerRankingItem) Reset() {
+	*x = CustomerRankingItem{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerRankingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerRankingItem) ProtoMessage() {}
+
+func (x *CustomerRankingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,34 +5285,62 @@ func (x *CancelCalendarEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelCalendarEventResponse.ProtoReflect.Descriptor instead.
-func (*CancelCalendarEventResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CustomerRankingItem.ProtoReflect.Descriptor instead.
+func (*CustomerRankingItem) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{72}
 }
 
-type RequestReminderResendRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
-	IdempotencyKey  string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CustomerRankingItem) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
 }
 
-func (x *RequestReminderResendRequest) Reset() {
-	*x = RequestReminderResendRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[26]
+func (x *CustomerRankingItem) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *CustomerRankingItem) GetCustomerSurname() string {
+	if x != nil {
+		return x.CustomerSurname
+	}
+	return ""
+}
+
+func (x *CustomerRankingItem) GetNumberOfAppointments() int32 {
+	if x != nil {
+		return x.NumberOfAppointments
+	}
+	return 0
+}
+
+type GetCustomerRankingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CustomerRankingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPage      string                 `protobuf:"bytes,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRankingResponse) Reset() {
+	*x = GetCustomerRankingResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestReminderResendRequest) String() string {
+func (x *GetCustomerRankingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestReminderResendRequest) ProtoMessage() {}
+func (*GetCustomerRankingResponse) ProtoMessage() {}
 
-func (x *RequestReminderResendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[26]
+func (x *GetCustomerRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,47 +5351,47 @@ func (x *RequestReminderResendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestReminderResendRequest.ProtoReflect.Descriptor instead.
-func (*RequestReminderResendRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetCustomerRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerRankingResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *RequestReminderResendRequest) GetCalendarEventId() string {
+func (x *GetCustomerRankingResponse) GetItems() []*CustomerRankingItem {
 	if x != nil {
-		return x.CalendarEventId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *RequestReminderResendRequest) GetIdempotencyKey() string {
+func (x *GetCustomerRankingResponse) GetNextPage() string {
 	if x != nil {
-		return x.IdempotencyKey
+		return x.NextPage
 	}
 	return ""
 }
 
-type RequestReminderResendResponse struct {
+type GetCustomerCancellationRankingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *CalendarEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestReminderResendResponse) Reset() {
-	*x = RequestReminderResendResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[27]
+func (x *GetCustomerCancellationRankingRequest) Reset() {
+	*x = GetCustomerCancellationRankingRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestReminderResendResponse) String() string {
+func (x *GetCustomerCancellationRankingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestReminderResendResponse) ProtoMessage() {}
+func (*GetCustomerCancellationRankingRequest) ProtoMessage() {}
 
-func (x *RequestReminderResendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[27]
+func (x *GetCustomerCancellationRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,43 +5402,43 @@ func (x *RequestReminderResendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestReminderResendResponse.ProtoReflect.Descriptor instead.
-func (*RequestReminderResendResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetCustomerCancellationRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerCancellationRankingRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *RequestReminderResendResponse) GetEvent() *CalendarEvent {
+func (x *GetCustomerCancellationRankingRequest) GetPage() *PageRequest {
 	if x != nil {
-		return x.Event
+		return x.Page
 	}
 	return nil
 }
 
-type CatalogService struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CustomerCancellationRankingItem struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId            string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	CustomerName          string                 `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerSurname       string                 `protobuf:"bytes,3,opt,name=customer_surname,json=customerSurname,proto3" json:"customer_surname,omitempty"`
+	NumberOfCancellations int32                  `protobuf:"varint,4,opt,name=number_of_cancellations,json=numberOfCancellations,proto3" json:"number_of_cancellations,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CatalogService) Reset() {
-	*x = CatalogService{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[28]
+func (x *CustomerCancellationRankingItem) Reset() {
+	*x = CustomerCancellationRankingItem{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CatalogService) String() string {
+func (x *CustomerCancellationRankingItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CatalogService) ProtoMessage() {}
+func (*CustomerCancellationRankingItem) ProtoMessage() {}
 
-func (x *CatalogService) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[28]
+func (x *CustomerCancellationRankingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,63 +5449,62 @@ func (x *CatalogService) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CatalogService.ProtoReflect.Descriptor instead.
-func (*CatalogService) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CustomerCancellationRankingItem.ProtoReflect.Descriptor instead.
+func (*CustomerCancellationRankingItem) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CatalogService) GetId() string {
+func (x *CustomerCancellationRankingItem) GetCustomerId() string {
 	if x != nil {
-		return x.Id
+		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *CatalogService) GetName() string {
+func (x *CustomerCancellationRankingItem) GetCustomerName() string {
 	if x != nil {
-		return x.Name
+		return x.CustomerName
 	}
 	return ""
 }
 
-func (x *CatalogService) GetTags() []string {
+func (x *CustomerCancellationRankingItem) GetCustomerSurname() string {
 	if x != nil {
-		return x.Tags
+		return x.CustomerSurname
 	}
-	return nil
+	return ""
 }
 
-func (x *CatalogService) GetColor() string {
+func (x *CustomerCancellationRankingItem) GetNumberOfCancellations() int32 {
 	if x != nil {
-		return x.Color
+		return x.NumberOfCancellations
 	}
-	return ""
+	return 0
 }
 
-type CreateServiceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
-	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+type GetCustomerCancellationRankingResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Items         []*CustomerCancellationRankingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPage      string                             `protobuf:"bytes,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateServiceRequest) Reset() {
-	*x = CreateServiceRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[29]
+func (x *GetCustomerCancellationRankingResponse) Reset() {
+	*x = GetCustomerCancellationRankingResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateServiceRequest) String() string {
+func (x *GetCustomerCancellationRankingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateServiceRequest) ProtoMessage() {}
+func (*GetCustomerCancellationRankingResponse) ProtoMessage() {}
 
-func (x *CreateServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[29]
+func (x *GetCustomerCancellationRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2190,54 +5515,47 @@ func (x *CreateServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateServiceRequest.ProtoReflect.Descriptor instead.
-func (*CreateServiceRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *CreateServiceRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetCustomerCancellationRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerCancellationRankingResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *CreateServiceRequest) GetTags() []string {
+func (x *GetCustomerCancellationRankingResponse) GetItems() []*CustomerCancellationRankingItem {
 	if x != nil {
-		return x.Tags
+		return x.Items
 	}
 	return nil
 }
 
-func (x *CreateServiceRequest) GetColor() string {
-	if x != nil && x.Color != nil {
-		return *x.Color
+func (x *GetCustomerCancellationRankingResponse) GetNextPage() string {
+	if x != nil {
+		return x.NextPage
 	}
 	return ""
 }
 
-type CreateServiceResponse struct {
+type GetCustomerNoShowRankingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Service       *CatalogService        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateServiceResponse) Reset() {
-	*x = CreateServiceResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[30]
+func (x *GetCustomerNoShowRankingRequest) Reset() {
+	*x = GetCustomerNoShowRankingRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateServiceResponse) String() string {
+func (x *GetCustomerNoShowRankingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateServiceResponse) ProtoMessage() {}
+func (*GetCustomerNoShowRankingRequest) ProtoMessage() {}
 
-func (x *CreateServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[30]
+func (x *GetCustomerNoShowRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2248,42 +5566,47 @@ func (x *CreateServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateServiceResponse.ProtoReflect.Descriptor instead.
-func (*CreateServiceResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetCustomerNoShowRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerNoShowRankingRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *CreateServiceResponse) GetService() *CatalogService {
+func (x *GetCustomerNoShowRankingRequest) GetPage() *PageRequest {
 	if x != nil {
-		return x.Service
+		return x.Page
 	}
 	return nil
 }
 
-type UpdateServiceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
-	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CustomerNoShowRankingItem counts only appointments with a recorded outcome; number_of_attendances is the
+// number of those outcomes and no_show_rate the share of no-shows among them, between 0 and 1.
+type CustomerNoShowRankingItem struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId          string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	CustomerName        string                 `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerSurname     string                 `protobuf:"bytes,3,opt,name=customer_surname,json=customerSurname,proto3" json:"customer_surname,omitempty"`
+	NumberOfNoShows     int32                  `protobuf:"varint,4,opt,name=number_of_no_shows,json=numberOfNoShows,proto3" json:"number_of_no_shows,omitempty"`
+	NumberOfAttendances int32                  `protobuf:"varint,5,opt,name=number_of_attendances,json=numberOfAttendances,proto3" json:"number_of_attendances,omitempty"`
+	NoShowRate          float64                `protobuf:"fixed64,6,opt,name=no_show_rate,json=noShowRate,proto3" json:"no_show_rate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *UpdateServiceRequest) Reset() {
-	*x = UpdateServiceRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[31]
+func (x *CustomerNoShowRankingItem) Reset() {
+	*x = CustomerNoShowRankingItem{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateServiceRequest) String() string {
+func (x *CustomerNoShowRankingItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateServiceRequest) ProtoMessage() {}
+func (*CustomerNoShowRankingItem) ProtoMessage() {}
 
-func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[31]
+func (x *CustomerNoShowRankingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,99 +5617,76 @@ func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *UpdateServiceRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CustomerNoShowRankingItem.ProtoReflect.Descriptor instead.
+func (*CustomerNoShowRankingItem) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UpdateServiceRequest) GetTags() []string {
+func (x *CustomerNoShowRankingItem) GetCustomerId() string {
 	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *UpdateServiceRequest) GetColor() string {
-	if x != nil && x.Color != nil {
-		return *x.Color
-	}
-	return ""
-}
-
-type UpdateServiceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Service       *CatalogService        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateServiceResponse) Reset() {
-	*x = UpdateServiceResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+		return x.CustomerId
+	}
+	return ""
 }
 
-func (x *UpdateServiceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CustomerNoShowRankingItem) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
 }
 
-func (*UpdateServiceResponse) ProtoMessage() {}
+func (x *CustomerNoShowRankingItem) GetCustomerSurname() string {
+	if x != nil {
+		return x.CustomerSurname
+	}
+	return ""
+}
 
-func (x *UpdateServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[32]
+func (x *CustomerNoShowRankingItem) GetNumberOfNoShows() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NumberOfNoShows
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateServiceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateServiceResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{32}
+func (x *CustomerNoShowRankingItem) GetNumberOfAttendances() int32 {
+	if x != nil {
+		return x.NumberOfAttendances
+	}
+	return 0
 }
 
-func (x *UpdateServiceResponse) GetService() *CatalogService {
+func (x *CustomerNoShowRankingItem) GetNoShowRate() float64 {
 	if x != nil {
-		return x.Service
+		return x.NoShowRate
 	}
-	return nil
+	return 0
 }
 
-type SearchServicesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+type GetCustomerNoShowRankingResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Items         []*CustomerNoShowRankingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPage      string                       `protobuf:"bytes,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchServicesRequest) Reset() {
-	*x = SearchServicesRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[33]
+func (x *GetCustomerNoShowRankingResponse) Reset() {
+	*x = GetCustomerNoShowRankingResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchServicesRequest) String() string {
+func (x *GetCustomerNoShowRankingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchServicesRequest) ProtoMessage() {}
+func (*GetCustomerNoShowRankingResponse) ProtoMessage() {}
 
-func (x *SearchServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[33]
+func (x *GetCustomerNoShowRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2397,47 +5697,46 @@ func (x *SearchServicesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchServicesRequest.ProtoReflect.Descriptor instead.
-func (*SearchServicesRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GetCustomerNoShowRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerNoShowRankingResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *SearchServicesRequest) GetQuery() string {
+func (x *GetCustomerNoShowRankingResponse) GetItems() []*CustomerNoShowRankingItem {
 	if x != nil {
-		return x.Query
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchServicesRequest) GetLimit() int32 {
+func (x *GetCustomerNoShowRankingResponse) GetNextPage() string {
 	if x != nil {
-		return x.Limit
+		return x.NextPage
 	}
-	return 0
+	return ""
 }
 
-type SearchServicesResponse struct {
+type GetInsightOverviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Services      []*CatalogService      `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchServicesResponse) Reset() {
-	*x = SearchServicesResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[34]
+func (x *GetInsightOverviewRequest) Reset() {
+	*x = GetInsightOverviewRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchServicesResponse) String() string {
+func (x *GetInsightOverviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchServicesResponse) ProtoMessage() {}
+func (*GetInsightOverviewRequest) ProtoMessage() {}
 
-func (x *SearchServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[34]
+func (x *GetInsightOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,42 +5747,36 @@ func (x *SearchServicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchServicesResponse.ProtoReflect.Descriptor instead.
-func (*SearchServicesResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *SearchServicesResponse) GetServices() []*CatalogService {
-	if x != nil {
-		return x.Services
-	}
-	return nil
+// Deprecated: Use GetInsightOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetInsightOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{80}
 }
 
-// ListServicesRequest filters the appointment service catalog when query is set.
-type ListServicesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CancellationDayOfWeekCount counts customer cancellations by the weekday of the canceled appointment,
+// evaluated in the appointment timezone. day_of_week is an upper-case English weekday such as MONDAY.
+type CancellationDayOfWeekCount struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek         string                 `protobuf:"bytes,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	CancellationCount int32                  `protobuf:"varint,2,opt,name=cancellation_count,json=cancellationCount,proto3" json:"cancellation_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListServicesRequest) Reset() {
-	*x = ListServicesRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[35]
+func (x *CancellationDayOfWeekCount) Reset() {
+	*x = CancellationDayOfWeekCount{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServicesRequest) String() string {
+func (x *CancellationDayOfWeekCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServicesRequest) ProtoMessage() {}
+func (*CancellationDayOfWeekCount) ProtoMessage() {}
 
-func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[35]
+func (x *CancellationDayOfWeekCount) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,47 +5787,47 @@ func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
-func (*ListServicesRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CancellationDayOfWeekCount.ProtoReflect.Descriptor instead.
+func (*CancellationDayOfWeekCount) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ListServicesRequest) GetQuery() string {
+func (x *CancellationDayOfWeekCount) GetDayOfWeek() string {
 	if x != nil {
-		return x.Query
+		return x.DayOfWeek
 	}
 	return ""
 }
 
-func (x *ListServicesRequest) GetLimit() int32 {
+func (x *CancellationDayOfWeekCount) GetCancellationCount() int32 {
 	if x != nil {
-		return x.Limit
+		return x.CancellationCount
 	}
 	return 0
 }
 
-type ListServicesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Services      []*CatalogService      `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetInsightOverviewResponse struct {
+	state                 protoimpl.MessageState        `protogen:"open.v1"`
+	CancellationDayOfWeek []*CancellationDayOfWeekCount `protobuf:"bytes,1,rep,name=cancellation_day_of_week,json=cancellationDayOfWeek,proto3" json:"cancellation_day_of_week,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *ListServicesResponse) Reset() {
-	*x = ListServicesResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[36]
+func (x *GetInsightOverviewResponse) Reset() {
+	*x = GetInsightOverviewResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServicesResponse) String() string {
+func (x *GetInsightOverviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServicesResponse) ProtoMessage() {}
+func (*GetInsightOverviewResponse) ProtoMessage() {}
 
-func (x *ListServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[36]
+func (x *GetInsightOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2545,41 +5838,45 @@ func (x *ListServicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServicesResponse.ProtoReflect.Descriptor instead.
-func (*ListServicesResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetInsightOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetInsightOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ListServicesResponse) GetServices() []*CatalogService {
+func (x *GetInsightOverviewResponse) GetCancellationDayOfWeek() []*CancellationDayOfWeekCount {
 	if x != nil {
-		return x.Services
+		return x.CancellationDayOfWeek
 	}
 	return nil
 }
 
-type PageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// WaitlistOffer is the time freed by a canceled appointment, offered to the customer until expires_at.
+// Once it expires the entry waits again and the same time goes to the next entry.
+type WaitlistOffer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalendarEventId string                 `protobuf:"bytes,1,opt,name=calendar_event_id,json=calendarEventId,proto3" json:"calendar_event_id,omitempty"`
+	TimeRange       *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	OfferedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=offered_at,json=offeredAt,proto3" json:"offered_at,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PageRequest) Reset() {
-	*x = PageRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[37]
+func (x *WaitlistOffer) Reset() {
+	*x = WaitlistOffer{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageRequest) String() string {
+func (x *WaitlistOffer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageRequest) ProtoMessage() {}
+func (*WaitlistOffer) ProtoMessage() {}
 
-func (x *PageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[37]
+func (x *WaitlistOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,47 +5887,71 @@ func (x *PageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
-func (*PageRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use WaitlistOffer.ProtoReflect.Descriptor instead.
+func (*WaitlistOffer) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *PageRequest) GetPage() int32 {
+func (x *WaitlistOffer) GetCalendarEventId() string {
 	if x != nil {
-		return x.Page
+		return x.CalendarEventId
 	}
-	return 0
+	return ""
 }
 
-func (x *PageRequest) GetLimit() int32 {
+func (x *WaitlistOffer) GetTimeRange() *TimeRange {
 	if x != nil {
-		return x.Limit
+		return x.TimeRange
 	}
-	return 0
+	return nil
 }
 
-type GetCustomerRankingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+func (x *WaitlistOffer) GetOfferedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OfferedAt
+	}
+	return nil
+}
+
+func (x *WaitlistOffer) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type WaitlistEntry struct {
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Id         string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CalendarId string                    `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Customer   *CustomerRef              `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	Services   []*AppointmentServiceItem `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	// The window in which the customer accepts a slot.
+	TimeRange *TimeRange          `protobuf:"bytes,5,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Status    WaitlistEntryStatus `protobuf:"varint,6,opt,name=status,proto3,enum=beaesthetic.appointment.v1.WaitlistEntryStatus" json:"status,omitempty"`
+	// The last offer made to the entry; kept after it expires.
+	Offer         *WaitlistOffer         `protobuf:"bytes,7,opt,name=offer,proto3" json:"offer,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerRankingRequest) Reset() {
-	*x = GetCustomerRankingRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[38]
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerRankingRequest) String() string {
+func (x *WaitlistEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerRankingRequest) ProtoMessage() {}
+func (*WaitlistEntry) ProtoMessage() {}
 
-func (x *GetCustomerRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[38]
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,109 +5962,100 @@ func (x *GetCustomerRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerRankingRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomerRankingRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetCustomerRankingRequest) GetPage() *PageRequest {
+func (x *WaitlistEntry) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return nil
-}
-
-type CustomerRankingItem struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId           string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	CustomerName         string                 `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
-	CustomerSurname      string                 `protobuf:"bytes,3,opt,name=customer_surname,json=customerSurname,proto3" json:"customer_surname,omitempty"`
-	NumberOfAppointments int32                  `protobuf:"varint,4,opt,name=number_of_appointments,json=numberOfAppointments,proto3" json:"number_of_appointments,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	return ""
 }
 
-func (x *CustomerRankingItem) Reset() {
-	*x = CustomerRankingItem{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WaitlistEntry) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
 }
 
-func (x *CustomerRankingItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WaitlistEntry) GetCustomer() *CustomerRef {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
 }
 
-func (*CustomerRankingItem) ProtoMessage() {}
-
-func (x *CustomerRankingItem) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[39]
+func (x *WaitlistEntry) GetServices() []*AppointmentServiceItem {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Services
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CustomerRankingItem.ProtoReflect.Descriptor instead.
-func (*CustomerRankingItem) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{39}
+func (x *WaitlistEntry) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
 }
 
-func (x *CustomerRankingItem) GetCustomerId() string {
+func (x *WaitlistEntry) GetStatus() WaitlistEntryStatus {
 	if x != nil {
-		return x.CustomerId
+		return x.Status
 	}
-	return ""
+	return WaitlistEntryStatus_WAITLIST_ENTRY_STATUS_UNSPECIFIED
 }
 
-func (x *CustomerRankingItem) GetCustomerName() string {
+func (x *WaitlistEntry) GetOffer() *WaitlistOffer {
 	if x != nil {
-		return x.CustomerName
+		return x.Offer
 	}
-	return ""
+	return nil
 }
 
-func (x *CustomerRankingItem) GetCustomerSurname() string {
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CustomerSurname
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CustomerRankingItem) GetNumberOfAppointments() int32 {
+func (x *WaitlistEntry) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NumberOfAppointments
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type GetCustomerRankingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CustomerRankingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	NextPage      string                 `protobuf:"bytes,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+type CreateWaitlistEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; omitted values use the service default calendar.
+	CalendarId    string                         `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	CustomerId    string                         `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Services      []*AppointmentServiceSelection `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty"`
+	TimeRange     *TimeRange                     `protobuf:"bytes,4,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerRankingResponse) Reset() {
-	*x = GetCustomerRankingResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[40]
+func (x *CreateWaitlistEntryRequest) Reset() {
+	*x = CreateWaitlistEntryRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerRankingResponse) String() string {
+func (x *CreateWaitlistEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerRankingResponse) ProtoMessage() {}
+func (*CreateWaitlistEntryRequest) ProtoMessage() {}
 
-func (x *GetCustomerRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[40]
+func (x *CreateWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2754,47 +6066,61 @@ func (x *GetCustomerRankingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerRankingResponse.ProtoReflect.Descriptor instead.
-func (*GetCustomerRankingResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CreateWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetCustomerRankingResponse) GetItems() []*CustomerRankingItem {
+func (x *CreateWaitlistEntryRequest) GetCalendarId() string {
 	if x != nil {
-		return x.Items
+		return x.CalendarId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetCustomerRankingResponse) GetNextPage() string {
+func (x *CreateWaitlistEntryRequest) GetCustomerId() string {
 	if x != nil {
-		return x.NextPage
+		return x.CustomerId
 	}
 	return ""
 }
 
-type GetCustomerCancellationRankingRequest struct {
+func (x *CreateWaitlistEntryRequest) GetServices() []*AppointmentServiceSelection {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *CreateWaitlistEntryRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+type CreateWaitlistEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerCancellationRankingRequest) Reset() {
-	*x = GetCustomerCancellationRankingRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[41]
+func (x *CreateWaitlistEntryResponse) Reset() {
+	*x = CreateWaitlistEntryResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerCancellationRankingRequest) String() string {
+func (x *CreateWaitlistEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerCancellationRankingRequest) ProtoMessage() {}
+func (*CreateWaitlistEntryResponse) ProtoMessage() {}
 
-func (x *GetCustomerCancellationRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[41]
+func (x *CreateWaitlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,43 +6131,42 @@ func (x *GetCustomerCancellationRankingRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerCancellationRankingRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomerCancellationRankingRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CreateWaitlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateWaitlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetCustomerCancellationRankingRequest) GetPage() *PageRequest {
+func (x *CreateWaitlistEntryResponse) GetEntry() *WaitlistEntry {
 	if x != nil {
-		return x.Page
+		return x.Entry
 	}
 	return nil
 }
 
-type CustomerCancellationRankingItem struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId            string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	CustomerName          string                 `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
-	CustomerSurname       string                 `protobuf:"bytes,3,opt,name=customer_surname,json=customerSurname,proto3" json:"customer_surname,omitempty"`
-	NumberOfCancellations int32                  `protobuf:"varint,4,opt,name=number_of_cancellations,json=numberOfCancellations,proto3" json:"number_of_cancellations,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type ListWaitlistEntriesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CalendarIds []string               `protobuf:"bytes,1,rep,name=calendar_ids,json=calendarIds,proto3" json:"calendar_ids,omitempty"`
+	// Removed entries are listed only when set.
+	IncludeRemoved bool `protobuf:"varint,2,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CustomerCancellationRankingItem) Reset() {
-	*x = CustomerCancellationRankingItem{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[42]
+func (x *ListWaitlistEntriesRequest) Reset() {
+	*x = ListWaitlistEntriesRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CustomerCancellationRankingItem) String() string {
+func (x *ListWaitlistEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomerCancellationRankingItem) ProtoMessage() {}
+func (*ListWaitlistEntriesRequest) ProtoMessage() {}
 
-func (x *CustomerCancellationRankingItem) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[42]
+func (x *ListWaitlistEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,62 +6177,48 @@ func (x *CustomerCancellationRankingItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomerCancellationRankingItem.ProtoReflect.Descriptor instead.
-func (*CustomerCancellationRankingItem) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *CustomerCancellationRankingItem) GetCustomerId() string {
-	if x != nil {
-		return x.CustomerId
-	}
-	return ""
-}
-
-func (x *CustomerCancellationRankingItem) GetCustomerName() string {
-	if x != nil {
-		return x.CustomerName
-	}
-	return ""
+// Deprecated: Use ListWaitlistEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *CustomerCancellationRankingItem) GetCustomerSurname() string {
+func (x *ListWaitlistEntriesRequest) GetCalendarIds() []string {
 	if x != nil {
-		return x.CustomerSurname
+		return x.CalendarIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CustomerCancellationRankingItem) GetNumberOfCancellations() int32 {
+func (x *ListWaitlistEntriesRequest) GetIncludeRemoved() bool {
 	if x != nil {
-		return x.NumberOfCancellations
+		return x.IncludeRemoved
 	}
-	return 0
+	return false
 }
 
-type GetCustomerCancellationRankingResponse struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Items         []*CustomerCancellationRankingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	NextPage      string                             `protobuf:"bytes,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+// Entries are listed oldest first, the order in which offers are made.
+type ListWaitlistEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerCancellationRankingResponse) Reset() {
-	*x = GetCustomerCancellationRankingResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[43]
+func (x *ListWaitlistEntriesResponse) Reset() {
+	*x = ListWaitlistEntriesResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerCancellationRankingResponse) String() string {
+func (x *ListWaitlistEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerCancellationRankingResponse) ProtoMessage() {}
+func (*ListWaitlistEntriesResponse) ProtoMessage() {}
 
-func (x *GetCustomerCancellationRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[43]
+func (x *ListWaitlistEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,84 +6229,40 @@ func (x *GetCustomerCancellationRankingResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerCancellationRankingResponse.ProtoReflect.Descriptor instead.
-func (*GetCustomerCancellationRankingResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListWaitlistEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetCustomerCancellationRankingResponse) GetItems() []*CustomerCancellationRankingItem {
+func (x *ListWaitlistEntriesResponse) GetEntries() []*WaitlistEntry {
 	if x != nil {
-		return x.Items
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *GetCustomerCancellationRankingResponse) GetNextPage() string {
-	if x != nil {
-		return x.NextPage
-	}
-	return ""
-}
-
-type GetInsightOverviewRequest struct {
+type RemoveWaitlistEntryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInsightOverviewRequest) Reset() {
-	*x = GetInsightOverviewRequest{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[44]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetInsightOverviewRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetInsightOverviewRequest) ProtoMessage() {}
-
-func (x *GetInsightOverviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[44]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetInsightOverviewRequest.ProtoReflect.Descriptor instead.
-func (*GetInsightOverviewRequest) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{44}
-}
-
-type CancellationDayOfWeekCount struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	DayOfWeek         string                 `protobuf:"bytes,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
-	CancellationCount int32                  `protobuf:"varint,2,opt,name=cancellation_count,json=cancellationCount,proto3" json:"cancellation_count,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *CancellationDayOfWeekCount) Reset() {
-	*x = CancellationDayOfWeekCount{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[45]
+func (x *RemoveWaitlistEntryRequest) Reset() {
+	*x = RemoveWaitlistEntryRequest{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancellationDayOfWeekCount) String() string {
+func (x *RemoveWaitlistEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancellationDayOfWeekCount) ProtoMessage() {}
+func (*RemoveWaitlistEntryRequest) ProtoMessage() {}
 
-func (x *CancellationDayOfWeekCount) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[45]
+func (x *RemoveWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,47 +6273,39 @@ func (x *CancellationDayOfWeekCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancellationDayOfWeekCount.ProtoReflect.Descriptor instead.
-func (*CancellationDayOfWeekCount) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RemoveWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CancellationDayOfWeekCount) GetDayOfWeek() string {
+func (x *RemoveWaitlistEntryRequest) GetId() string {
 	if x != nil {
-		return x.DayOfWeek
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CancellationDayOfWeekCount) GetCancellationCount() int32 {
-	if x != nil {
-		return x.CancellationCount
-	}
-	return 0
-}
-
-type GetInsightOverviewResponse struct {
-	state                 protoimpl.MessageState        `protogen:"open.v1"`
-	CancellationDayOfWeek []*CancellationDayOfWeekCount `protobuf:"bytes,1,rep,name=cancellation_day_of_week,json=cancellationDayOfWeek,proto3" json:"cancellation_day_of_week,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type RemoveWaitlistEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInsightOverviewResponse) Reset() {
-	*x = GetInsightOverviewResponse{}
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[46]
+func (x *RemoveWaitlistEntryResponse) Reset() {
+	*x = RemoveWaitlistEntryResponse{}
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInsightOverviewResponse) String() string {
+func (x *RemoveWaitlistEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInsightOverviewResponse) ProtoMessage() {}
+func (*RemoveWaitlistEntryResponse) ProtoMessage() {}
 
-func (x *GetInsightOverviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[46]
+func (x *RemoveWaitlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3057,16 +6316,9 @@ func (x *GetInsightOverviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInsightOverviewResponse.ProtoReflect.Descriptor instead.
-func (*GetInsightOverviewResponse) Descriptor() ([]byte, []int) {
-	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *GetInsightOverviewResponse) GetCancellationDayOfWeek() []*CancellationDayOfWeekCount {
-	if x != nil {
-		return x.CancellationDayOfWeek
-	}
-	return nil
+// Deprecated: Use RemoveWaitlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWaitlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP(), []int{90}
 }
 
 var File_beaesthetic_appointment_v1_appointment_api_proto protoreflect.FileDescriptor
@@ -3078,11 +6330,21 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\bstart_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
 	"\x06end_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x1a\n" +
 	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x17\n" +
-	"\aall_day\x18\x04 \x01(\bR\x06allDay\"\x9a\x01\n" +
+	"\aall_day\x18\x04 \x01(\bR\x06allDay\"\xd7\x01\n" +
+	"\x15CalendarEventConflict\x12*\n" +
+	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\x12L\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\x0e2-.beaesthetic.appointment.v1.CalendarEventTypeR\teventType\x12D\n" +
+	"\n" +
+	"time_range\x18\x03 \x01(\v2%.beaesthetic.appointment.v1.TimeRangeR\ttimeRange\"e\n" +
+	"\n" +
+	"Recurrence\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12C\n" +
+	"\x0fexception_dates\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\x0eexceptionDates\"\x9a\x01\n" +
 	"\x19CalendarEventCancellation\x12@\n" +
 	"\x06reason\x18\x01 \x01(\x0e2(.beaesthetic.appointment.v1.CancelReasonR\x06reason\x12;\n" +
 	"\vcanceled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"canceledAt\"\xe8\x06\n" +
+	"canceledAt\"\xb0\a\n" +
 	"\rCalendarEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcalendar_id\x18\x02 \x01(\tR\n" +
@@ -3102,25 +6364,57 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"visibility\x18\v \x01(\x0e23.beaesthetic.appointment.v1.CalendarEventVisibilityR\n" +
 	"visibility\x12\x14\n" +
 	"\x05title\x18\f \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\r \x01(\tR\vdescription\x12Q\n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\x12F\n" +
+	"\n" +
+	"recurrence\x18\x0e \x01(\v2&.beaesthetic.appointment.v1.RecurrenceR\n" +
+	"recurrence\x12Q\n" +
 	"\vappointment\x18\x14 \x01(\v2-.beaesthetic.appointment.v1.AppointmentDetailH\x00R\vappointment\x12R\n" +
 	"\fmanual_event\x18\x15 \x01(\v2-.beaesthetic.appointment.v1.ManualEventDetailH\x00R\vmanualEvent\x12L\n" +
 	"\n" +
 	"time_block\x18\x16 \x01(\v2+.beaesthetic.appointment.v1.TimeBlockDetailH\x00R\ttimeBlockB\b\n" +
-	"\x06detailJ\x04\b\x04\x10\x05J\x04\b\x06\x10\aR\x06statusR\adisplay\"\xf5\x01\n" +
+	"\x06detailJ\x04\b\x04\x10\x05J\x04\b\x06\x10\aR\x06statusR\adisplay\"\xbc\x03\n" +
 	"\x11AppointmentDetail\x12C\n" +
 	"\bcustomer\x18\x01 \x01(\v2'.beaesthetic.appointment.v1.CustomerRefR\bcustomer\x12N\n" +
-	"\bservices\x18\x02 \x03(\v22.beaesthetic.appointment.v1.AppointmentServiceItemR\bservices\x12K\n" +
-	"\breminder\x18\x03 \x01(\v2/.beaesthetic.appointment.v1.AppointmentReminderR\breminder\"Q\n" +
+	"\bservices\x18\x02 \x03(\v22.beaesthetic.appointment.v1.AppointmentServiceItemR\bservices\x12Q\n" +
+	"\n" +
+	"attendance\x18\x04 \x01(\v21.beaesthetic.appointment.v1.AppointmentAttendanceR\n" +
+	"attendance\x12M\n" +
+	"\treminders\x18\x05 \x03(\v2/.beaesthetic.appointment.v1.AppointmentReminderR\treminders\x12`\n" +
+	"\rnotifications\x18\x06 \x01(\v2:.beaesthetic.appointment.v1.AppointmentNotificationSummaryR\rnotificationsJ\x04\b\x03\x10\x04R\breminder\"\xcd\x01\n" +
+	"\x1eAppointmentNotificationSummary\x12\x18\n" +
+	"\apending\x18\x01 \x01(\x05R\apending\x12\x12\n" +
+	"\x04sent\x18\x02 \x01(\x05R\x04sent\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\x05R\aexpired\x12K\n" +
+	"\x06latest\x18\x05 \x01(\v23.beaesthetic.appointment.v1.AppointmentNotificationR\x06latest\"\xaf\x04\n" +
+	"\x17AppointmentNotification\x12'\n" +
+	"\x0fcorrelation_key\x18\x01 \x01(\tR\x0ecorrelationKey\x12K\n" +
+	"\x04kind\x18\x02 \x01(\x0e27.beaesthetic.appointment.v1.AppointmentNotificationKindR\x04kind\x12Q\n" +
+	"\x06status\x18\x03 \x01(\x0e29.beaesthetic.appointment.v1.AppointmentNotificationStatusR\x06status\x120\n" +
+	"\x11reminder_position\x18\x04 \x01(\x05H\x00R\x10reminderPosition\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12%\n" +
+	"\x0efailure_reason\x18\b \x01(\tR\rfailureReason\x12'\n" +
+	"\x0ffailure_message\x18\t \x01(\tR\x0efailureMessageB\x14\n" +
+	"\x12_reminder_position\"\xa5\x01\n" +
+	"\x15AppointmentAttendance\x12O\n" +
+	"\x06status\x18\x01 \x01(\x0e27.beaesthetic.appointment.v1.AppointmentAttendanceStatusR\x06status\x12;\n" +
+	"\vrecorded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"Q\n" +
 	"\vCustomerRef\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\x83\x01\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\xd5\x01\n" +
 	"\x16AppointmentServiceItem\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x01 \x01(\tR\tserviceId\x12!\n" +
 	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12\x1a\n" +
-	"\bposition\x18\x04 \x01(\x05R\bpositionJ\x04\b\x03\x10\x04R\x05price\"\xb4\x03\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\x12%\n" +
+	"\x0ebuffer_minutes\x18\x06 \x01(\x05R\rbufferMinutesJ\x04\b\x03\x10\x04R\x05price\"\xd0\x03\n" +
 	"\x13AppointmentReminder\x12M\n" +
 	"\x06status\x18\x01 \x01(\x0e25.beaesthetic.appointment.v1.AppointmentReminderStatusR\x06status\x122\n" +
 	"\x15remind_before_seconds\x18\x02 \x01(\x05R\x13remindBeforeSeconds\x12=\n" +
@@ -3128,13 +6422,14 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\x11sent_requested_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fsentRequestedAt\x123\n" +
 	"\asent_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x127\n" +
 	"\tfailed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\x12%\n" +
-	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\"g\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\"g\n" +
 	"\x11ManualEventDetail\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
 	"\blocation\x18\x03 \x01(\tR\blocation\")\n" +
 	"\x0fTimeBlockDetail\x12\x16\n" +
-	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xb0\x04\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xf8\x04\n" +
 	"\x1aCreateCalendarEventRequest\x12\x1f\n" +
 	"\vcalendar_id\x18\x01 \x01(\tR\n" +
 	"calendarId\x12D\n" +
@@ -3144,7 +6439,10 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"visibility\x18\x04 \x01(\x0e23.beaesthetic.appointment.v1.CalendarEventVisibilityR\n" +
 	"visibility\x12\x14\n" +
 	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12W\n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12F\n" +
+	"\n" +
+	"recurrence\x18\a \x01(\v2&.beaesthetic.appointment.v1.RecurrenceR\n" +
+	"recurrence\x12W\n" +
 	"\vappointment\x18\x14 \x01(\v23.beaesthetic.appointment.v1.CreateAppointmentDetailH\x00R\vappointment\x12X\n" +
 	"\fmanual_event\x18\x15 \x01(\v23.beaesthetic.appointment.v1.CreateManualEventDetailH\x00R\vmanualEvent\x12R\n" +
 	"\n" +
@@ -3165,15 +6463,17 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
 	"\blocation\x18\x03 \x01(\tR\blocation\"/\n" +
 	"\x15CreateTimeBlockDetail\x12\x16\n" +
-	"\x06reason\x18\x01 \x01(\tR\x06reason\"I\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"\x9a\x01\n" +
 	"\x1bCreateCalendarEventResponse\x12*\n" +
-	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\")\n" +
+	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\x12O\n" +
+	"\tconflicts\x18\x02 \x03(\v21.beaesthetic.appointment.v1.CalendarEventConflictR\tconflicts\")\n" +
 	"\x17GetCalendarEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
 	"\x18GetCalendarEventResponse\x12?\n" +
-	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\"^\n" +
+	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\"\xaf\x01\n" +
 	"\x1bUpdateCalendarEventResponse\x12?\n" +
-	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\"\x97\x02\n" +
+	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\x12O\n" +
+	"\tconflicts\x18\x02 \x03(\v21.beaesthetic.appointment.v1.CalendarEventConflictR\tconflicts\"\xd2\x03\n" +
 	"\x19ListCalendarEventsRequest\x12\x1f\n" +
 	"\vcalendar_id\x18\x01 \x01(\tR\n" +
 	"calendarId\x125\n" +
@@ -3182,9 +6482,17 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\vcustomer_id\x18\x04 \x01(\tR\n" +
 	"customerId\x12N\n" +
 	"\vevent_types\x18\x05 \x03(\x0e2-.beaesthetic.appointment.v1.CalendarEventTypeR\n" +
-	"eventTypes\"_\n" +
+	"eventTypes\x12!\n" +
+	"\fcalendar_ids\x18\x06 \x03(\tR\vcalendarIds\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12\x14\n" +
+	"\x05query\x18\t \x01(\tR\x05query\x12D\n" +
+	"\x05order\x18\n" +
+	" \x01(\x0e2..beaesthetic.appointment.v1.CalendarEventOrderR\x05order\"\x87\x01\n" +
 	"\x1aListCalendarEventsResponse\x12A\n" +
-	"\x06events\x18\x01 \x03(\v2).beaesthetic.appointment.v1.CalendarEventR\x06events\"\x80\x05\n" +
+	"\x06events\x18\x01 \x03(\v2).beaesthetic.appointment.v1.CalendarEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9c\a\n" +
 	"\x1aUpdateCalendarEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
 	"\n" +
@@ -3195,16 +6503,26 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"visibility\x18\x05 \x01(\x0e23.beaesthetic.appointment.v1.CalendarEventVisibilityR\n" +
 	"visibility\x12\x19\n" +
 	"\x05title\x18\x06 \x01(\tH\x01R\x05title\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\a \x01(\tH\x02R\vdescription\x88\x01\x01\x12W\n" +
+	"\vdescription\x18\a \x01(\tH\x02R\vdescription\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\b \x01(\x03H\x03R\x0fexpectedVersion\x88\x01\x01\x12F\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\v2&.beaesthetic.appointment.v1.RecurrenceR\n" +
+	"recurrence\x12J\n" +
+	"\x13occurrence_start_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x11occurrenceStartAt\x12A\n" +
+	"\x05scope\x18\v \x01(\x0e2+.beaesthetic.appointment.v1.RecurrenceScopeR\x05scope\x12W\n" +
 	"\vappointment\x18\x14 \x01(\v23.beaesthetic.appointment.v1.UpdateAppointmentDetailH\x00R\vappointment\x12X\n" +
 	"\fmanual_event\x18\x15 \x01(\v23.beaesthetic.appointment.v1.UpdateManualEventDetailH\x00R\vmanualEvent\x12R\n" +
 	"\n" +
 	"time_block\x18\x16 \x01(\v21.beaesthetic.appointment.v1.UpdateTimeBlockDetailH\x00R\ttimeBlockB\b\n" +
 	"\x06detailB\b\n" +
 	"\x06_titleB\x0e\n" +
-	"\f_descriptionJ\x04\b\x03\x10\x04R\adisplay\"n\n" +
+	"\f_descriptionB\x13\n" +
+	"\x11_expected_versionJ\x04\b\x03\x10\x04R\adisplay\"\xc1\x01\n" +
 	"\x17UpdateAppointmentDetail\x12S\n" +
-	"\bservices\x18\x01 \x03(\v27.beaesthetic.appointment.v1.AppointmentServiceSelectionR\bservices\"\xa3\x01\n" +
+	"\bservices\x18\x01 \x03(\v27.beaesthetic.appointment.v1.AppointmentServiceSelectionR\bservices\x127\n" +
+	"\x15remind_before_seconds\x18\x02 \x01(\x05H\x00R\x13remindBeforeSeconds\x88\x01\x01B\x18\n" +
+	"\x16_remind_before_seconds\"\xa3\x01\n" +
 	"\x17UpdateManualEventDetail\x12\x19\n" +
 	"\x05title\x18\x01 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
@@ -3213,33 +6531,150 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\f_descriptionB\v\n" +
 	"\t_location\"/\n" +
 	"\x15UpdateTimeBlockDetail\x12\x16\n" +
-	"\x06reason\x18\x01 \x01(\tR\x06reason\"n\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xc2\x02\n" +
 	"\x1aCancelCalendarEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12@\n" +
-	"\x06reason\x18\x02 \x01(\x0e2(.beaesthetic.appointment.v1.CancelReasonR\x06reason\"\x1d\n" +
-	"\x1bCancelCalendarEventResponse\"s\n" +
+	"\x06reason\x18\x02 \x01(\x0e2(.beaesthetic.appointment.v1.CancelReasonR\x06reason\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01\x12J\n" +
+	"\x13occurrence_start_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11occurrenceStartAt\x12A\n" +
+	"\x05scope\x18\x05 \x01(\x0e2+.beaesthetic.appointment.v1.RecurrenceScopeR\x05scopeB\x13\n" +
+	"\x11_expected_version\"\x1d\n" +
+	"\x1bCancelCalendarEventResponse\"\x93\x03\n" +
+	"\x1fBulkChangeCalendarEventsRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12N\n" +
+	"\vevent_types\x18\x04 \x03(\x0e2-.beaesthetic.appointment.v1.CalendarEventTypeR\n" +
+	"eventTypes\x12F\n" +
+	"\x06cancel\x18\x05 \x01(\v2,.beaesthetic.appointment.v1.BulkCancelActionH\x00R\x06cancel\x12C\n" +
+	"\x05shift\x18\x06 \x01(\v2+.beaesthetic.appointment.v1.BulkShiftActionH\x00R\x05shiftB\b\n" +
+	"\x06action\"T\n" +
+	"\x10BulkCancelAction\x12@\n" +
+	"\x06reason\x18\x01 \x01(\x0e2(.beaesthetic.appointment.v1.CancelReasonR\x06reason\"0\n" +
+	"\x0fBulkShiftAction\x12\x1d\n" +
+	"\n" +
+	"by_minutes\x18\x01 \x01(\x05R\tbyMinutes\"\x9f\x02\n" +
+	"\x1dBulkChangeCalendarEventResult\x12*\n" +
+	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12D\n" +
+	"\x06status\x18\x03 \x01(\x0e2,.beaesthetic.appointment.v1.BulkChangeStatusR\x06status\x12?\n" +
+	"\x05event\x18\x04 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"w\n" +
+	" BulkChangeCalendarEventsResponse\x12S\n" +
+	"\aresults\x18\x01 \x03(\v29.beaesthetic.appointment.v1.BulkChangeCalendarEventResultR\aresults\"\x94\x01\n" +
+	"!MarkCalendarEventCompletedRequest\x12*\n" +
+	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"e\n" +
+	"\"MarkCalendarEventCompletedResponse\x12?\n" +
+	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\"\x91\x01\n" +
+	"\x1eMarkCalendarEventNoShowRequest\x12*\n" +
+	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"b\n" +
+	"\x1fMarkCalendarEventNoShowResponse\x12?\n" +
+	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\"\xf2\x01\n" +
+	"\x19FindAvailableSlotsRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x1f\n" +
+	"\vservice_ids\x18\x04 \x03(\tR\n" +
+	"serviceIds\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"y\n" +
+	"\rAvailableSlot\x125\n" +
+	"\bstart_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\"]\n" +
+	"\x1aFindAvailableSlotsResponse\x12?\n" +
+	"\x05slots\x18\x01 \x03(\v2).beaesthetic.appointment.v1.AvailableSlotR\x05slots\"\xbb\x01\n" +
 	"\x1cRequestReminderResendRequest\x12*\n" +
 	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\x12'\n" +
-	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\"`\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\x120\n" +
+	"\x11reminder_position\x18\x03 \x01(\x05H\x00R\x10reminderPosition\x88\x01\x01B\x14\n" +
+	"\x12_reminder_position\"`\n" +
 	"\x1dRequestReminderResendResponse\x12?\n" +
-	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\"k\n" +
+	"\x05event\x18\x01 \x01(\v2).beaesthetic.appointment.v1.CalendarEventR\x05event\"S\n" +
+	"%ListCalendarEventNotificationsRequest\x12*\n" +
+	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\"\x83\x01\n" +
+	"&ListCalendarEventNotificationsResponse\x12Y\n" +
+	"\rnotifications\x18\x01 \x03(\v23.beaesthetic.appointment.v1.AppointmentNotificationR\rnotifications\"\xf7\x01\n" +
+	"\bCalendar\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12;\n" +
+	"\varchived_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"P\n" +
+	"\x15CreateCalendarRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05color\x18\x02 \x01(\tH\x00R\x05color\x88\x01\x01B\b\n" +
+	"\x06_color\"Z\n" +
+	"\x16CreateCalendarResponse\x12@\n" +
+	"\bcalendar\x18\x01 \x01(\v2$.beaesthetic.appointment.v1.CalendarR\bcalendar\"A\n" +
+	"\x14ListCalendarsRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"[\n" +
+	"\x15ListCalendarsResponse\x12B\n" +
+	"\tcalendars\x18\x01 \x03(\v2$.beaesthetic.appointment.v1.CalendarR\tcalendars\"n\n" +
+	"\x15UpdateCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x01R\x05color\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_color\"Z\n" +
+	"\x16UpdateCalendarResponse\x12@\n" +
+	"\bcalendar\x18\x01 \x01(\v2$.beaesthetic.appointment.v1.CalendarR\bcalendar\"(\n" +
+	"\x16ArchiveCalendarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x17ArchiveCalendarResponse\x12@\n" +
+	"\bcalendar\x18\x01 \x01(\v2$.beaesthetic.appointment.v1.CalendarR\bcalendar\"^\n" +
+	"\x1aGetCalendarFeedLinkRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\"G\n" +
+	"\x1bGetCalendarFeedLinkResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"S\n" +
+	"\x17ImportTimeBlocksRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03ics\x18\x02 \x01(\tR\x03ics\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xf1\x01\n" +
+	"\x15TimeBlockImportResult\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12*\n" +
+	"\x11calendar_event_id\x18\x02 \x01(\tR\x0fcalendarEventId\x12I\n" +
+	"\x06status\x18\x03 \x01(\x0e21.beaesthetic.appointment.v1.TimeBlockImportStatusR\x06status\x12O\n" +
+	"\tconflicts\x18\x04 \x03(\v21.beaesthetic.appointment.v1.CalendarEventConflictR\tconflicts\"g\n" +
+	"\x18ImportTimeBlocksResponse\x12K\n" +
+	"\aresults\x18\x01 \x03(\v21.beaesthetic.appointment.v1.TimeBlockImportResultR\aresults\"\xbd\x01\n" +
 	"\x0eCatalogService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x14\n" +
-	"\x05color\x18\x05 \x01(\tR\x05colorJ\x04\b\x03\x10\x04R\x05price\"c\n" +
+	"\x05color\x18\x05 \x01(\tR\x05color\x12)\n" +
+	"\x10duration_minutes\x18\x06 \x01(\x05R\x0fdurationMinutes\x12%\n" +
+	"\x0ebuffer_minutes\x18\a \x01(\x05R\rbufferMinutesJ\x04\b\x03\x10\x04R\x05price\"\xb5\x01\n" +
 	"\x14CreateServiceRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x19\n" +
-	"\x05color\x18\x03 \x01(\tH\x00R\x05color\x88\x01\x01B\b\n" +
+	"\x05color\x18\x03 \x01(\tH\x00R\x05color\x88\x01\x01\x12)\n" +
+	"\x10duration_minutes\x18\x04 \x01(\x05R\x0fdurationMinutes\x12%\n" +
+	"\x0ebuffer_minutes\x18\x05 \x01(\x05R\rbufferMinutesB\b\n" +
 	"\x06_color\"]\n" +
 	"\x15CreateServiceResponse\x12D\n" +
-	"\aservice\x18\x01 \x01(\v2*.beaesthetic.appointment.v1.CatalogServiceR\aservice\"_\n" +
+	"\aservice\x18\x01 \x01(\v2*.beaesthetic.appointment.v1.CatalogServiceR\aservice\"\xe3\x01\n" +
 	"\x14UpdateServiceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x19\n" +
-	"\x05color\x18\x03 \x01(\tH\x00R\x05color\x88\x01\x01B\b\n" +
-	"\x06_color\"]\n" +
+	"\x05color\x18\x03 \x01(\tH\x00R\x05color\x88\x01\x01\x12.\n" +
+	"\x10duration_minutes\x18\x04 \x01(\x05H\x01R\x0fdurationMinutes\x88\x01\x01\x12*\n" +
+	"\x0ebuffer_minutes\x18\x05 \x01(\x05H\x02R\rbufferMinutes\x88\x01\x01B\b\n" +
+	"\x06_colorB\x13\n" +
+	"\x11_duration_minutesB\x11\n" +
+	"\x0f_buffer_minutes\"]\n" +
 	"\x15UpdateServiceResponse\x12D\n" +
 	"\aservice\x18\x01 \x01(\v2*.beaesthetic.appointment.v1.CatalogServiceR\aservice\"C\n" +
 	"\x15SearchServicesRequest\x12\x14\n" +
@@ -3276,13 +6711,67 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\x17number_of_cancellations\x18\x04 \x01(\x05R\x15numberOfCancellations\"\x98\x01\n" +
 	"&GetCustomerCancellationRankingResponse\x12Q\n" +
 	"\x05items\x18\x01 \x03(\v2;.beaesthetic.appointment.v1.CustomerCancellationRankingItemR\x05items\x12\x1b\n" +
+	"\tnext_page\x18\x02 \x01(\tR\bnextPage\"^\n" +
+	"\x1fGetCustomerNoShowRankingRequest\x12;\n" +
+	"\x04page\x18\x01 \x01(\v2'.beaesthetic.appointment.v1.PageRequestR\x04page\"\x8f\x02\n" +
+	"\x19CustomerNoShowRankingItem\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12#\n" +
+	"\rcustomer_name\x18\x02 \x01(\tR\fcustomerName\x12)\n" +
+	"\x10customer_surname\x18\x03 \x01(\tR\x0fcustomerSurname\x12+\n" +
+	"\x12number_of_no_shows\x18\x04 \x01(\x05R\x0fnumberOfNoShows\x122\n" +
+	"\x15number_of_attendances\x18\x05 \x01(\x05R\x13numberOfAttendances\x12 \n" +
+	"\fno_show_rate\x18\x06 \x01(\x01R\n" +
+	"noShowRate\"\x8c\x01\n" +
+	" GetCustomerNoShowRankingResponse\x12K\n" +
+	"\x05items\x18\x01 \x03(\v25.beaesthetic.appointment.v1.CustomerNoShowRankingItemR\x05items\x12\x1b\n" +
 	"\tnext_page\x18\x02 \x01(\tR\bnextPage\"\x1b\n" +
 	"\x19GetInsightOverviewRequest\"k\n" +
 	"\x1aCancellationDayOfWeekCount\x12\x1e\n" +
 	"\vday_of_week\x18\x01 \x01(\tR\tdayOfWeek\x12-\n" +
 	"\x12cancellation_count\x18\x02 \x01(\x05R\x11cancellationCount\"\x8d\x01\n" +
 	"\x1aGetInsightOverviewResponse\x12o\n" +
-	"\x18cancellation_day_of_week\x18\x01 \x03(\v26.beaesthetic.appointment.v1.CancellationDayOfWeekCountR\x15cancellationDayOfWeek*\xa1\x01\n" +
+	"\x18cancellation_day_of_week\x18\x01 \x03(\v26.beaesthetic.appointment.v1.CancellationDayOfWeekCountR\x15cancellationDayOfWeek\"\xf7\x01\n" +
+	"\rWaitlistOffer\x12*\n" +
+	"\x11calendar_event_id\x18\x01 \x01(\tR\x0fcalendarEventId\x12D\n" +
+	"\n" +
+	"time_range\x18\x02 \x01(\v2%.beaesthetic.appointment.v1.TimeRangeR\ttimeRange\x129\n" +
+	"\n" +
+	"offered_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tofferedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x9b\x04\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\tR\n" +
+	"calendarId\x12C\n" +
+	"\bcustomer\x18\x03 \x01(\v2'.beaesthetic.appointment.v1.CustomerRefR\bcustomer\x12N\n" +
+	"\bservices\x18\x04 \x03(\v22.beaesthetic.appointment.v1.AppointmentServiceItemR\bservices\x12D\n" +
+	"\n" +
+	"time_range\x18\x05 \x01(\v2%.beaesthetic.appointment.v1.TimeRangeR\ttimeRange\x12G\n" +
+	"\x06status\x18\x06 \x01(\x0e2/.beaesthetic.appointment.v1.WaitlistEntryStatusR\x06status\x12?\n" +
+	"\x05offer\x18\a \x01(\v2).beaesthetic.appointment.v1.WaitlistOfferR\x05offer\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf9\x01\n" +
+	"\x1aCreateWaitlistEntryRequest\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\tR\n" +
+	"calendarId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12S\n" +
+	"\bservices\x18\x03 \x03(\v27.beaesthetic.appointment.v1.AppointmentServiceSelectionR\bservices\x12D\n" +
+	"\n" +
+	"time_range\x18\x04 \x01(\v2%.beaesthetic.appointment.v1.TimeRangeR\ttimeRange\"^\n" +
+	"\x1bCreateWaitlistEntryResponse\x12?\n" +
+	"\x05entry\x18\x01 \x01(\v2).beaesthetic.appointment.v1.WaitlistEntryR\x05entry\"h\n" +
+	"\x1aListWaitlistEntriesRequest\x12!\n" +
+	"\fcalendar_ids\x18\x01 \x03(\tR\vcalendarIds\x12'\n" +
+	"\x0finclude_removed\x18\x02 \x01(\bR\x0eincludeRemoved\"b\n" +
+	"\x1bListWaitlistEntriesResponse\x12C\n" +
+	"\aentries\x18\x01 \x03(\v2).beaesthetic.appointment.v1.WaitlistEntryR\aentries\",\n" +
+	"\x1aRemoveWaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bRemoveWaitlistEntryResponse*\xa1\x01\n" +
 	"\x11CalendarEventType\x12#\n" +
 	"\x1fCALENDAR_EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fCALENDAR_EVENT_TYPE_APPOINTMENT\x10\x01\x12\x1e\n" +
@@ -3291,7 +6780,19 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\x17CalendarEventVisibility\x12)\n" +
 	"%CALENDAR_EVENT_VISIBILITY_UNSPECIFIED\x10\x00\x12$\n" +
 	" CALENDAR_EVENT_VISIBILITY_PUBLIC\x10\x01\x12%\n" +
-	"!CALENDAR_EVENT_VISIBILITY_PRIVATE\x10\x02*\xf2\x02\n" +
+	"!CALENDAR_EVENT_VISIBILITY_PRIVATE\x10\x02*\x83\x02\n" +
+	"\x1bAppointmentNotificationKind\x12-\n" +
+	")APPOINTMENT_NOTIFICATION_KIND_UNSPECIFIED\x10\x00\x12.\n" +
+	"*APPOINTMENT_NOTIFICATION_KIND_CONFIRMATION\x10\x01\x12-\n" +
+	")APPOINTMENT_NOTIFICATION_KIND_RESCHEDULED\x10\x02\x12*\n" +
+	"&APPOINTMENT_NOTIFICATION_KIND_REMINDER\x10\x03\x12*\n" +
+	"&APPOINTMENT_NOTIFICATION_KIND_CANCELED\x10\x04*\x80\x02\n" +
+	"\x1dAppointmentNotificationStatus\x12/\n" +
+	"+APPOINTMENT_NOTIFICATION_STATUS_UNSPECIFIED\x10\x00\x12+\n" +
+	"'APPOINTMENT_NOTIFICATION_STATUS_PENDING\x10\x01\x12(\n" +
+	"$APPOINTMENT_NOTIFICATION_STATUS_SENT\x10\x02\x12*\n" +
+	"&APPOINTMENT_NOTIFICATION_STATUS_FAILED\x10\x03\x12+\n" +
+	"'APPOINTMENT_NOTIFICATION_STATUS_EXPIRED\x10\x04*\xf2\x02\n" +
 	"\x19AppointmentReminderStatus\x12+\n" +
 	"'APPOINTMENT_REMINDER_STATUS_UNSPECIFIED\x10\x00\x12'\n" +
 	"#APPOINTMENT_REMINDER_STATUS_PENDING\x10\x01\x12)\n" +
@@ -3304,23 +6805,69 @@ const file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc = "" +
 	"\fCancelReason\x12\x1d\n" +
 	"\x19CANCEL_REASON_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15CANCEL_REASON_DELETED\x10\x01\x12!\n" +
-	"\x1dCANCEL_REASON_CUSTOMER_CANCEL\x10\x022\xab\b\n" +
+	"\x1dCANCEL_REASON_CUSTOMER_CANCEL\x10\x02*\x91\x01\n" +
+	"\x0fRecurrenceScope\x12 \n" +
+	"\x1cRECURRENCE_SCOPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14RECURRENCE_SCOPE_ALL\x10\x01\x12\x19\n" +
+	"\x15RECURRENCE_SCOPE_THIS\x10\x02\x12'\n" +
+	"#RECURRENCE_SCOPE_THIS_AND_FOLLOWING\x10\x03*\xa4\x01\n" +
+	"\x1bAppointmentAttendanceStatus\x12-\n" +
+	")APPOINTMENT_ATTENDANCE_STATUS_UNSPECIFIED\x10\x00\x12+\n" +
+	"'APPOINTMENT_ATTENDANCE_STATUS_COMPLETED\x10\x01\x12)\n" +
+	"%APPOINTMENT_ATTENDANCE_STATUS_NO_SHOW\x10\x02*\x83\x01\n" +
+	"\x12CalendarEventOrder\x12$\n" +
+	" CALENDAR_EVENT_ORDER_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCALENDAR_EVENT_ORDER_START_ASC\x10\x01\x12#\n" +
+	"\x1fCALENDAR_EVENT_ORDER_START_DESC\x10\x02*\x98\x01\n" +
+	"\x10BulkChangeStatus\x12\"\n" +
+	"\x1eBULK_CHANGE_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bBULK_CHANGE_STATUS_CANCELED\x10\x01\x12\x1e\n" +
+	"\x1aBULK_CHANGE_STATUS_SHIFTED\x10\x02\x12\x1f\n" +
+	"\x1bBULK_CHANGE_STATUS_REJECTED\x10\x03*\x82\x02\n" +
+	"\x15TimeBlockImportStatus\x12(\n" +
+	"$TIME_BLOCK_IMPORT_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" TIME_BLOCK_IMPORT_STATUS_CREATED\x10\x01\x12$\n" +
+	" TIME_BLOCK_IMPORT_STATUS_UPDATED\x10\x02\x12&\n" +
+	"\"TIME_BLOCK_IMPORT_STATUS_UNCHANGED\x10\x03\x12%\n" +
+	"!TIME_BLOCK_IMPORT_STATUS_CANCELED\x10\x04\x12$\n" +
+	" TIME_BLOCK_IMPORT_STATUS_SKIPPED\x10\x05*\xa5\x01\n" +
+	"\x13WaitlistEntryStatus\x12%\n" +
+	"!WAITLIST_ENTRY_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dWAITLIST_ENTRY_STATUS_WAITING\x10\x01\x12!\n" +
+	"\x1dWAITLIST_ENTRY_STATUS_OFFERED\x10\x02\x12!\n" +
+	"\x1dWAITLIST_ENTRY_STATUS_REMOVED\x10\x032\xde\x17\n" +
 	"\x0fCalendarService\x12\xa6\x01\n" +
 	"\x13CreateCalendarEvent\x126.beaesthetic.appointment.v1.CreateCalendarEventRequest\x1a7.beaesthetic.appointment.v1.CreateCalendarEventResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/calendar-events\x12\x9f\x01\n" +
 	"\x10GetCalendarEvent\x123.beaesthetic.appointment.v1.GetCalendarEventRequest\x1a4.beaesthetic.appointment.v1.GetCalendarEventResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/calendar-events/{id}\x12\xa0\x01\n" +
 	"\x12ListCalendarEvents\x125.beaesthetic.appointment.v1.ListCalendarEventsRequest\x1a6.beaesthetic.appointment.v1.ListCalendarEventsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/calendar-events\x12\xab\x01\n" +
 	"\x13UpdateCalendarEvent\x126.beaesthetic.appointment.v1.UpdateCalendarEventRequest\x1a7.beaesthetic.appointment.v1.UpdateCalendarEventResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*2\x18/v1/calendar-events/{id}\x12\xa8\x01\n" +
-	"\x13CancelCalendarEvent\x126.beaesthetic.appointment.v1.CancelCalendarEventRequest\x1a7.beaesthetic.appointment.v1.CancelCalendarEventResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/calendar-events/{id}\x12\xd0\x01\n" +
-	"\x15RequestReminderResend\x128.beaesthetic.appointment.v1.RequestReminderResendRequest\x1a9.beaesthetic.appointment.v1.RequestReminderResendResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/v1/calendar-events/{calendar_event_id}/reminder/resend2\xdd\x04\n" +
+	"\x13CancelCalendarEvent\x126.beaesthetic.appointment.v1.CancelCalendarEventRequest\x1a7.beaesthetic.appointment.v1.CancelCalendarEventResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/calendar-events/{id}\x12\xa0\x01\n" +
+	"\x12FindAvailableSlots\x125.beaesthetic.appointment.v1.FindAvailableSlotsRequest\x1a6.beaesthetic.appointment.v1.FindAvailableSlotsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/available-slots\x12\xd0\x01\n" +
+	"\x15RequestReminderResend\x128.beaesthetic.appointment.v1.RequestReminderResendRequest\x1a9.beaesthetic.appointment.v1.RequestReminderResendResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/v1/calendar-events/{calendar_event_id}/reminder/resend\x12\xe6\x01\n" +
+	"\x1eListCalendarEventNotifications\x12A.beaesthetic.appointment.v1.ListCalendarEventNotificationsRequest\x1aB.beaesthetic.appointment.v1.ListCalendarEventNotificationsResponse\"=\x82\xd3\xe4\x93\x027\x125/v1/calendar-events/{calendar_event_id}/notifications\x12\xc0\x01\n" +
+	"\x18BulkChangeCalendarEvents\x12;.beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest\x1a<.beaesthetic.appointment.v1.BulkChangeCalendarEventsResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/calendar-events:bulkChange\x12\xd8\x01\n" +
+	"\x1aMarkCalendarEventCompleted\x12=.beaesthetic.appointment.v1.MarkCalendarEventCompletedRequest\x1a>.beaesthetic.appointment.v1.MarkCalendarEventCompletedResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/calendar-events/{calendar_event_id}/complete\x12\xce\x01\n" +
+	"\x17MarkCalendarEventNoShow\x12:.beaesthetic.appointment.v1.MarkCalendarEventNoShowRequest\x1a;.beaesthetic.appointment.v1.MarkCalendarEventNoShowResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/calendar-events/{calendar_event_id}/no-show\x12\x91\x01\n" +
+	"\x0eCreateCalendar\x121.beaesthetic.appointment.v1.CreateCalendarRequest\x1a2.beaesthetic.appointment.v1.CreateCalendarResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/calendars\x12\x8b\x01\n" +
+	"\rListCalendars\x120.beaesthetic.appointment.v1.ListCalendarsRequest\x1a1.beaesthetic.appointment.v1.ListCalendarsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/calendars\x12\x96\x01\n" +
+	"\x0eUpdateCalendar\x121.beaesthetic.appointment.v1.UpdateCalendarRequest\x1a2.beaesthetic.appointment.v1.UpdateCalendarResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/v1/calendars/{id}\x12\x9e\x01\n" +
+	"\x0fArchiveCalendar\x122.beaesthetic.appointment.v1.ArchiveCalendarRequest\x1a3.beaesthetic.appointment.v1.ArchiveCalendarResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/calendars/{id}/archive\x12\xa7\x01\n" +
+	"\x13GetCalendarFeedLink\x126.beaesthetic.appointment.v1.GetCalendarFeedLinkRequest\x1a7.beaesthetic.appointment.v1.GetCalendarFeedLinkResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/calendar-feeds:link\x12\xaf\x01\n" +
+	"\x10ImportTimeBlocks\x123.beaesthetic.appointment.v1.ImportTimeBlocksRequest\x1a4.beaesthetic.appointment.v1.ImportTimeBlocksResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/calendars/{id}/time-block-imports2\xdd\x04\n" +
 	"\x15ServiceCatalogService\x12\x8d\x01\n" +
 	"\rCreateService\x120.beaesthetic.appointment.v1.CreateServiceRequest\x1a1.beaesthetic.appointment.v1.CreateServiceResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/services\x12\x92\x01\n" +
 	"\rUpdateService\x120.beaesthetic.appointment.v1.UpdateServiceRequest\x1a1.beaesthetic.appointment.v1.UpdateServiceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/services/{id}\x12\x94\x01\n" +
 	"\x0eSearchServices\x121.beaesthetic.appointment.v1.SearchServicesRequest\x1a2.beaesthetic.appointment.v1.SearchServicesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/services:search\x12\x87\x01\n" +
-	"\fListServices\x12/.beaesthetic.appointment.v1.ListServicesRequest\x1a0.beaesthetic.appointment.v1.ListServicesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/services2\xd1\x03\n" +
-	"\x19AppointmentInsightService\x12\x83\x01\n" +
-	"\x12GetCustomerRanking\x125.beaesthetic.appointment.v1.GetCustomerRankingRequest\x1a6.beaesthetic.appointment.v1.GetCustomerRankingResponse\x12\xa7\x01\n" +
-	"\x1eGetCustomerCancellationRanking\x12A.beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest\x1aB.beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse\x12\x83\x01\n" +
-	"\x12GetInsightOverview\x125.beaesthetic.appointment.v1.GetInsightOverviewRequest\x1a6.beaesthetic.appointment.v1.GetInsightOverviewResponseBUZSgithub.com/petretiandrea/beaesthetic-backend/core-contracts/appointment;appointmentb\x06proto3"
+	"\fListServices\x12/.beaesthetic.appointment.v1.ListServicesRequest\x1a0.beaesthetic.appointment.v1.ListServicesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/services2\x92\x06\n" +
+	"\x19AppointmentInsightService\x12\xaa\x01\n" +
+	"\x12GetCustomerRanking\x125.beaesthetic.appointment.v1.GetCustomerRankingRequest\x1a6.beaesthetic.appointment.v1.GetCustomerRankingResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/insights/customer-ranking\x12\xdb\x01\n" +
+	"\x1eGetCustomerCancellationRanking\x12A.beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest\x1aB.beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/insights/customer-cancellation-ranking\x12\xc4\x01\n" +
+	"\x18GetCustomerNoShowRanking\x12;.beaesthetic.appointment.v1.GetCustomerNoShowRankingRequest\x1a<.beaesthetic.appointment.v1.GetCustomerNoShowRankingResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/insights/customer-no-show-ranking\x12\xa2\x01\n" +
+	"\x12GetInsightOverview\x125.beaesthetic.appointment.v1.GetInsightOverviewRequest\x1a6.beaesthetic.appointment.v1.GetInsightOverviewResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/insights/overview2\x8e\x04\n" +
+	"\x0fWaitlistService\x12\xa7\x01\n" +
+	"\x13CreateWaitlistEntry\x126.beaesthetic.appointment.v1.CreateWaitlistEntryRequest\x1a7.beaesthetic.appointment.v1.CreateWaitlistEntryResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/waitlist-entries\x12\xa4\x01\n" +
+	"\x13ListWaitlistEntries\x126.beaesthetic.appointment.v1.ListWaitlistEntriesRequest\x1a7.beaesthetic.appointment.v1.ListWaitlistEntriesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/waitlist-entries\x12\xa9\x01\n" +
+	"\x13RemoveWaitlistEntry\x126.beaesthetic.appointment.v1.RemoveWaitlistEntryRequest\x1a7.beaesthetic.appointment.v1.RemoveWaitlistEntryResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/waitlist-entries/{id}BUZSgithub.com/petretiandrea/beaesthetic-backend/core-contracts/appointment;appointmentb\x06proto3"
 
 var (
 	file_beaesthetic_appointment_v1_appointment_api_proto_rawDescOnce sync.Once
@@ -3334,146 +6881,295 @@ func file_beaesthetic_appointment_v1_appointment_api_proto_rawDescGZIP() []byte
 	return file_beaesthetic_appointment_v1_appointment_api_proto_rawDescData
 }
 
-var file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_beaesthetic_appointment_v1_appointment_api_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
 var file_beaesthetic_appointment_v1_appointment_api_proto_goTypes = []any{
 	(CalendarEventType)(0),                         // 0: beaesthetic.appointment.v1.CalendarEventType
 	(CalendarEventVisibility)(0),                   // 1: beaesthetic.appointment.v1.CalendarEventVisibility
-	(AppointmentReminderStatus)(0),                 // 2: beaesthetic.appointment.v1.AppointmentReminderStatus
-	(CancelReason)(0),                              // 3: beaesthetic.appointment.v1.CancelReason
-	(*TimeRange)(nil),                              // 4: beaesthetic.appointment.v1.TimeRange
-	(*CalendarEventCancellation)(nil),              // 5: beaesthetic.appointment.v1.CalendarEventCancellation
-	(*CalendarEvent)(nil),                          // 6: beaesthetic.appointment.v1.CalendarEvent
-	(*AppointmentDetail)(nil),                      // 7: beaesthetic.appointment.v1.AppointmentDetail
-	(*CustomerRef)(nil),                            // 8: beaesthetic.appointment.v1.CustomerRef
-	(*AppointmentServiceItem)(nil),                 // 9: beaesthetic.appointment.v1.AppointmentServiceItem
-	(*AppointmentReminder)(nil),                    // 10: beaesthetic.appointment.v1.AppointmentReminder
-	(*ManualEventDetail)(nil),                      // 11: beaesthetic.appointment.v1.ManualEventDetail
-	(*TimeBlockDetail)(nil),                        // 12: beaesthetic.appointment.v1.TimeBlockDetail
-	(*CreateCalendarEventRequest)(nil),             // 13: beaesthetic.appointment.v1.CreateCalendarEventRequest
-	(*CreateAppointmentDetail)(nil),                // 14: beaesthetic.appointment.v1.CreateAppointmentDetail
-	(*AppointmentServiceSelection)(nil),            // 15: beaesthetic.appointment.v1.AppointmentServiceSelection
-	(*CreateManualEventDetail)(nil),                // 16: beaesthetic.appointment.v1.CreateManualEventDetail
-	(*CreateTimeBlockDetail)(nil),                  // 17: beaesthetic.appointment.v1.CreateTimeBlockDetail
-	(*CreateCalendarEventResponse)(nil),            // 18: beaesthetic.appointment.v1.CreateCalendarEventResponse
-	(*GetCalendarEventRequest)(nil),                // 19: beaesthetic.appointment.v1.GetCalendarEventRequest
-	(*GetCalendarEventResponse)(nil),               // 20: beaesthetic.appointment.v1.GetCalendarEventResponse
-	(*UpdateCalendarEventResponse)(nil),            // 21: beaesthetic.appointment.v1.UpdateCalendarEventResponse
-	(*ListCalendarEventsRequest)(nil),              // 22: beaesthetic.appointment.v1.ListCalendarEventsRequest
-	(*ListCalendarEventsResponse)(nil),             // 23: beaesthetic.appointment.v1.ListCalendarEventsResponse
-	(*UpdateCalendarEventRequest)(nil),             // 24: beaesthetic.appointment.v1.UpdateCalendarEventRequest
-	(*UpdateAppointmentDetail)(nil),                // 25: beaesthetic.appointment.v1.UpdateAppointmentDetail
-	(*UpdateManualEventDetail)(nil),                // 26: beaesthetic.appointment.v1.UpdateManualEventDetail
-	(*UpdateTimeBlockDetail)(nil),                  // 27: beaesthetic.appointment.v1.UpdateTimeBlockDetail
-	(*CancelCalendarEventRequest)(nil),             // 28: beaesthetic.appointment.v1.CancelCalendarEventRequest
-	(*CancelCalendarEventResponse)(nil),            // 29: beaesthetic.appointment.v1.CancelCalendarEventResponse
-	(*RequestReminderResendRequest)(nil),           // 30: beaesthetic.appointment.v1.RequestReminderResendRequest
-	(*RequestReminderResendResponse)(nil),          // 31: beaesthetic.appointment.v1.RequestReminderResendResponse
-	(*CatalogService)(nil),                         // 32: beaesthetic.appointment.v1.CatalogService
-	(*CreateServiceRequest)(nil),                   // 33: beaesthetic.appointment.v1.CreateServiceRequest
-	(*CreateServiceResponse)(nil),                  // 34: beaesthetic.appointment.v1.CreateServiceResponse
-	(*UpdateServiceRequest)(nil),                   // 35: beaesthetic.appointment.v1.UpdateServiceRequest
-	(*UpdateServiceResponse)(nil),                  // 36: beaesthetic.appointment.v1.UpdateServiceResponse
-	(*SearchServicesRequest)(nil),                  // 37: beaesthetic.appointment.v1.SearchServicesRequest
-	(*SearchServicesResponse)(nil),                 // 38: beaesthetic.appointment.v1.SearchServicesResponse
-	(*ListServicesRequest)(nil),                    // 39: beaesthetic.appointment.v1.ListServicesRequest
-	(*ListServicesResponse)(nil),                   // 40: beaesthetic.appointment.v1.ListServicesResponse
-	(*PageRequest)(nil),                            // 41: beaesthetic.appointment.v1.PageRequest
-	(*GetCustomerRankingRequest)(nil),              // 42: beaesthetic.appointment.v1.GetCustomerRankingRequest
-	(*CustomerRankingItem)(nil),                    // 43: beaesthetic.appointment.v1.CustomerRankingItem
-	(*GetCustomerRankingResponse)(nil),             // 44: beaesthetic.appointment.v1.GetCustomerRankingResponse
-	(*GetCustomerCancellationRankingRequest)(nil),  // 45: beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest
-	(*CustomerCancellationRankingItem)(nil),        // 46: beaesthetic.appointment.v1.CustomerCancellationRankingItem
-	(*GetCustomerCancellationRankingResponse)(nil), // 47: beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse
-	(*GetInsightOverviewRequest)(nil),              // 48: beaesthetic.appointment.v1.GetInsightOverviewRequest
-	(*CancellationDayOfWeekCount)(nil),             // 49: beaesthetic.appointment.v1.CancellationDayOfWeekCount
-	(*GetInsightOverviewResponse)(nil),             // 50: beaesthetic.appointment.v1.GetInsightOverviewResponse
-	(*timestamppb.Timestamp)(nil),                  // 51: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),                  // 52: google.protobuf.FieldMask
+	(AppointmentNotificationKind)(0),               // 2: beaesthetic.appointment.v1.AppointmentNotificationKind
+	(AppointmentNotificationStatus)(0),             // 3: beaesthetic.appointment.v1.AppointmentNotificationStatus
+	(AppointmentReminderStatus)(0),                 // 4: beaesthetic.appointment.v1.AppointmentReminderStatus
+	(CancelReason)(0),                              // 5: beaesthetic.appointment.v1.CancelReason
+	(RecurrenceScope)(0),                           // 6: beaesthetic.appointment.v1.RecurrenceScope
+	(AppointmentAttendanceStatus)(0),               // 7: beaesthetic.appointment.v1.AppointmentAttendanceStatus
+	(CalendarEventOrder)(0),                        // 8: beaesthetic.appointment.v1.CalendarEventOrder
+	(BulkChangeStatus)(0),                          // 9: beaesthetic.appointment.v1.BulkChangeStatus
+	(TimeBlockImportStatus)(0),                     // 10: beaesthetic.appointment.v1.TimeBlockImportStatus
+	(WaitlistEntryStatus)(0),                       // 11: beaesthetic.appointment.v1.WaitlistEntryStatus
+	(*TimeRange)(nil),                              // 12: beaesthetic.appointment.v1.TimeRange
+	(*CalendarEventConflict)(nil),                  // 13: beaesthetic.appointment.v1.CalendarEventConflict
+	(*Recurrence)(nil),                             // 14: beaesthetic.appointment.v1.Recurrence
+	(*CalendarEventCancellation)(nil),              // 15: beaesthetic.appointment.v1.CalendarEventCancellation
+	(*CalendarEvent)(nil),                          // 16: beaesthetic.appointment.v1.CalendarEvent
+	(*AppointmentDetail)(nil),                      // 17: beaesthetic.appointment.v1.AppointmentDetail
+	(*AppointmentNotificationSummary)(nil),         // 18: beaesthetic.appointment.v1.AppointmentNotificationSummary
+	(*AppointmentNotification)(nil),                // 19: beaesthetic.appointment.v1.AppointmentNotification
+	(*AppointmentAttendance)(nil),                  // 20: beaesthetic.appointment.v1.AppointmentAttendance
+	(*CustomerRef)(nil),                            // 21: beaesthetic.appointment.v1.CustomerRef
+	(*AppointmentServiceItem)(nil),                 // 22: beaesthetic.appointment.v1.AppointmentServiceItem
+	(*AppointmentReminder)(nil),                    // 23: beaesthetic.appointment.v1.AppointmentReminder
+	(*ManualEventDetail)(nil),                      // 24: beaesthetic.appointment.v1.ManualEventDetail
+	(*TimeBlockDetail)(nil),                        // 25: beaesthetic.appointment.v1.TimeBlockDetail
+	(*CreateCalendarEventRequest)(nil),             // 26: beaesthetic.appointment.v1.CreateCalendarEventRequest
+	(*CreateAppointmentDetail)(nil),                // 27: beaesthetic.appointment.v1.CreateAppointmentDetail
+	(*AppointmentServiceSelection)(nil),            // 28: beaesthetic.appointment.v1.AppointmentServiceSelection
+	(*CreateManualEventDetail)(nil),                // 29: beaesthetic.appointment.v1.CreateManualEventDetail
+	(*CreateTimeBlockDetail)(nil),                  // 30: beaesthetic.appointment.v1.CreateTimeBlockDetail
+	(*CreateCalendarEventResponse)(nil),            // 31: beaesthetic.appointment.v1.CreateCalendarEventResponse
+	(*GetCalendarEventRequest)(nil),                // 32: beaesthetic.appointment.v1.GetCalendarEventRequest
+	(*GetCalendarEventResponse)(nil),               // 33: beaesthetic.appointment.v1.GetCalendarEventResponse
+	(*UpdateCalendarEventResponse)(nil),            // 34: beaesthetic.appointment.v1.UpdateCalendarEventResponse
+	(*ListCalendarEventsRequest)(nil),              // 35: beaesthetic.appointment.v1.ListCalendarEventsRequest
+	(*ListCalendarEventsResponse)(nil),             // 36: beaesthetic.appointment.v1.ListCalendarEventsResponse
+	(*UpdateCalendarEventRequest)(nil),             // 37: beaesthetic.appointment.v1.UpdateCalendarEventRequest
+	(*UpdateAppointmentDetail)(nil),                // 38: beaesthetic.appointment.v1.UpdateAppointmentDetail
+	(*UpdateManualEventDetail)(nil),                // 39: beaesthetic.appointment.v1.UpdateManualEventDetail
+	(*UpdateTimeBlockDetail)(nil),                  // 40: beaesthetic.appointment.v1.UpdateTimeBlockDetail
+	(*CancelCalendarEventRequest)(nil),             // 41: beaesthetic.appointment.v1.CancelCalendarEventRequest
+	(*CancelCalendarEventResponse)(nil),            // 42: beaesthetic.appointment.v1.CancelCalendarEventResponse
+	(*BulkChangeCalendarEventsRequest)(nil),        // 43: beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest
+	(*BulkCancelAction)(nil),                       // 44: beaesthetic.appointment.v1.BulkCancelAction
+	(*BulkShiftAction)(nil),                        // 45: beaesthetic.appointment.v1.BulkShiftAction
+	(*BulkChangeCalendarEventResult)(nil),          // 46: beaesthetic.appointment.v1.BulkChangeCalendarEventResult
+	(*BulkChangeCalendarEventsResponse)(nil),       // 47: beaesthetic.appointment.v1.BulkChangeCalendarEventsResponse
+	(*MarkCalendarEventCompletedRequest)(nil),      // 48: beaesthetic.appointment.v1.MarkCalendarEventCompletedRequest
+	(*MarkCalendarEventCompletedResponse)(nil),     // 49: beaesthetic.appointment.v1.MarkCalendarEventCompletedResponse
+	(*MarkCalendarEventNoShowRequest)(nil),         // 50: beaesthetic.appointment.v1.MarkCalendarEventNoShowRequest
+	(*MarkCalendarEventNoShowResponse)(nil),        // 51: beaesthetic.appointment.v1.MarkCalendarEventNoShowResponse
+	(*FindAvailableSlotsRequest)(nil),              // 52: beaesthetic.appointment.v1.FindAvailableSlotsRequest
+	(*AvailableSlot)(nil),                          // 53: beaesthetic.appointment.v1.AvailableSlot
+	(*FindAvailableSlotsResponse)(nil),             // 54: beaesthetic.appointment.v1.FindAvailableSlotsResponse
+	(*RequestReminderResendRequest)(nil),           // 55: beaesthetic.appointment.v1.RequestReminderResendRequest
+	(*RequestReminderResendResponse)(nil),          // 56: beaesthetic.appointment.v1.RequestReminderResendResponse
+	(*ListCalendarEventNotificationsRequest)(nil),  // 57: beaesthetic.appointment.v1.ListCalendarEventNotificationsRequest
+	(*ListCalendarEventNotificationsResponse)(nil), // 58: beaesthetic.appointment.v1.ListCalendarEventNotificationsResponse
+	(*Calendar)(nil),                               // 59: beaesthetic.appointment.v1.Calendar
+	(*CreateCalendarRequest)(nil),                  // 60: beaesthetic.appointment.v1.CreateCalendarRequest
+	(*CreateCalendarResponse)(nil),                 // 61: beaesthetic.appointment.v1.CreateCalendarResponse
+	(*ListCalendarsRequest)(nil),                   // 62: beaesthetic.appointment.v1.ListCalendarsRequest
+	(*ListCalendarsResponse)(nil),                  // 63: beaesthetic.appointment.v1.ListCalendarsResponse
+	(*UpdateCalendarRequest)(nil),                  // 64: beaesthetic.appointment.v1.UpdateCalendarRequest
+	(*UpdateCalendarResponse)(nil),                 // 65: beaesthetic.appointment.v1.UpdateCalendarResponse
+	(*ArchiveCalendarRequest)(nil),                 // 66: beaesthetic.appointment.v1.ArchiveCalendarRequest
+	(*ArchiveCalendarResponse)(nil),                // 67: beaesthetic.appointment.v1.ArchiveCalendarResponse
+	(*GetCalendarFeedLinkRequest)(nil),             // 68: beaesthetic.appointment.v1.GetCalendarFeedLinkRequest
+	(*GetCalendarFeedLinkResponse)(nil),            // 69: beaesthetic.appointment.v1.GetCalendarFeedLinkResponse
+	(*ImportTimeBlocksRequest)(nil),                // 70: beaesthetic.appointment.v1.ImportTimeBlocksRequest
+	(*TimeBlockImportResult)(nil),                  // 71: beaesthetic.appointment.v1.TimeBlockImportResult
+	(*ImportTimeBlocksResponse)(nil),               // 72: beaesthetic.appointment.v1.ImportTimeBlocksResponse
+	(*CatalogService)(nil),                         // 73: beaesthetic.appointment.v1.CatalogService
+	(*CreateServiceRequest)(nil),                   // 74: beaesthetic.appointment.v1.CreateServiceRequest
+	(*CreateServiceResponse)(nil),                  // 75: beaesthetic.appointment.v1.CreateServiceResponse
+	(*UpdateServiceRequest)(nil),                   // 76: beaesthetic.appointment.v1.UpdateServiceRequest
+	(*UpdateServiceResponse)(nil),                  // 77: beaesthetic.appointment.v1.UpdateServiceResponse
+	(*SearchServicesRequest)(nil),                  // 78: beaesthetic.appointment.v1.SearchServicesRequest
+	(*SearchServicesResponse)(nil),                 // 79: beaesthetic.appointment.v1.SearchServicesResponse
+	(*ListServicesRequest)(nil),                    // 80: beaesthetic.appointment.v1.ListServicesRequest
+	(*ListServicesResponse)(nil),                   // 81: beaesthetic.appointment.v1.ListServicesResponse
+	(*PageRequest)(nil),                            // 82: beaesthetic.appointment.v1.PageRequest
+	(*GetCustomerRankingRequest)(nil),              // 83: beaesthetic.appointment.v1.GetCustomerRankingRequest
+	(*CustomerRankingItem)(nil),                    // 84: beaesthetic.appointment.v1.CustomerRankingItem
+	(*GetCustomerRankingResponse)(nil),             // 85: beaesthetic.appointment.v1.GetCustomerRankingResponse
+	(*GetCustomerCancellationRankingRequest)(nil),  // 86: beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest
+	(*CustomerCancellationRankingItem)(nil),        // 87: beaesthetic.appointment.v1.CustomerCancellationRankingItem
+	(*GetCustomerCancellationRankingResponse)(nil), // 88: beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse
+	(*GetCustomerNoShowRankingRequest)(nil),        // 89: beaesthetic.appointment.v1.GetCustomerNoShowRankingRequest
+	(*CustomerNoShowRankingItem)(nil),              // 90: beaesthetic.appointment.v1.CustomerNoShowRankingItem
+	(*GetCustomerNoShowRankingResponse)(nil),       // 91: beaesthetic.appointment.v1.GetCustomerNoShowRankingResponse
+	(*GetInsightOverviewRequest)(nil),              // 92: beaesthetic.appointment.v1.GetInsightOverviewRequest
+	(*CancellationDayOfWeekCount)(nil),             // 93: beaesthetic.appointment.v1.CancellationDayOfWeekCount
+	(*GetInsightOverviewResponse)(nil),             // 94: beaesthetic.appointment.v1.GetInsightOverviewResponse
+	(*WaitlistOffer)(nil),                          // 95: beaesthetic.appointment.v1.WaitlistOffer
+	(*WaitlistEntry)(nil),                          // 96: beaesthetic.appointment.v1.WaitlistEntry
+	(*CreateWaitlistEntryRequest)(nil),             // 97: beaesthetic.appointment.v1.CreateWaitlistEntryRequest
+	(*CreateWaitlistEntryResponse)(nil),            // 98: beaesthetic.appointment.v1.CreateWaitlistEntryResponse
+	(*ListWaitlistEntriesRequest)(nil),             // 99: beaesthetic.appointment.v1.ListWaitlistEntriesRequest
+	(*ListWaitlistEntriesResponse)(nil),            // 100: beaesthetic.appointment.v1.ListWaitlistEntriesResponse
+	(*RemoveWaitlistEntryRequest)(nil),             // 101: beaesthetic.appointment.v1.RemoveWaitlistEntryRequest
+	(*RemoveWaitlistEntryResponse)(nil),            // 102: beaesthetic.appointment.v1.RemoveWaitlistEntryResponse
+	(*timestamppb.Timestamp)(nil),                  // 103: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 104: google.protobuf.FieldMask
 }
 var file_beaesthetic_appointment_v1_appointment_api_proto_depIdxs = []int32{
-	51, // 0: beaesthetic.appointment.v1.TimeRange.start_at:type_name -> google.protobuf.Timestamp
-	51, // 1: beaesthetic.appointment.v1.TimeRange.end_at:type_name -> google.protobuf.Timestamp
-	3,  // 2: beaesthetic.appointment.v1.CalendarEventCancellation.reason:type_name -> beaesthetic.appointment.v1.CancelReason
-	51, // 3: beaesthetic.appointment.v1.CalendarEventCancellation.canceled_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: beaesthetic.appointment.v1.CalendarEvent.event_type:type_name -> beaesthetic.appointment.v1.CalendarEventType
-	4,  // 5: beaesthetic.appointment.v1.CalendarEvent.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
-	51, // 6: beaesthetic.appointment.v1.CalendarEvent.created_at:type_name -> google.protobuf.Timestamp
-	51, // 7: beaesthetic.appointment.v1.CalendarEvent.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 8: beaesthetic.appointment.v1.CalendarEvent.cancellation:type_name -> beaesthetic.appointment.v1.CalendarEventCancellation
-	1,  // 9: beaesthetic.appointment.v1.CalendarEvent.visibility:type_name -> beaesthetic.appointment.v1.CalendarEventVisibility
-	7,  // 10: beaesthetic.appointment.v1.CalendarEvent.appointment:type_name -> beaesthetic.appointment.v1.AppointmentDetail
-	11, // 11: beaesthetic.appointment.v1.CalendarEvent.manual_event:type_name -> beaesthetic.appointment.v1.ManualEventDetail
-	12, // 12: beaesthetic.appointment.v1.CalendarEvent.time_block:type_name -> beaesthetic.appointment.v1.TimeBlockDetail
-	8,  // 13: beaesthetic.appointment.v1.AppointmentDetail.customer:type_name -> beaesthetic.appointment.v1.CustomerRef
-	9,  // 14: beaesthetic.appointment.v1.AppointmentDetail.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceItem
-	10, // 15: beaesthetic.appointment.v1.AppointmentDetail.reminder:type_name -> beaesthetic.appointment.v1.AppointmentReminder
-	2,  // 16: beaesthetic.appointment.v1.AppointmentReminder.status:type_name -> beaesthetic.appointment.v1.AppointmentReminderStatus
-	51, // 17: beaesthetic.appointment.v1.AppointmentReminder.scheduled_at:type_name -> google.protobuf.Timestamp
-	51, // 18: beaesthetic.appointment.v1.AppointmentReminder.sent_requested_at:type_name -> google.protobuf.Timestamp
-	51, // 19: beaesthetic.appointment.v1.AppointmentReminder.sent_at:type_name -> google.protobuf.Timestamp
-	51, // 20: beaesthetic.appointment.v1.AppointmentReminder.failed_at:type_name -> google.protobuf.Timestamp
-	4,  // 21: beaesthetic.appointment.v1.CreateCalendarEventRequest.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
-	1,  // 22: beaesthetic.appointment.v1.CreateCalendarEventRequest.visibility:type_name -> beaesthetic.appointment.v1.CalendarEventVisibility
-	14, // 23: beaesthetic.appointment.v1.CreateCalendarEventRequest.appointment:type_name -> beaesthetic.appointment.v1.CreateAppointmentDetail
-	16, // 24: beaesthetic.appointment.v1.CreateCalendarEventRequest.manual_event:type_name -> beaesthetic.appointment.v1.CreateManualEventDetail
-	17, // 25: beaesthetic.appointment.v1.CreateCalendarEventRequest.time_block:type_name -> beaesthetic.appointment.v1.CreateTimeBlockDetail
-	15, // 26: beaesthetic.appointment.v1.CreateAppointmentDetail.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceSelection
-	6,  // 27: beaesthetic.appointment.v1.GetCalendarEventResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
-	6,  // 28: beaesthetic.appointment.v1.UpdateCalendarEventResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
-	51, // 29: beaesthetic.appointment.v1.ListCalendarEventsRequest.start_at:type_name -> google.protobuf.Timestamp
-	51, // 30: beaesthetic.appointment.v1.ListCalendarEventsRequest.end_at:type_name -> google.protobuf.Timestamp
-	0,  // 31: beaesthetic.appointment.v1.ListCalendarEventsRequest.event_types:type_name -> beaesthetic.appointment.v1.CalendarEventType
-	6,  // 32: beaesthetic.appointment.v1.ListCalendarEventsResponse.events:type_name -> beaesthetic.appointment.v1.CalendarEvent
-	4,  // 33: beaesthetic.appointment.v1.UpdateCalendarEventRequest.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
-	52, // 34: beaesthetic.appointment.v1.UpdateCalendarEventRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 35: beaesthetic.appointment.v1.UpdateCalendarEventRequest.visibility:type_name -> beaesthetic.appointment.v1.CalendarEventVisibility
-	25, // 36: beaesthetic.appointment.v1.UpdateCalendarEventRequest.appointment:type_name -> beaesthetic.appointment.v1.UpdateAppointmentDetail
-	26, // 37: beaesthetic.appointment.v1.UpdateCalendarEventRequest.manual_event:type_name -> beaesthetic.appointment.v1.UpdateManualEventDetail
-	27, // 38: beaesthetic.appointment.v1.UpdateCalendarEventRequest.time_block:type_name -> beaesthetic.appointment.v1.UpdateTimeBlockDetail
-	15, // 39: beaesthetic.appointment.v1.UpdateAppointmentDetail.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceSelection
-	3,  // 40: beaesthetic.appointment.v1.CancelCalendarEventRequest.reason:type_name -> beaesthetic.appointment.v1.CancelReason
-	6,  // 41: beaesthetic.appointment.v1.RequestReminderResendResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
-	32, // 42: beaesthetic.appointment.v1.CreateServiceResponse.service:type_name -> beaesthetic.appointment.v1.CatalogService
-	32, // 43: beaesthetic.appointment.v1.UpdateServiceResponse.service:type_name -> beaesthetic.appointment.v1.CatalogService
-	32, // 44: beaesthetic.appointment.v1.SearchServicesResponse.services:type_name -> beaesthetic.appointment.v1.CatalogService
-	32, // 45: beaesthetic.appointment.v1.ListServicesResponse.services:type_name -> beaesthetic.appointment.v1.CatalogService
-	41, // 46: beaesthetic.appointment.v1.GetCustomerRankingRequest.page:type_name -> beaesthetic.appointment.v1.PageRequest
-	43, // 47: beaesthetic.appointment.v1.GetCustomerRankingResponse.items:type_name -> beaesthetic.appointment.v1.CustomerRankingItem
-	41, // 48: beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest.page:type_name -> beaesthetic.appointment.v1.PageRequest
-	46, // 49: beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse.items:type_name -> beaesthetic.appointment.v1.CustomerCancellationRankingItem
-	49, // 50: beaesthetic.appointment.v1.GetInsightOverviewResponse.cancellation_day_of_week:type_name -> beaesthetic.appointment.v1.CancellationDayOfWeekCount
-	13, // 51: beaesthetic.appointment.v1.CalendarService.CreateCalendarEvent:input_type -> beaesthetic.appointment.v1.CreateCalendarEventRequest
-	19, // 52: beaesthetic.appointment.v1.CalendarService.GetCalendarEvent:input_type -> beaesthetic.appointment.v1.GetCalendarEventRequest
-	22, // 53: beaesthetic.appointment.v1.CalendarService.ListCalendarEvents:input_type -> beaesthetic.appointment.v1.ListCalendarEventsRequest
-	24, // 54: beaesthetic.appointment.v1.CalendarService.UpdateCalendarEvent:input_type -> beaesthetic.appointment.v1.UpdateCalendarEventRequest
-	28, // 55: beaesthetic.appointment.v1.CalendarService.CancelCalendarEvent:input_type -> beaesthetic.appointment.v1.CancelCalendarEventRequest
-	30, // 56: beaesthetic.appointment.v1.CalendarService.RequestReminderResend:input_type -> beaesthetic.appointment.v1.RequestReminderResendRequest
-	33, // 57: beaesthetic.appointment.v1.ServiceCatalogService.CreateService:input_type -> beaesthetic.appointment.v1.CreateServiceRequest
-	35, // 58: beaesthetic.appointment.v1.ServiceCatalogService.UpdateService:input_type -> beaesthetic.appointment.v1.UpdateServiceRequest
-	37, // 59: beaesthetic.appointment.v1.ServiceCatalogService.SearchServices:input_type -> beaesthetic.appointment.v1.SearchServicesRequest
-	39, // 60: beaesthetic.appointment.v1.ServiceCatalogService.ListServices:input_type -> beaesthetic.appointment.v1.ListServicesRequest
-	42, // 61: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerRanking:input_type -> beaesthetic.appointment.v1.GetCustomerRankingRequest
-	45, // 62: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerCancellationRanking:input_type -> beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest
-	48, // 63: beaesthetic.appointment.v1.AppointmentInsightService.GetInsightOverview:input_type -> beaesthetic.appointment.v1.GetInsightOverviewRequest
-	18, // 64: beaesthetic.appointment.v1.CalendarService.CreateCalendarEvent:output_type -> beaesthetic.appointment.v1.CreateCalendarEventResponse
-	20, // 65: beaesthetic.appointment.v1.CalendarService.GetCalendarEvent:output_type -> beaesthetic.appointment.v1.GetCalendarEventResponse
-	23, // 66: beaesthetic.appointment.v1.CalendarService.ListCalendarEvents:output_type -> beaesthetic.appointment.v1.ListCalendarEventsResponse
-	21, // 67: beaesthetic.appointment.v1.CalendarService.UpdateCalendarEvent:output_type -> beaesthetic.appointment.v1.UpdateCalendarEventResponse
-	29, // 68: beaesthetic.appointment.v1.CalendarService.CancelCalendarEvent:output_type -> beaesthetic.appointment.v1.CancelCalendarEventResponse
-	31, // 69: beaesthetic.appointment.v1.CalendarService.RequestReminderResend:output_type -> beaesthetic.appointment.v1.RequestReminderResendResponse
-	34, // 70: beaesthetic.appointment.v1.ServiceCatalogService.CreateService:output_type -> beaesthetic.appointment.v1.CreateServiceResponse
-	36, // 71: beaesthetic.appointment.v1.ServiceCatalogService.UpdateService:output_type -> beaesthetic.appointment.v1.UpdateServiceResponse
-	38, // 72: beaesthetic.appointment.v1.ServiceCatalogService.SearchServices:output_type -> beaesthetic.appointment.v1.SearchServicesResponse
-	40, // 73: beaesthetic.appointment.v1.ServiceCatalogService.ListServices:output_type -> beaesthetic.appointment.v1.ListServicesResponse
-	44, // 74: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerRanking:output_type -> beaesthetic.appointment.v1.GetCustomerRankingResponse
-	47, // 75: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerCancellationRanking:output_type -> beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse
-	50, // 76: beaesthetic.appointment.v1.AppointmentInsightService.GetInsightOverview:output_type -> beaesthetic.appointment.v1.GetInsightOverviewResponse
-	64, // [64:77] is the sub-list for method output_type
-	51, // [51:64] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	103, // 0: beaesthetic.appointment.v1.TimeRange.start_at:type_name -> google.protobuf.Timestamp
+	103, // 1: beaesthetic.appointment.v1.TimeRange.end_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: beaesthetic.appointment.v1.CalendarEventConflict.event_type:type_name -> beaesthetic.appointment.v1.CalendarEventType
+	12,  // 3: beaesthetic.appointment.v1.CalendarEventConflict.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
+	103, // 4: beaesthetic.appointment.v1.Recurrence.exception_dates:type_name -> google.protobuf.Timestamp
+	5,   // 5: beaesthetic.appointment.v1.CalendarEventCancellation.reason:type_name -> beaesthetic.appointment.v1.CancelReason
+	103, // 6: beaesthetic.appointment.v1.CalendarEventCancellation.canceled_at:type_name -> google.protobuf.Timestamp
+	0,   // 7: beaesthetic.appointment.v1.CalendarEvent.event_type:type_name -> beaesthetic.appointment.v1.CalendarEventType
+	12,  // 8: beaesthetic.appointment.v1.CalendarEvent.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
+	103, // 9: beaesthetic.appointment.v1.CalendarEvent.created_at:type_name -> google.protobuf.Timestamp
+	103, // 10: beaesthetic.appointment.v1.CalendarEvent.updated_at:type_name -> google.protobuf.Timestamp
+	15,  // 11: beaesthetic.appointment.v1.CalendarEvent.cancellation:type_name -> beaesthetic.appointment.v1.CalendarEventCancellation
+	1,   // 12: beaesthetic.appointment.v1.CalendarEvent.visibility:type_name -> beaesthetic.appointment.v1.CalendarEventVisibility
+	14,  // 13: beaesthetic.appointment.v1.CalendarEvent.recurrence:type_name -> beaesthetic.appointment.v1.Recurrence
+	17,  // 14: beaesthetic.appointment.v1.CalendarEvent.appointment:type_name -> beaesthetic.appointment.v1.AppointmentDetail
+	24,  // 15: beaesthetic.appointment.v1.CalendarEvent.manual_event:type_name -> beaesthetic.appointment.v1.ManualEventDetail
+	25,  // 16: beaesthetic.appointment.v1.CalendarEvent.time_block:type_name -> beaesthetic.appointment.v1.TimeBlockDetail
+	21,  // 17: beaesthetic.appointment.v1.AppointmentDetail.customer:type_name -> beaesthetic.appointment.v1.CustomerRef
+	22,  // 18: beaesthetic.appointment.v1.AppointmentDetail.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceItem
+	20,  // 19: beaesthetic.appointment.v1.AppointmentDetail.attendance:type_name -> beaesthetic.appointment.v1.AppointmentAttendance
+	23,  // 20: beaesthetic.appointment.v1.AppointmentDetail.reminders:type_name -> beaesthetic.appointment.v1.AppointmentReminder
+	18,  // 21: beaesthetic.appointment.v1.AppointmentDetail.notifications:type_name -> beaesthetic.appointment.v1.AppointmentNotificationSummary
+	19,  // 22: beaesthetic.appointment.v1.AppointmentNotificationSummary.latest:type_name -> beaesthetic.appointment.v1.AppointmentNotification
+	2,   // 23: beaesthetic.appointment.v1.AppointmentNotification.kind:type_name -> beaesthetic.appointment.v1.AppointmentNotificationKind
+	3,   // 24: beaesthetic.appointment.v1.AppointmentNotification.status:type_name -> beaesthetic.appointment.v1.AppointmentNotificationStatus
+	103, // 25: beaesthetic.appointment.v1.AppointmentNotification.created_at:type_name -> google.protobuf.Timestamp
+	103, // 26: beaesthetic.appointment.v1.AppointmentNotification.completed_at:type_name -> google.protobuf.Timestamp
+	103, // 27: beaesthetic.appointment.v1.AppointmentNotification.expires_at:type_name -> google.protobuf.Timestamp
+	7,   // 28: beaesthetic.appointment.v1.AppointmentAttendance.status:type_name -> beaesthetic.appointment.v1.AppointmentAttendanceStatus
+	103, // 29: beaesthetic.appointment.v1.AppointmentAttendance.recorded_at:type_name -> google.protobuf.Timestamp
+	4,   // 30: beaesthetic.appointment.v1.AppointmentReminder.status:type_name -> beaesthetic.appointment.v1.AppointmentReminderStatus
+	103, // 31: beaesthetic.appointment.v1.AppointmentReminder.scheduled_at:type_name -> google.protobuf.Timestamp
+	103, // 32: beaesthetic.appointment.v1.AppointmentReminder.sent_requested_at:type_name -> google.protobuf.Timestamp
+	103, // 33: beaesthetic.appointment.v1.AppointmentReminder.sent_at:type_name -> google.protobuf.Timestamp
+	103, // 34: beaesthetic.appointment.v1.AppointmentReminder.failed_at:type_name -> google.protobuf.Timestamp
+	12,  // 35: beaesthetic.appointment.v1.CreateCalendarEventRequest.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
+	1,   // 36: beaesthetic.appointment.v1.CreateCalendarEventRequest.visibility:type_name -> beaesthetic.appointment.v1.CalendarEventVisibility
+	14,  // 37: beaesthetic.appointment.v1.CreateCalendarEventRequest.recurrence:type_name -> beaesthetic.appointment.v1.Recurrence
+	27,  // 38: beaesthetic.appointment.v1.CreateCalendarEventRequest.appointment:type_name -> beaesthetic.appointment.v1.CreateAppointmentDetail
+	29,  // 39: beaesthetic.appointment.v1.CreateCalendarEventRequest.manual_event:type_name -> beaesthetic.appointment.v1.CreateManualEventDetail
+	30,  // 40: beaesthetic.appointment.v1.CreateCalendarEventRequest.time_block:type_name -> beaesthetic.appointment.v1.CreateTimeBlockDetail
+	28,  // 41: beaesthetic.appointment.v1.CreateAppointmentDetail.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceSelection
+	13,  // 42: beaesthetic.appointment.v1.CreateCalendarEventResponse.conflicts:type_name -> beaesthetic.appointment.v1.CalendarEventConflict
+	16,  // 43: beaesthetic.appointment.v1.GetCalendarEventResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
+	16,  // 44: beaesthetic.appointment.v1.UpdateCalendarEventResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
+	13,  // 45: beaesthetic.appointment.v1.UpdateCalendarEventResponse.conflicts:type_name -> beaesthetic.appointment.v1.CalendarEventConflict
+	103, // 46: beaesthetic.appointment.v1.ListCalendarEventsRequest.start_at:type_name -> google.protobuf.Timestamp
+	103, // 47: beaesthetic.appointment.v1.ListCalendarEventsRequest.end_at:type_name -> google.protobuf.Timestamp
+	0,   // 48: beaesthetic.appointment.v1.ListCalendarEventsRequest.event_types:type_name -> beaesthetic.appointment.v1.CalendarEventType
+	8,   // 49: beaesthetic.appointment.v1.ListCalendarEventsRequest.order:type_name -> beaesthetic.appointment.v1.CalendarEventOrder
+	16,  // 50: beaesthetic.appointment.v1.ListCalendarEventsResponse.events:type_name -> beaesthetic.appointment.v1.CalendarEvent
+	12,  // 51: beaesthetic.appointment.v1.UpdateCalendarEventRequest.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
+	104, // 52: beaesthetic.appointment.v1.UpdateCalendarEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,   // 53: beaesthetic.appointment.v1.UpdateCalendarEventRequest.visibility:type_name -> beaesthetic.appointment.v1.CalendarEventVisibility
+	14,  // 54: beaesthetic.appointment.v1.UpdateCalendarEventRequest.recurrence:type_name -> beaesthetic.appointment.v1.Recurrence
+	103, // 55: beaesthetic.appointment.v1.UpdateCalendarEventRequest.occurrence_start_at:type_name -> google.protobuf.Timestamp
+	6,   // 56: beaesthetic.appointment.v1.UpdateCalendarEventRequest.scope:type_name -> beaesthetic.appointment.v1.RecurrenceScope
+	38,  // 57: beaesthetic.appointment.v1.UpdateCalendarEventRequest.appointment:type_name -> beaesthetic.appointment.v1.UpdateAppointmentDetail
+	39,  // 58: beaesthetic.appointment.v1.UpdateCalendarEventRequest.manual_event:type_name -> beaesthetic.appointment.v1.UpdateManualEventDetail
+	40,  // 59: beaesthetic.appointment.v1.UpdateCalendarEventRequest.time_block:type_name -> beaesthetic.appointment.v1.UpdateTimeBlockDetail
+	28,  // 60: beaesthetic.appointment.v1.UpdateAppointmentDetail.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceSelection
+	5,   // 61: beaesthetic.appointment.v1.CancelCalendarEventRequest.reason:type_name -> beaesthetic.appointment.v1.CancelReason
+	103, // 62: beaesthetic.appointment.v1.CancelCalendarEventRequest.occurrence_start_at:type_name -> google.protobuf.Timestamp
+	6,   // 63: beaesthetic.appointment.v1.CancelCalendarEventRequest.scope:type_name -> beaesthetic.appointment.v1.RecurrenceScope
+	103, // 64: beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest.start_at:type_name -> google.protobuf.Timestamp
+	103, // 65: beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest.end_at:type_name -> google.protobuf.Timestamp
+	0,   // 66: beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest.event_types:type_name -> beaesthetic.appointment.v1.CalendarEventType
+	44,  // 67: beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest.cancel:type_name -> beaesthetic.appointment.v1.BulkCancelAction
+	45,  // 68: beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest.shift:type_name -> beaesthetic.appointment.v1.BulkShiftAction
+	5,   // 69: beaesthetic.appointment.v1.BulkCancelAction.reason:type_name -> beaesthetic.appointment.v1.CancelReason
+	103, // 70: beaesthetic.appointment.v1.BulkChangeCalendarEventResult.start_at:type_name -> google.protobuf.Timestamp
+	9,   // 71: beaesthetic.appointment.v1.BulkChangeCalendarEventResult.status:type_name -> beaesthetic.appointment.v1.BulkChangeStatus
+	16,  // 72: beaesthetic.appointment.v1.BulkChangeCalendarEventResult.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
+	46,  // 73: beaesthetic.appointment.v1.BulkChangeCalendarEventsResponse.results:type_name -> beaesthetic.appointment.v1.BulkChangeCalendarEventResult
+	16,  // 74: beaesthetic.appointment.v1.MarkCalendarEventCompletedResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
+	16,  // 75: beaesthetic.appointment.v1.MarkCalendarEventNoShowResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
+	103, // 76: beaesthetic.appointment.v1.FindAvailableSlotsRequest.start_at:type_name -> google.protobuf.Timestamp
+	103, // 77: beaesthetic.appointment.v1.FindAvailableSlotsRequest.end_at:type_name -> google.protobuf.Timestamp
+	103, // 78: beaesthetic.appointment.v1.AvailableSlot.start_at:type_name -> google.protobuf.Timestamp
+	103, // 79: beaesthetic.appointment.v1.AvailableSlot.end_at:type_name -> google.protobuf.Timestamp
+	53,  // 80: beaesthetic.appointment.v1.FindAvailableSlotsResponse.slots:type_name -> beaesthetic.appointment.v1.AvailableSlot
+	16,  // 81: beaesthetic.appointment.v1.RequestReminderResendResponse.event:type_name -> beaesthetic.appointment.v1.CalendarEvent
+	19,  // 82: beaesthetic.appointment.v1.ListCalendarEventNotificationsResponse.notifications:type_name -> beaesthetic.appointment.v1.AppointmentNotification
+	103, // 83: beaesthetic.appointment.v1.Calendar.archived_at:type_name -> google.protobuf.Timestamp
+	103, // 84: beaesthetic.appointment.v1.Calendar.created_at:type_name -> google.protobuf.Timestamp
+	103, // 85: beaesthetic.appointment.v1.Calendar.updated_at:type_name -> google.protobuf.Timestamp
+	59,  // 86: beaesthetic.appointment.v1.CreateCalendarResponse.calendar:type_name -> beaesthetic.appointment.v1.Calendar
+	59,  // 87: beaesthetic.appointment.v1.ListCalendarsResponse.calendars:type_name -> beaesthetic.appointment.v1.Calendar
+	59,  // 88: beaesthetic.appointment.v1.UpdateCalendarResponse.calendar:type_name -> beaesthetic.appointment.v1.Calendar
+	59,  // 89: beaesthetic.appointment.v1.ArchiveCalendarResponse.calendar:type_name -> beaesthetic.appointment.v1.Calendar
+	10,  // 90: beaesthetic.appointment.v1.TimeBlockImportResult.status:type_name -> beaesthetic.appointment.v1.TimeBlockImportStatus
+	13,  // 91: beaesthetic.appointment.v1.TimeBlockImportResult.conflicts:type_name -> beaesthetic.appointment.v1.CalendarEventConflict
+	71,  // 92: beaesthetic.appointment.v1.ImportTimeBlocksResponse.results:type_name -> beaesthetic.appointment.v1.TimeBlockImportResult
+	73,  // 93: beaesthetic.appointment.v1.CreateServiceResponse.service:type_name -> beaesthetic.appointment.v1.CatalogService
+	73,  // 94: beaesthetic.appointment.v1.UpdateServiceResponse.service:type_name -> beaesthetic.appointment.v1.CatalogService
+	73,  // 95: beaesthetic.appointment.v1.SearchServicesResponse.services:type_name -> beaesthetic.appointment.v1.CatalogService
+	73,  // 96: beaesthetic.appointment.v1.ListServicesResponse.services:type_name -> beaesthetic.appointment.v1.CatalogService
+	82,  // 97: beaesthetic.appointment.v1.GetCustomerRankingRequest.page:type_name -> beaesthetic.appointment.v1.PageRequest
+	84,  // 98: beaesthetic.appointment.v1.GetCustomerRankingResponse.items:type_name -> beaesthetic.appointment.v1.CustomerRankingItem
+	82,  // 99: beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest.page:type_name -> beaesthetic.appointment.v1.PageRequest
+	87,  // 100: beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse.items:type_name -> beaesthetic.appointment.v1.CustomerCancellationRankingItem
+	82,  // 101: beaesthetic.appointment.v1.GetCustomerNoShowRankingRequest.page:type_name -> beaesthetic.appointment.v1.PageRequest
+	90,  // 102: beaesthetic.appointment.v1.GetCustomerNoShowRankingResponse.items:type_name -> beaesthetic.appointment.v1.CustomerNoShowRankingItem
+	93,  // 103: beaesthetic.appointment.v1.GetInsightOverviewResponse.cancellation_day_of_week:type_name -> beaesthetic.appointment.v1.CancellationDayOfWeekCount
+	12,  // 104: beaesthetic.appointment.v1.WaitlistOffer.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
+	103, // 105: beaesthetic.appointment.v1.WaitlistOffer.offered_at:type_name -> google.protobuf.Timestamp
+	103, // 106: beaesthetic.appointment.v1.WaitlistOffer.expires_at:type_name -> google.protobuf.Timestamp
+	21,  // 107: beaesthetic.appointment.v1.WaitlistEntry.customer:type_name -> beaesthetic.appointment.v1.CustomerRef
+	22,  // 108: beaesthetic.appointment.v1.WaitlistEntry.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceItem
+	12,  // 109: beaesthetic.appointment.v1.WaitlistEntry.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
+	11,  // 110: beaesthetic.appointment.v1.WaitlistEntry.status:type_name -> beaesthetic.appointment.v1.WaitlistEntryStatus
+	95,  // 111: beaesthetic.appointment.v1.WaitlistEntry.offer:type_name -> beaesthetic.appointment.v1.WaitlistOffer
+	103, // 112: beaesthetic.appointment.v1.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	103, // 113: beaesthetic.appointment.v1.WaitlistEntry.updated_at:type_name -> google.protobuf.Timestamp
+	28,  // 114: beaesthetic.appointment.v1.CreateWaitlistEntryRequest.services:type_name -> beaesthetic.appointment.v1.AppointmentServiceSelection
+	12,  // 115: beaesthetic.appointment.v1.CreateWaitlistEntryRequest.time_range:type_name -> beaesthetic.appointment.v1.TimeRange
+	96,  // 116: beaesthetic.appointment.v1.CreateWaitlistEntryResponse.entry:type_name -> beaesthetic.appointment.v1.WaitlistEntry
+	96,  // 117: beaesthetic.appointment.v1.ListWaitlistEntriesResponse.entries:type_name -> beaesthetic.appointment.v1.WaitlistEntry
+	26,  // 118: beaesthetic.appointment.v1.CalendarService.CreateCalendarEvent:input_type -> beaesthetic.appointment.v1.CreateCalendarEventRequest
+	32,  // 119: beaesthetic.appointment.v1.CalendarService.GetCalendarEvent:input_type -> beaesthetic.appointment.v1.GetCalendarEventRequest
+	35,  // 120: beaesthetic.appointment.v1.CalendarService.ListCalendarEvents:input_type -> beaesthetic.appointment.v1.ListCalendarEventsRequest
+	37,  // 121: beaesthetic.appointment.v1.CalendarService.UpdateCalendarEvent:input_type -> beaesthetic.appointment.v1.UpdateCalendarEventRequest
+	41,  // 122: beaesthetic.appointment.v1.CalendarService.CancelCalendarEvent:input_type -> beaesthetic.appointment.v1.CancelCalendarEventRequest
+	52,  // 123: beaesthetic.appointment.v1.CalendarService.FindAvailableSlots:input_type -> beaesthetic.appointment.v1.FindAvailableSlotsRequest
+	55,  // 124: beaesthetic.appointment.v1.CalendarService.RequestReminderResend:input_type -> beaesthetic.appointment.v1.RequestReminderResendRequest
+	57,  // 125: beaesthetic.appointment.v1.CalendarService.ListCalendarEventNotifications:input_type -> beaesthetic.appointment.v1.ListCalendarEventNotificationsRequest
+	43,  // 126: beaesthetic.appointment.v1.CalendarService.BulkChangeCalendarEvents:input_type -> beaesthetic.appointment.v1.BulkChangeCalendarEventsRequest
+	48,  // 127: beaesthetic.appointment.v1.CalendarService.MarkCalendarEventCompleted:input_type -> beaesthetic.appointment.v1.MarkCalendarEventCompletedRequest
+	50,  // 128: beaesthetic.appointment.v1.CalendarService.MarkCalendarEventNoShow:input_type -> beaesthetic.appointment.v1.MarkCalendarEventNoShowRequest
+	60,  // 129: beaesthetic.appointment.v1.CalendarService.CreateCalendar:input_type -> beaesthetic.appointment.v1.CreateCalendarRequest
+	62,  // 130: beaesthetic.appointment.v1.CalendarService.ListCalendars:input_type -> beaesthetic.appointment.v1.ListCalendarsRequest
+	64,  // 131: beaesthetic.appointment.v1.CalendarService.UpdateCalendar:input_type -> beaesthetic.appointment.v1.UpdateCalendarRequest
+	66,  // 132: beaesthetic.appointment.v1.CalendarService.ArchiveCalendar:input_type -> beaesthetic.appointment.v1.ArchiveCalendarRequest
+	68,  // 133: beaesthetic.appointment.v1.CalendarService.GetCalendarFeedLink:input_type -> beaesthetic.appointment.v1.GetCalendarFeedLinkRequest
+	70,  // 134: beaesthetic.appointment.v1.CalendarService.ImportTimeBlocks:input_type -> beaesthetic.appointment.v1.ImportTimeBlocksRequest
+	74,  // 135: beaesthetic.appointment.v1.ServiceCatalogService.CreateService:input_type -> beaesthetic.appointment.v1.CreateServiceRequest
+	76,  // 136: beaesthetic.appointment.v1.ServiceCatalogService.UpdateService:input_type -> beaesthetic.appointment.v1.UpdateServiceRequest
+	78,  // 137: beaesthetic.appointment.v1.ServiceCatalogService.SearchServices:input_type -> beaesthetic.appointment.v1.SearchServicesRequest
+	80,  // 138: beaesthetic.appointment.v1.ServiceCatalogService.ListServices:input_type -> beaesthetic.appointment.v1.ListServicesRequest
+	83,  // 139: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerRanking:input_type -> beaesthetic.appointment.v1.GetCustomerRankingRequest
+	86,  // 140: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerCancellationRanking:input_type -> beaesthetic.appointment.v1.GetCustomerCancellationRankingRequest
+	89,  // 141: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerNoShowRanking:input_type -> beaesthetic.appointment.v1.GetCustomerNoShowRankingRequest
+	92,  // 142: beaesthetic.appointment.v1.AppointmentInsightService.GetInsightOverview:input_type -> beaesthetic.appointment.v1.GetInsightOverviewRequest
+	97,  // 143: beaesthetic.appointment.v1.WaitlistService.CreateWaitlistEntry:input_type -> beaesthetic.appointment.v1.CreateWaitlistEntryRequest
+	99,  // 144: beaesthetic.appointment.v1.WaitlistService.ListWaitlistEntries:input_type -> beaesthetic.appointment.v1.ListWaitlistEntriesRequest
+	101, // 145: beaesthetic.appointment.v1.WaitlistService.RemoveWaitlistEntry:input_type -> beaesthetic.appointment.v1.RemoveWaitlistEntryRequest
+	31,  // 146: beaesthetic.appointment.v1.CalendarService.CreateCalendarEvent:output_type -> beaesthetic.appointment.v1.CreateCalendarEventResponse
+	33,  // 147: beaesthetic.appointment.v1.CalendarService.GetCalendarEvent:output_type -> beaesthetic.appointment.v1.GetCalendarEventResponse
+	36,  // 148: beaesthetic.appointment.v1.CalendarService.ListCalendarEvents:output_type -> beaesthetic.appointment.v1.ListCalendarEventsResponse
+	34,  // 149: beaesthetic.appointment.v1.CalendarService.UpdateCalendarEvent:output_type -> beaesthetic.appointment.v1.UpdateCalendarEventResponse
+	42,  // 150: beaesthetic.appointment.v1.CalendarService.CancelCalendarEvent:output_type -> beaesthetic.appointment.v1.CancelCalendarEventResponse
+	54,  // 151: beaesthetic.appointment.v1.CalendarService.FindAvailableSlots:output_type -> beaesthetic.appointment.v1.FindAvailableSlotsResponse
+	56,  // 152: beaesthetic.appointment.v1.CalendarService.RequestReminderResend:output_type -> beaesthetic.appointment.v1.RequestReminderResendResponse
+	58,  // 153: beaesthetic.appointment.v1.CalendarService.ListCalendarEventNotifications:output_type -> beaesthetic.appointment.v1.ListCalendarEventNotificationsResponse
+	47,  // 154: beaesthetic.appointment.v1.CalendarService.BulkChangeCalendarEvents:output_type -> beaesthetic.appointment.v1.BulkChangeCalendarEventsResponse
+	49,  // 155: beaesthetic.appointment.v1.CalendarService.MarkCalendarEventCompleted:output_type -> beaesthetic.appointment.v1.MarkCalendarEventCompletedResponse
+	51,  // 156: beaesthetic.appointment.v1.CalendarService.MarkCalendarEventNoShow:output_type -> beaesthetic.appointment.v1.MarkCalendarEventNoShowResponse
+	61,  // 157: beaesthetic.appointment.v1.CalendarService.CreateCalendar:output_type -> beaesthetic.appointment.v1.CreateCalendarResponse
+	63,  // 158: beaesthetic.appointment.v1.CalendarService.ListCalendars:output_type -> beaesthetic.appointment.v1.ListCalendarsResponse
+	65,  // 159: beaesthetic.appointment.v1.CalendarService.UpdateCalendar:output_type -> beaesthetic.appointment.v1.UpdateCalendarResponse
+	67,  // 160: beaesthetic.appointment.v1.CalendarService.ArchiveCalendar:output_type -> beaesthetic.appointment.v1.ArchiveCalendarResponse
+	69,  // 161: beaesthetic.appointment.v1.CalendarService.GetCalendarFeedLink:output_type -> beaesthetic.appointment.v1.GetCalendarFeedLinkResponse
+	72,  // 162: beaesthetic.appointment.v1.CalendarService.ImportTimeBlocks:output_type -> beaesthetic.appointment.v1.ImportTimeBlocksResponse
+	75,  // 163: beaesthetic.appointment.v1.ServiceCatalogService.CreateService:output_type -> beaesthetic.appointment.v1.CreateServiceResponse
+	77,  // 164: beaesthetic.appointment.v1.ServiceCatalogService.UpdateService:output_type -> beaesthetic.appointment.v1.UpdateServiceResponse
+	79,  // 165: beaesthetic.appointment.v1.ServiceCatalogService.SearchServices:output_type -> beaesthetic.appointment.v1.SearchServicesResponse
+	81,  // 166: beaesthetic.appointment.v1.ServiceCatalogService.ListServices:output_type -> beaesthetic.appointment.v1.ListServicesResponse
+	85,  // 167: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerRanking:output_type -> beaesthetic.appointment.v1.GetCustomerRankingResponse
+	88,  // 168: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerCancellationRanking:output_type -> beaesthetic.appointment.v1.GetCustomerCancellationRankingResponse
+	91,  // 169: beaesthetic.appointment.v1.AppointmentInsightService.GetCustomerNoShowRanking:output_type -> beaesthetic.appointment.v1.GetCustomerNoShowRankingResponse
+	94,  // 170: beaesthetic.appointment.v1.AppointmentInsightService.GetInsightOverview:output_type -> beaesthetic.appointment.v1.GetInsightOverviewResponse
+	98,  // 171: beaesthetic.appointment.v1.WaitlistService.CreateWaitlistEntry:output_type -> beaesthetic.appointment.v1.CreateWaitlistEntryResponse
+	100, // 172: beaesthetic.appointment.v1.WaitlistService.ListWaitlistEntries:output_type -> beaesthetic.appointment.v1.ListWaitlistEntriesResponse
+	102, // 173: beaesthetic.appointment.v1.WaitlistService.RemoveWaitlistEntry:output_type -> beaesthetic.appointment.v1.RemoveWaitlistEntryResponse
+	146, // [146:174] is the sub-list for method output_type
+	118, // [118:146] is the sub-list for method input_type
+	118, // [118:118] is the sub-list for extension type_name
+	118, // [118:118] is the sub-list for extension extendee
+	0,   // [0:118] is the sub-list for field type_name
 }
 
 func init() { file_beaesthetic_appointment_v1_appointment_api_proto_init() }
@@ -3481,38 +7177,50 @@ func file_beaesthetic_appointment_v1_appointment_api_proto_init() {
 	if File_beaesthetic_appointment_v1_appointment_api_proto != nil {
 		return
 	}
-	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[2].OneofWrappers = []any{
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[4].OneofWrappers = []any{
 		(*CalendarEvent_Appointment)(nil),
 		(*CalendarEvent_ManualEvent)(nil),
 		(*CalendarEvent_TimeBlock)(nil),
 	}
-	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[9].OneofWrappers = []any{
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[7].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[14].OneofWrappers = []any{
 		(*CreateCalendarEventRequest_Appointment)(nil),
 		(*CreateCalendarEventRequest_ManualEvent)(nil),
 		(*CreateCalendarEventRequest_TimeBlock)(nil),
 	}
-	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[10].OneofWrappers = []any{}
-	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[11].OneofWrappers = []any{
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[15].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[16].OneofWrappers = []any{
 		(*AppointmentServiceSelection_CatalogServiceId)(nil),
 		(*AppointmentServiceSelection_CustomServiceName)(nil),
 	}
-	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[20].OneofWrappers = []any{
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[25].OneofWrappers = []any{
 		(*UpdateCalendarEventRequest_Appointment)(nil),
 		(*UpdateCalendarEventRequest_ManualEvent)(nil),
 		(*UpdateCalendarEventRequest_TimeBlock)(nil),
 	}
-	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[22].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[26].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[27].OneofWrappers = []any{}
 	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[29].OneofWrappers = []any{}
-	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[31].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[31].OneofWrappers = []any{
+		(*BulkChangeCalendarEventsRequest_Cancel)(nil),
+		(*BulkChangeCalendarEventsRequest_Shift)(nil),
+	}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[36].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[38].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[43].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[48].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[52].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[62].OneofWrappers = []any{}
+	file_beaesthetic_appointment_v1_appointment_api_proto_msgTypes[64].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc), len(file_beaesthetic_appointment_v1_appointment_api_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   47,
+			NumEnums:      12,
+			NumMessages:   91,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   4,
 		},
 		GoTypes:           file_beaesthetic_appointment_v1_appointment_api_proto_goTypes,
 		DependencyIndexes: file_beaesthetic_appointment_v1_appointment_api_proto_depIdxs,
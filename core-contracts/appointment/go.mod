@@ -5,3 +5,5 @@ go 1.25.0
 require google.golang.org/protobuf v1.36.11
 
 require google.golang.org/genproto/googleapis/api v0.0.0-20260817212433-ac3dfec99bb1
+
+require connectrpc.com/connect v1.19.1
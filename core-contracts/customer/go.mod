@@ -0,0 +1,5 @@
+module github.com/petretiandrea/beaesthetic-backend/core-contracts/customer
+
+go 1.25.0
+
+require google.golang.org/protobuf v1.36.10
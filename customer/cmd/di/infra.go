@@ -2,24 +2,46 @@ package di
 
 import (
 	"context"
-	"database/sql"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/lib/pq"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 	cacheinfra "github.com/petretiandrea/beaesthetic-backend/customer/internal/infra/cache"
 	postgresinfra "github.com/petretiandrea/beaesthetic-backend/customer/internal/infra/postgres"
+	"github.com/petretiandrea/outbox-go/pkg/outbox"
+	outboxpostgres "github.com/petretiandrea/outbox-go/pkg/outbox/postgres"
 	"github.com/redis/go-redis/v9"
 )
 
-func (d *DiContainer) GetPostgresDatabase() *sql.DB {
-	return singletonWithError(d, "postgresDatabase", func() (*sql.DB, error) {
-		db, err := sql.Open("postgres", d.Config.Postgres.DSN)
+func (d *DiContainer) GetPostgresDatabase() *pgxpool.Pool {
+	return singletonWithError(d, "postgresDatabase", func() (*pgxpool.Pool, error) {
+		pool, err := pgxpool.New(context.Background(), d.Config.Postgres.DSN)
 		if err != nil {
 			return nil, err
 		}
-		return db, db.Ping()
+		return pool, pool.Ping(context.Background())
+	})
+}
+
+func (d *DiContainer) GetPostgresContextDB() *postgresinfra.ContextDB {
+	return singleton(d, "postgresContextDB", func() *postgresinfra.ContextDB {
+		return postgresinfra.NewContextDB(d.GetPostgresDatabase())
+	})
+}
+
+func (d *DiContainer) GetOutboxPublisher() outbox.Publisher {
+	return singletonWithError(d, "outboxPublisher", func() (outbox.Publisher, error) {
+		return outboxpostgres.NewPublisher(d.GetPostgresContextDB(), outboxpostgres.PublisherConfig{
+			TableName: "outbox_messages",
+		})
+	})
+}
+
+func (d *DiContainer) GetCustomerEventPublisher() *postgresinfra.CustomerEventPublisher {
+	return singleton(d, "customerEventPublisher", func() *postgresinfra.CustomerEventPublisher {
+		return postgresinfra.NewCustomerEventPublisher(d.GetOutboxPublisher())
 	})
 }
 
@@ -42,9 +64,10 @@ func (d *DiContainer) GetCustomerCache() *cacheinfra.Cache {
 
 func (d *DiContainer) GetMigrator() *migrate.Migrate {
 	return singletonWithError(d, "migrator", func() (*migrate.Migrate, error) {
-		db := d.GetPostgresDatabase()
+		db := stdlib.OpenDBFromPool(d.GetPostgresDatabase())
 		driver, err := postgres.WithInstance(db, &postgres.Config{})
 		if err != nil {
+			db.Close()
 			return nil, err
 		}
 		return migrate.NewWithDatabaseInstance("file://migrations", "postgres", driver)
@@ -53,18 +76,18 @@ func (d *DiContainer) GetMigrator() *migrate.Migrate {
 
 func (d *DiContainer) GetCustomerRepository() *postgresinfra.CustomerRepository {
 	return singleton(d, "customerRepository", func() *postgresinfra.CustomerRepository {
-		return postgresinfra.NewCustomerRepository(d.GetPostgresDatabase())
+		return postgresinfra.NewCustomerRepository(d.GetPostgresContextDB())
 	})
 }
 
 func (d *DiContainer) GetFidelityRepository() *postgresinfra.FidelityRepository {
 	return singleton(d, "fidelityRepository", func() *postgresinfra.FidelityRepository {
-		return postgresinfra.NewFidelityRepository(d.GetPostgresDatabase())
+		return postgresinfra.NewFidelityRepository(d.GetPostgresContextDB())
 	})
 }
 
 func (d *DiContainer) GetWalletRepository() *postgresinfra.WalletRepository {
 	return singleton(d, "walletRepository", func() *postgresinfra.WalletRepository {
-		return postgresinfra.NewWalletRepository(d.GetPostgresDatabase())
+		return postgresinfra.NewWalletRepository(d.GetPostgresContextDB())
 	})
 }
@@ -3,7 +3,9 @@ package di
 import "github.com/petretiandrea/beaesthetic-backend/customer/internal/application"
 
 func (d *DiContainer) GetCustomerService() *application.CustomerService {
-	return singleton(d, "customerService", func() *application.CustomerService { return application.NewCustomerService(d.GetCustomerRepository()) })
+	return singleton(d, "customerService", func() *application.CustomerService {
+		return application.NewCustomerService(d.GetCustomerRepository(), d.GetCustomerEventPublisher())
+	})
 }
 func (d *DiContainer) GetFidelityService() *application.FidelityService {
 	return singleton(d, "fidelityService", func() *application.FidelityService { return application.NewFidelityService(d.GetFidelityRepository()) })
@@ -9,6 +9,12 @@
 {{- printf "%s-%s-%s" $namespaceBase $environment $base -}}
 {{- end -}}
 
+{{- define "customer.rabbitmqVhost" -}}
+{{- $environment := default "prod" (include "customer.environment" .) -}}
+{{- $namespaceBase := trimSuffix (printf "-%s" $environment) .Values.namespace -}}
+{{- printf "%s-%s" $namespaceBase $environment -}}
+{{- end -}}
+
 {{- define "customer.renderEnvConfig" -}}
 {{- $environment := include "customer.environment" . -}}
 {{- $envConfig := toYaml .Values.envConfig -}}
@@ -7,6 +7,7 @@ import (
 )
 
 type CustomerRepository interface {
+	Tx(ctx context.Context, atomicFn func(context.Context) error) error
 	Save(ctx context.Context, customer customerdomain.Customer) (customerdomain.Customer, error)
 	FindByID(ctx context.Context, id string) (*customerdomain.Customer, error)
 	FindAll(ctx context.Context, filter string, limit int) ([]customerdomain.Customer, error)
@@ -15,10 +16,19 @@ type CustomerRepository interface {
 	Delete(ctx context.Context, id string) (bool, error)
 }
 
-type CustomerService struct{ repo CustomerRepository }
+// CustomerEventPublisher publishes the changes of a customer to the other services. It writes to the outbox
+// through the transaction in ctx, so an event is stored only with the change it describes.
+type CustomerEventPublisher interface {
+	PublishCustomerChanged(ctx context.Context, customer customerdomain.Customer) error
+}
+
+type CustomerService struct {
+	repo   CustomerRepository
+	events CustomerEventPublisher
+}
 
-func NewCustomerService(repo CustomerRepository) *CustomerService {
-	return &CustomerService{repo: repo}
+func NewCustomerService(repo CustomerRepository, events CustomerEventPublisher) *CustomerService {
+	return &CustomerService{repo: repo, events: events}
 }
 
 func (s *CustomerService) Create(ctx context.Context, name, surname string, email, phone, note *string) (customerdomain.Customer, error) {
@@ -33,17 +43,29 @@ func (s *CustomerService) Create(ctx context.Context, name, surname string, emai
 	return s.repo.Save(ctx, customer)
 }
 
+// Update saves the changed customer and publishes it in the same transaction, so the change is either stored
+// with its event or not at all.
 func (s *CustomerService) Update(ctx context.Context, id string, name, surname, email, phone, note *string) (*customerdomain.Customer, error) {
-	current, err := s.repo.FindByID(ctx, id)
-	if err != nil || current == nil {
-		return current, err
-	}
-	updated, err := current.Update(name, surname, email, phone, note)
-	if err != nil {
+	var saved *customerdomain.Customer
+	if err := s.repo.Tx(ctx, func(ctx context.Context) error {
+		current, err := s.repo.FindByID(ctx, id)
+		if err != nil || current == nil {
+			return err
+		}
+		updated, err := current.Update(name, surname, email, phone, note)
+		if err != nil {
+			return err
+		}
+		customer, err := s.repo.Save(ctx, updated)
+		if err != nil {
+			return err
+		}
+		saved = &customer
+		return s.events.PublishCustomerChanged(ctx, customer)
+	}); err != nil {
 		return nil, err
 	}
-	saved, err := s.repo.Save(ctx, updated)
-	return &saved, err
+	return saved, nil
 }
 
 func (s *CustomerService) Delete(ctx context.Context, id string) (bool, error) {
@@ -0,0 +1,88 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	customerdomain "github.com/petretiandrea/beaesthetic-backend/customer/internal/domain/customer"
+)
+
+func TestUpdatePublishesTheChangedDisplayName(t *testing.T) {
+	t.Parallel()
+
+	repository := &customerRepositoryStub{customer: customerdomain.Customer{ID: "customer-1", Name: "Ada", Surname: "Lovelce"}}
+	events := &customerEventPublisherStub{}
+	service := NewCustomerService(repository, events)
+
+	surname := "Lovelace"
+	if _, err := service.Update(context.Background(), "customer-1", nil, &surname, nil, nil, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(events.published) != 1 || events.published[0].DisplayName() != "Ada Lovelace" {
+		t.Fatalf("published = %+v, want the renamed customer", events.published)
+	}
+}
+
+func TestUpdatePublishesInTheSaveTransaction(t *testing.T) {
+	t.Parallel()
+
+	repository := &customerRepositoryStub{customer: customerdomain.Customer{ID: "customer-1", Name: "Ada", Surname: "Lovelce"}}
+	events := &customerEventPublisherStub{repository: repository, err: errors.New("outbox unavailable")}
+	service := NewCustomerService(repository, events)
+
+	surname := "Lovelace"
+	if _, err := service.Update(context.Background(), "customer-1", nil, &surname, nil, nil, nil); err == nil {
+		t.Fatal("Update() error = nil, want the publish failure")
+	}
+	if !events.inTx {
+		t.Fatal("published outside the transaction, want the event written with the save")
+	}
+	if repository.customer.Surname != "Lovelce" {
+		t.Fatalf("surname = %q, want the update rolled back with the failed publish", repository.customer.Surname)
+	}
+}
+
+type customerRepositoryStub struct {
+	CustomerRepository
+	customer customerdomain.Customer
+	inTx     bool
+}
+
+// Tx restores the stored customer when atomicFn fails, as a rolled back transaction would.
+func (r *customerRepositoryStub) Tx(ctx context.Context, atomicFn func(context.Context) error) error {
+	committed := r.customer
+	r.inTx = true
+	defer func() { r.inTx = false }()
+	if err := atomicFn(ctx); err != nil {
+		r.customer = committed
+		return err
+	}
+	return nil
+}
+
+func (r *customerRepositoryStub) FindByID(context.Context, string) (*customerdomain.Customer, error) {
+	customer := r.customer
+	return &customer, nil
+}
+
+func (r *customerRepositoryStub) Save(_ context.Context, customer customerdomain.Customer) (customerdomain.Customer, error) {
+	r.customer = customer
+	return customer, nil
+}
+
+type customerEventPublisherStub struct {
+	repository *customerRepositoryStub
+	published  []customerdomain.Customer
+	inTx       bool
+	err        error
+}
+
+func (p *customerEventPublisherStub) PublishCustomerChanged(_ context.Context, customer customerdomain.Customer) error {
+	p.inTx = p.repository != nil && p.repository.inTx
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, customer)
+	return nil
+}
@@ -45,6 +45,11 @@ func ParsePhone(value string) (*Phone, error) {
 
 func (phone Phone) FullNumber() string { return phone.Prefix + phone.Number }
 
+// DisplayName is the name the other services show for the customer.
+func (customer Customer) DisplayName() string {
+	return strings.TrimSpace(customer.Name + " " + customer.Surname)
+}
+
 func (customer Customer) Update(name, surname, email, phone, note *string) (Customer, error) {
 	if name != nil {
 		if strings.TrimSpace(*name) == "" {
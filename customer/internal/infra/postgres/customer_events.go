@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	customercontracts "github.com/petretiandrea/beaesthetic-backend/core-contracts/customer"
+	customerdomain "github.com/petretiandrea/beaesthetic-backend/customer/internal/domain/customer"
+	"github.com/petretiandrea/outbox-go/pkg/outbox"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const channelCustomersChanged = "beaesthetic.customers.changed"
+
+// CustomerEventPublisher writes the customer events to the outbox table, from which the outbox forwarder
+// delivers them to RabbitMQ.
+type CustomerEventPublisher struct {
+	publisher outbox.Publisher
+}
+
+func NewCustomerEventPublisher(publisher outbox.Publisher) *CustomerEventPublisher {
+	return &CustomerEventPublisher{publisher: publisher}
+}
+
+func (p *CustomerEventPublisher) PublishCustomerChanged(ctx context.Context, customer customerdomain.Customer) error {
+	occurredAt := time.Now().UTC()
+	payload, err := protojson.Marshal(customerChangedEvent(customer, occurredAt))
+	if err != nil {
+		return fmt.Errorf("marshal customer changed event: %w", err)
+	}
+	if err := p.publisher.Publish(ctx, outbox.Message{
+		ID:          uuid.NewString(),
+		Channel:     outbox.Channel(channelCustomersChanged),
+		AffinityKey: outbox.AffinityKey(customer.ID),
+		Payload:     payload,
+		Metadata:    outbox.Metadata{},
+		OccurredAt:  occurredAt,
+	}); err != nil {
+		return fmt.Errorf("publish customer changed event: %w", err)
+	}
+	return nil
+}
+
+func customerChangedEvent(customer customerdomain.Customer, occurredAt time.Time) *customercontracts.CustomerChanged {
+	return &customercontracts.CustomerChanged{
+		CustomerId:  customer.ID,
+		Name:        customer.Name,
+		Surname:     customer.Surname,
+		DisplayName: customer.DisplayName(),
+		OccurredAt:  timestamppb.New(occurredAt),
+	}
+}
@@ -2,25 +2,30 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"encoding/base64"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	customerdomain "github.com/petretiandrea/beaesthetic-backend/customer/internal/domain/customer"
 	"github.com/petretiandrea/beaesthetic-backend/customer/internal/infra/postgres/queries"
 )
 
 type CustomerRepository struct {
-	db      *sql.DB
+	db      *ContextDB
 	queries *queries.Queries
 }
 
-func NewCustomerRepository(db *sql.DB) *CustomerRepository {
+func NewCustomerRepository(db *ContextDB) *CustomerRepository {
 	return &CustomerRepository{db: db, queries: queries.New(db)}
 }
 
+func (r *CustomerRepository) Tx(ctx context.Context, atomicFn func(ctx context.Context) error) error {
+	return r.db.Tx(ctx, atomicFn)
+}
+
 func (r *CustomerRepository) Save(ctx context.Context, c customerdomain.Customer) (customerdomain.Customer, error) {
 	phone := (*string)(nil)
 	if c.Phone != nil {
@@ -34,13 +39,13 @@ func (r *CustomerRepository) Save(ctx context.Context, c customerdomain.Customer
 		Email:     nullableString(c.Email),
 		Phone:     nullableString(phone),
 		Note:      c.Note,
-		UpdatedAt: time.Now().UTC(),
+		UpdatedAt: pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
 	})
 }
 
 func (r *CustomerRepository) FindByID(ctx context.Context, id string) (*customerdomain.Customer, error) {
 	row, err := r.queries.FindCustomerByID(ctx, id)
-	if err == sql.ErrNoRows {
+	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
@@ -66,7 +71,7 @@ func (r *CustomerRepository) FindAll(ctx context.Context, filter string, limit i
 		return out, nil
 	}
 	rows, err := r.queries.SearchCustomers(ctx, queries.SearchCustomersParams{
-		Column1: sql.NullString{String: strings.ToLower(filter), Valid: true},
+		Column1: pgtype.Text{String: strings.ToLower(filter), Valid: true},
 		Limit:   int32(limit),
 	})
 	if err != nil {
@@ -80,8 +85,8 @@ func (r *CustomerRepository) FindAll(ctx context.Context, filter string, limit i
 }
 
 func (r *CustomerRepository) FindByPhone(ctx context.Context, phone string) (*customerdomain.Customer, error) {
-	row, err := r.queries.FindCustomerByPhone(ctx, sql.NullString{String: phone, Valid: true})
-	if err == sql.ErrNoRows {
+	row, err := r.queries.FindCustomerByPhone(ctx, pgtype.Text{String: phone, Valid: true})
+	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
@@ -108,23 +113,19 @@ func (r *CustomerRepository) FindPage(ctx context.Context, pageToken string, lim
 }
 
 func (r *CustomerRepository) Delete(ctx context.Context, id string) (bool, error) {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return false, err
-	}
-	defer tx.Rollback()
-	qtx := r.queries.WithTx(tx)
-	rowsAffected, err := qtx.ArchiveDeletedCustomer(ctx, id)
+	deleted := false
+	err := r.db.Tx(ctx, func(ctx context.Context) error {
+		rowsAffected, err := r.queries.ArchiveDeletedCustomer(ctx, id)
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		deleted = true
+		return r.queries.DeleteCustomer(ctx, id)
+	})
 	if err != nil {
 		return false, err
 	}
-	if rowsAffected == 0 {
-		return false, nil
-	}
-	if err := qtx.DeleteCustomer(ctx, id); err != nil {
-		return false, err
-	}
-	return true, tx.Commit()
+	return deleted, nil
 }
 
 func (r *CustomerRepository) findPage(ctx context.Context, limit int, offset int, sortBy string, direction string) ([]customerdomain.Customer, error) {
@@ -163,7 +164,7 @@ func (r *CustomerRepository) findPage(ctx context.Context, limit int, offset int
 	}
 }
 
-func mapCustomer(id string, name string, surname string, email sql.NullString, phone sql.NullString, note string) customerdomain.Customer {
+func mapCustomer(id string, name string, surname string, email pgtype.Text, phone pgtype.Text, note string) customerdomain.Customer {
 	c := customerdomain.Customer{ID: id, Name: name, Surname: surname, Note: note}
 	if email.Valid {
 		c.Email = &email.String
@@ -217,11 +218,11 @@ func mapCustomerPageByUpdatedAtDesc(rows []queries.FindCustomersPageByUpdatedAtD
 	return out
 }
 
-func nullableString(value *string) sql.NullString {
+func nullableString(value *string) pgtype.Text {
 	if value == nil {
-		return sql.NullString{}
+		return pgtype.Text{}
 	}
-	return sql.NullString{String: *value, Valid: true}
+	return pgtype.Text{String: *value, Valid: true}
 }
 
 func encodePageToken(offset int) string {
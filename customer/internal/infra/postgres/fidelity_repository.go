@@ -2,16 +2,16 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/petretiandrea/beaesthetic-backend/customer/internal/domain/fidelity"
 	"github.com/petretiandrea/beaesthetic-backend/customer/internal/infra/postgres/queries"
 )
 
 type FidelityRepository struct{ queries *queries.Queries }
 
-func NewFidelityRepository(db *sql.DB) *FidelityRepository {
+func NewFidelityRepository(db *ContextDB) *FidelityRepository {
 	return &FidelityRepository{queries: queries.New(db)}
 }
 
@@ -40,7 +40,7 @@ func (r *FidelityRepository) FindAll(ctx context.Context) ([]fidelity.Card, erro
 }
 func (r *FidelityRepository) FindByID(ctx context.Context, id string) (*fidelity.Card, error) {
 	row, err := r.queries.FindFidelityCardByID(ctx, id)
-	if err == sql.ErrNoRows {
+	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
@@ -73,7 +73,7 @@ func (r *FidelityRepository) FindByVoucherID(ctx context.Context, voucherID stri
 		return nil, err
 	}
 	row, err := r.queries.FindFidelityCardByVoucherID(ctx, filter)
-	if err == sql.ErrNoRows {
+	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
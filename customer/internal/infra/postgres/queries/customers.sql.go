@@ -7,8 +7,8 @@ package queries
 
 import (
 	"context"
-	"database/sql"
-	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const archiveDeletedCustomer = `-- name: ArchiveDeletedCustomer :execrows
@@ -20,11 +20,11 @@ ON CONFLICT (id) DO NOTHING
 `
 
 func (q *Queries) ArchiveDeletedCustomer(ctx context.Context, id string) (int64, error) {
-	result, err := q.db.ExecContext(ctx, archiveDeletedCustomer, id)
+	result, err := q.db.Exec(ctx, archiveDeletedCustomer, id)
 	if err != nil {
 		return 0, err
 	}
-	return result.RowsAffected()
+	return result.RowsAffected(), nil
 }
 
 const deleteCustomer = `-- name: DeleteCustomer :exec
@@ -33,7 +33,7 @@ WHERE id = $1
 `
 
 func (q *Queries) DeleteCustomer(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteCustomer, id)
+	_, err := q.db.Exec(ctx, deleteCustomer, id)
 	return err
 }
 
@@ -44,16 +44,16 @@ WHERE id = $1
 `
 
 type FindCustomerByIDRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomerByID(ctx context.Context, id string) (FindCustomerByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, findCustomerByID, id)
+	row := q.db.QueryRow(ctx, findCustomerByID, id)
 	var i FindCustomerByIDRow
 	err := row.Scan(
 		&i.ID,
@@ -74,16 +74,16 @@ LIMIT 1
 `
 
 type FindCustomerByPhoneRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
-func (q *Queries) FindCustomerByPhone(ctx context.Context, phone sql.NullString) (FindCustomerByPhoneRow, error) {
-	row := q.db.QueryRowContext(ctx, findCustomerByPhone, phone)
+func (q *Queries) FindCustomerByPhone(ctx context.Context, phone pgtype.Text) (FindCustomerByPhoneRow, error) {
+	row := q.db.QueryRow(ctx, findCustomerByPhone, phone)
 	var i FindCustomerByPhoneRow
 	err := row.Scan(
 		&i.ID,
@@ -104,16 +104,16 @@ LIMIT $1
 `
 
 type FindCustomersRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomers(ctx context.Context, limit int32) ([]FindCustomersRow, error) {
-	rows, err := q.db.QueryContext(ctx, findCustomers, limit)
+	rows, err := q.db.Query(ctx, findCustomers, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -133,9 +133,6 @@ func (q *Queries) FindCustomers(ctx context.Context, limit int32) ([]FindCustome
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -155,16 +152,16 @@ type FindCustomersPageByNameAscParams struct {
 }
 
 type FindCustomersPageByNameAscRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomersPageByNameAsc(ctx context.Context, arg FindCustomersPageByNameAscParams) ([]FindCustomersPageByNameAscRow, error) {
-	rows, err := q.db.QueryContext(ctx, findCustomersPageByNameAsc, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, findCustomersPageByNameAsc, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -184,9 +181,6 @@ func (q *Queries) FindCustomersPageByNameAsc(ctx context.Context, arg FindCustom
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -206,16 +200,16 @@ type FindCustomersPageByNameDescParams struct {
 }
 
 type FindCustomersPageByNameDescRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomersPageByNameDesc(ctx context.Context, arg FindCustomersPageByNameDescParams) ([]FindCustomersPageByNameDescRow, error) {
-	rows, err := q.db.QueryContext(ctx, findCustomersPageByNameDesc, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, findCustomersPageByNameDesc, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -235,9 +229,6 @@ func (q *Queries) FindCustomersPageByNameDesc(ctx context.Context, arg FindCusto
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -257,16 +248,16 @@ type FindCustomersPageBySurnameAscParams struct {
 }
 
 type FindCustomersPageBySurnameAscRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomersPageBySurnameAsc(ctx context.Context, arg FindCustomersPageBySurnameAscParams) ([]FindCustomersPageBySurnameAscRow, error) {
-	rows, err := q.db.QueryContext(ctx, findCustomersPageBySurnameAsc, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, findCustomersPageBySurnameAsc, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -286,9 +277,6 @@ func (q *Queries) FindCustomersPageBySurnameAsc(ctx context.Context, arg FindCus
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -308,16 +296,16 @@ type FindCustomersPageBySurnameDescParams struct {
 }
 
 type FindCustomersPageBySurnameDescRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomersPageBySurnameDesc(ctx context.Context, arg FindCustomersPageBySurnameDescParams) ([]FindCustomersPageBySurnameDescRow, error) {
-	rows, err := q.db.QueryContext(ctx, findCustomersPageBySurnameDesc, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, findCustomersPageBySurnameDesc, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -337,9 +325,6 @@ func (q *Queries) FindCustomersPageBySurnameDesc(ctx context.Context, arg FindCu
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -359,16 +344,16 @@ type FindCustomersPageByUpdatedAtAscParams struct {
 }
 
 type FindCustomersPageByUpdatedAtAscRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomersPageByUpdatedAtAsc(ctx context.Context, arg FindCustomersPageByUpdatedAtAscParams) ([]FindCustomersPageByUpdatedAtAscRow, error) {
-	rows, err := q.db.QueryContext(ctx, findCustomersPageByUpdatedAtAsc, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, findCustomersPageByUpdatedAtAsc, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -388,9 +373,6 @@ func (q *Queries) FindCustomersPageByUpdatedAtAsc(ctx context.Context, arg FindC
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -410,16 +392,16 @@ type FindCustomersPageByUpdatedAtDescParams struct {
 }
 
 type FindCustomersPageByUpdatedAtDescRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) FindCustomersPageByUpdatedAtDesc(ctx context.Context, arg FindCustomersPageByUpdatedAtDescParams) ([]FindCustomersPageByUpdatedAtDescRow, error) {
-	rows, err := q.db.QueryContext(ctx, findCustomersPageByUpdatedAtDesc, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, findCustomersPageByUpdatedAtDesc, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -439,9 +421,6 @@ func (q *Queries) FindCustomersPageByUpdatedAtDesc(ctx context.Context, arg Find
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -461,17 +440,17 @@ ON CONFLICT (id) DO UPDATE SET
 `
 
 type SaveCustomerParams struct {
-	ID        string         `json:"id"`
-	Name      string         `json:"name"`
-	Surname   string         `json:"surname"`
-	Email     sql.NullString `json:"email"`
-	Phone     sql.NullString `json:"phone"`
-	Note      string         `json:"note"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	Surname   string             `json:"surname"`
+	Email     pgtype.Text        `json:"email"`
+	Phone     pgtype.Text        `json:"phone"`
+	Note      string             `json:"note"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
 func (q *Queries) SaveCustomer(ctx context.Context, arg SaveCustomerParams) error {
-	_, err := q.db.ExecContext(ctx, saveCustomer,
+	_, err := q.db.Exec(ctx, saveCustomer,
 		arg.ID,
 		arg.Name,
 		arg.Surname,
@@ -492,21 +471,21 @@ LIMIT $2
 `
 
 type SearchCustomersParams struct {
-	Column1 sql.NullString `json:"column_1"`
-	Limit   int32          `json:"limit"`
+	Column1 pgtype.Text `json:"column_1"`
+	Limit   int32       `json:"limit"`
 }
 
 type SearchCustomersRow struct {
-	ID      string         `json:"id"`
-	Name    string         `json:"name"`
-	Surname string         `json:"surname"`
-	Email   sql.NullString `json:"email"`
-	Phone   sql.NullString `json:"phone"`
-	Note    string         `json:"note"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Surname string      `json:"surname"`
+	Email   pgtype.Text `json:"email"`
+	Phone   pgtype.Text `json:"phone"`
+	Note    string      `json:"note"`
 }
 
 func (q *Queries) SearchCustomers(ctx context.Context, arg SearchCustomersParams) ([]SearchCustomersRow, error) {
-	rows, err := q.db.QueryContext(ctx, searchCustomers, arg.Column1, arg.Limit)
+	rows, err := q.db.Query(ctx, searchCustomers, arg.Column1, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -526,9 +505,6 @@ func (q *Queries) SearchCustomers(ctx context.Context, arg SearchCustomersParams
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -24,7 +24,7 @@ type FindFidelityCardByIDRow struct {
 }
 
 func (q *Queries) FindFidelityCardByID(ctx context.Context, id string) (FindFidelityCardByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, findFidelityCardByID, id)
+	row := q.db.QueryRow(ctx, findFidelityCardByID, id)
 	var i FindFidelityCardByIDRow
 	err := row.Scan(
 		&i.ID,
@@ -50,7 +50,7 @@ type FindFidelityCardByVoucherIDRow struct {
 }
 
 func (q *Queries) FindFidelityCardByVoucherID(ctx context.Context, dollar_1 json.RawMessage) (FindFidelityCardByVoucherIDRow, error) {
-	row := q.db.QueryRowContext(ctx, findFidelityCardByVoucherID, dollar_1)
+	row := q.db.QueryRow(ctx, findFidelityCardByVoucherID, dollar_1)
 	var i FindFidelityCardByVoucherIDRow
 	err := row.Scan(
 		&i.ID,
@@ -75,7 +75,7 @@ type FindFidelityCardsRow struct {
 }
 
 func (q *Queries) FindFidelityCards(ctx context.Context) ([]FindFidelityCardsRow, error) {
-	rows, err := q.db.QueryContext(ctx, findFidelityCards)
+	rows, err := q.db.Query(ctx, findFidelityCards)
 	if err != nil {
 		return nil, err
 	}
@@ -93,9 +93,6 @@ func (q *Queries) FindFidelityCards(ctx context.Context) ([]FindFidelityCardsRow
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -116,7 +113,7 @@ type FindFidelityCardsByCustomerIDRow struct {
 }
 
 func (q *Queries) FindFidelityCardsByCustomerID(ctx context.Context, customerID string) ([]FindFidelityCardsByCustomerIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, findFidelityCardsByCustomerID, customerID)
+	rows, err := q.db.Query(ctx, findFidelityCardsByCustomerID, customerID)
 	if err != nil {
 		return nil, err
 	}
@@ -134,9 +131,6 @@ func (q *Queries) FindFidelityCardsByCustomerID(ctx context.Context, customerID
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -161,7 +155,7 @@ type SaveFidelityCardParams struct {
 }
 
 func (q *Queries) SaveFidelityCard(ctx context.Context, arg SaveFidelityCardParams) error {
-	_, err := q.db.ExecContext(ctx, saveFidelityCard,
+	_, err := q.db.Exec(ctx, saveFidelityCard,
 		arg.ID,
 		arg.CustomerID,
 		arg.SolariumPurchases,
@@ -7,9 +7,9 @@ package queries
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
-	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const findWalletByCustomerID = `-- name: FindWalletByCustomerID :one
@@ -19,7 +19,7 @@ WHERE owner = $1
 `
 
 func (q *Queries) FindWalletByCustomerID(ctx context.Context, owner string) (Wallet, error) {
-	row := q.db.QueryRowContext(ctx, findWalletByCustomerID, owner)
+	row := q.db.QueryRow(ctx, findWalletByCustomerID, owner)
 	var i Wallet
 	err := row.Scan(
 		&i.ID,
@@ -41,7 +41,7 @@ WHERE id = $1
 `
 
 func (q *Queries) FindWalletByID(ctx context.Context, id string) (Wallet, error) {
-	row := q.db.QueryRowContext(ctx, findWalletByID, id)
+	row := q.db.QueryRow(ctx, findWalletByID, id)
 	var i Wallet
 	err := row.Scan(
 		&i.ID,
@@ -78,24 +78,24 @@ WHERE w.id = $1
 `
 
 type FindWalletReadModelByIDRow struct {
-	ID              string          `json:"id"`
-	Owner           string          `json:"owner"`
-	AvailableAmount float64         `json:"available_amount"`
-	Spent           float64         `json:"spent"`
-	Operations      json.RawMessage `json:"operations"`
-	GiftCards       json.RawMessage `json:"gift_cards"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	CustomerID      string          `json:"customer_id"`
-	Name            string          `json:"name"`
-	Surname         string          `json:"surname"`
-	Email           sql.NullString  `json:"email"`
-	Phone           sql.NullString  `json:"phone"`
-	Note            string          `json:"note"`
+	ID              string             `json:"id"`
+	Owner           string             `json:"owner"`
+	AvailableAmount float64            `json:"available_amount"`
+	Spent           float64            `json:"spent"`
+	Operations      json.RawMessage    `json:"operations"`
+	GiftCards       json.RawMessage    `json:"gift_cards"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	CustomerID      string             `json:"customer_id"`
+	Name            string             `json:"name"`
+	Surname         string             `json:"surname"`
+	Email           pgtype.Text        `json:"email"`
+	Phone           pgtype.Text        `json:"phone"`
+	Note            string             `json:"note"`
 }
 
 func (q *Queries) FindWalletReadModelByID(ctx context.Context, id string) (FindWalletReadModelByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, findWalletReadModelByID, id)
+	row := q.db.QueryRow(ctx, findWalletReadModelByID, id)
 	var i FindWalletReadModelByIDRow
 	err := row.Scan(
 		&i.ID,
@@ -138,24 +138,24 @@ ORDER BY w.created_at DESC
 `
 
 type FindWalletReadModelsRow struct {
-	ID              string          `json:"id"`
-	Owner           string          `json:"owner"`
-	AvailableAmount float64         `json:"available_amount"`
-	Spent           float64         `json:"spent"`
-	Operations      json.RawMessage `json:"operations"`
-	GiftCards       json.RawMessage `json:"gift_cards"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	CustomerID      string          `json:"customer_id"`
-	Name            string          `json:"name"`
-	Surname         string          `json:"surname"`
-	Email           sql.NullString  `json:"email"`
-	Phone           sql.NullString  `json:"phone"`
-	Note            string          `json:"note"`
+	ID              string             `json:"id"`
+	Owner           string             `json:"owner"`
+	AvailableAmount float64            `json:"available_amount"`
+	Spent           float64            `json:"spent"`
+	Operations      json.RawMessage    `json:"operations"`
+	GiftCards       json.RawMessage    `json:"gift_cards"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	CustomerID      string             `json:"customer_id"`
+	Name            string             `json:"name"`
+	Surname         string             `json:"surname"`
+	Email           pgtype.Text        `json:"email"`
+	Phone           pgtype.Text        `json:"phone"`
+	Note            string             `json:"note"`
 }
 
 func (q *Queries) FindWalletReadModels(ctx context.Context) ([]FindWalletReadModelsRow, error) {
-	rows, err := q.db.QueryContext(ctx, findWalletReadModels)
+	rows, err := q.db.Query(ctx, findWalletReadModels)
 	if err != nil {
 		return nil, err
 	}
@@ -183,9 +183,6 @@ func (q *Queries) FindWalletReadModels(ctx context.Context) ([]FindWalletReadMod
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
@@ -205,18 +202,18 @@ ON CONFLICT (id) DO UPDATE SET
 `
 
 type SaveWalletParams struct {
-	ID              string          `json:"id"`
-	Owner           string          `json:"owner"`
-	AvailableAmount float64         `json:"available_amount"`
-	Spent           float64         `json:"spent"`
-	Operations      json.RawMessage `json:"operations"`
-	GiftCards       json.RawMessage `json:"gift_cards"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
+	ID              string             `json:"id"`
+	Owner           string             `json:"owner"`
+	AvailableAmount float64            `json:"available_amount"`
+	Spent           float64            `json:"spent"`
+	Operations      json.RawMessage    `json:"operations"`
+	GiftCards       json.RawMessage    `json:"gift_cards"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
 }
 
 func (q *Queries) SaveWallet(ctx context.Context, arg SaveWalletParams) error {
-	_, err := q.db.ExecContext(ctx, saveWallet,
+	_, err := q.db.Exec(ctx, saveWallet,
 		arg.ID,
 		arg.Owner,
 		arg.AvailableAmount,
@@ -252,24 +249,24 @@ ORDER BY w.created_at DESC
 `
 
 type SearchWalletReadModelsRow struct {
-	ID              string          `json:"id"`
-	Owner           string          `json:"owner"`
-	AvailableAmount float64         `json:"available_amount"`
-	Spent           float64         `json:"spent"`
-	Operations      json.RawMessage `json:"operations"`
-	GiftCards       json.RawMessage `json:"gift_cards"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	CustomerID      string          `json:"customer_id"`
-	Name            string          `json:"name"`
-	Surname         string          `json:"surname"`
-	Email           sql.NullString  `json:"email"`
-	Phone           sql.NullString  `json:"phone"`
-	Note            string          `json:"note"`
+	ID              string             `json:"id"`
+	Owner           string             `json:"owner"`
+	AvailableAmount float64            `json:"available_amount"`
+	Spent           float64            `json:"spent"`
+	Operations      json.RawMessage    `json:"operations"`
+	GiftCards       json.RawMessage    `json:"gift_cards"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	CustomerID      string             `json:"customer_id"`
+	Name            string             `json:"name"`
+	Surname         string             `json:"surname"`
+	Email           pgtype.Text        `json:"email"`
+	Phone           pgtype.Text        `json:"phone"`
+	Note            string             `json:"note"`
 }
 
-func (q *Queries) SearchWalletReadModels(ctx context.Context, dollar_1 sql.NullString) ([]SearchWalletReadModelsRow, error) {
-	rows, err := q.db.QueryContext(ctx, searchWalletReadModels, dollar_1)
+func (q *Queries) SearchWalletReadModels(ctx context.Context, dollar_1 pgtype.Text) ([]SearchWalletReadModelsRow, error) {
+	rows, err := q.db.Query(ctx, searchWalletReadModels, dollar_1)
 	if err != nil {
 		return nil, err
 	}
@@ -297,9 +294,6 @@ func (q *Queries) SearchWalletReadModels(ctx context.Context, dollar_1 sql.NullS
 		}
 		items = append(items, i)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
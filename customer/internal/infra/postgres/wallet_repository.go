@@ -2,11 +2,11 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"strings"
-	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/petretiandrea/beaesthetic-backend/customer/internal/application"
 	customerdomain "github.com/petretiandrea/beaesthetic-backend/customer/internal/domain/customer"
 	"github.com/petretiandrea/beaesthetic-backend/customer/internal/domain/wallet"
@@ -15,7 +15,7 @@ import (
 
 type WalletRepository struct{ queries *queries.Queries }
 
-func NewWalletRepository(db *sql.DB) *WalletRepository {
+func NewWalletRepository(db *ContextDB) *WalletRepository {
 	return &WalletRepository{queries: queries.New(db)}
 }
 func (r *WalletRepository) Save(ctx context.Context, w wallet.Wallet) (wallet.Wallet, error) {
@@ -34,13 +34,13 @@ func (r *WalletRepository) Save(ctx context.Context, w wallet.Wallet) (wallet.Wa
 		Spent:           w.Spent,
 		Operations:      operations,
 		GiftCards:       giftCards,
-		CreatedAt:       w.CreatedAt,
-		UpdatedAt:       w.UpdatedAt,
+		CreatedAt:       pgtype.Timestamptz{Time: w.CreatedAt, Valid: true},
+		UpdatedAt:       pgtype.Timestamptz{Time: w.UpdatedAt, Valid: true},
 	})
 }
 func (r *WalletRepository) FindDomainByID(ctx context.Context, id string) (*wallet.Wallet, error) {
 	row, err := r.queries.FindWalletByID(ctx, id)
-	if err == sql.ErrNoRows {
+	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
@@ -51,7 +51,7 @@ func (r *WalletRepository) FindDomainByID(ctx context.Context, id string) (*wall
 }
 func (r *WalletRepository) FindByCustomer(ctx context.Context, customerID string) (*wallet.Wallet, error) {
 	row, err := r.queries.FindWalletByCustomerID(ctx, customerID)
-	if err == sql.ErrNoRows {
+	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
@@ -62,7 +62,7 @@ func (r *WalletRepository) FindByCustomer(ctx context.Context, customerID string
 }
 func (r *WalletRepository) FindByID(ctx context.Context, id string) (*application.WalletReadModel, error) {
 	row, err := r.queries.FindWalletReadModelByID(ctx, id)
-	if err == sql.ErrNoRows {
+	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
@@ -74,7 +74,7 @@ func (r *WalletRepository) FindByID(ctx context.Context, id string) (*applicatio
 func (r *WalletRepository) FindAll(ctx context.Context, filter string) ([]application.WalletReadModel, error) {
 	var out []application.WalletReadModel
 	if strings.TrimSpace(filter) != "" {
-		rows, err := r.queries.SearchWalletReadModels(ctx, sql.NullString{String: strings.ToLower(filter), Valid: true})
+		rows, err := r.queries.SearchWalletReadModels(ctx, pgtype.Text{String: strings.ToLower(filter), Valid: true})
 		if err != nil {
 			return nil, err
 		}
@@ -101,23 +101,23 @@ func mapWallet(row queries.Wallet) wallet.Wallet {
 		Owner:           row.Owner,
 		AvailableAmount: row.AvailableAmount,
 		Spent:           row.Spent,
-		CreatedAt:       row.CreatedAt,
-		UpdatedAt:       row.UpdatedAt,
+		CreatedAt:       row.CreatedAt.Time,
+		UpdatedAt:       row.UpdatedAt.Time,
 	}
 	_ = json.Unmarshal(row.Operations, &w.Operations)
 	_ = json.Unmarshal(row.GiftCards, &w.GiftCards)
 	return w
 }
 
-func mapWalletReadModel(id string, owner string, availableAmount float64, spent float64, operations []byte, giftCards []byte, createdAt time.Time, updatedAt time.Time, customerID string, name string, surname string, email sql.NullString, phone sql.NullString, note string) application.WalletReadModel {
+func mapWalletReadModel(id string, owner string, availableAmount float64, spent float64, operations []byte, giftCards []byte, createdAt pgtype.Timestamptz, updatedAt pgtype.Timestamptz, customerID string, name string, surname string, email pgtype.Text, phone pgtype.Text, note string) application.WalletReadModel {
 	m := application.WalletReadModel{
 		Wallet: wallet.Wallet{
 			ID:              id,
 			Owner:           owner,
 			AvailableAmount: availableAmount,
 			Spent:           spent,
-			CreatedAt:       createdAt,
-			UpdatedAt:       updatedAt,
+			CreatedAt:       createdAt.Time,
+			UpdatedAt:       updatedAt.Time,
 		},
 		Customer: customerdomain.Customer{
 			ID:      customerID,
@@ -1,13 +1,13 @@
 package server
 
 import (
-	"database/sql"
 	"errors"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/petretiandrea/beaesthetic-backend/customer/internal/application"
 	cacheinfra "github.com/petretiandrea/beaesthetic-backend/customer/internal/infra/cache"
 	customerapi "github.com/petretiandrea/beaesthetic-backend/customer/internal/port/http/server/customer"
@@ -20,7 +20,7 @@ type HttpHandlers struct {
 	Customer customerapi.StrictServerInterface
 	Fidelity fidelityapi.StrictServerInterface
 	Wallet   walletapi.StrictServerInterface
-	DB       *sql.DB
+	DB       *pgxpool.Pool
 }
 
 func New(handlers *HttpHandlers, log *zap.Logger) *gin.Engine {
@@ -66,13 +66,13 @@ func registerTrailingSlashAliases(r gin.IRouter, fidelityHandler fidelityapi.Ser
 	r.POST("/admin/wallets/giftCard/", walletHandler.AddGiftCard)
 }
 
-func healthCheck(db *sql.DB) gin.HandlerFunc {
+func healthCheck(db *pgxpool.Pool) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		if db == nil {
 			ctx.JSON(http.StatusServiceUnavailable, gin.H{"status": "down"})
 			return
 		}
-		if err := db.PingContext(ctx.Request.Context()); err != nil {
+		if err := db.Ping(ctx.Request.Context()); err != nil {
 			ctx.JSON(http.StatusServiceUnavailable, gin.H{"status": "down"})
 			return
 		}
@@ -60,6 +60,12 @@ func TestRendererRendersBuiltInAppointmentTemplates(t *testing.T) {
 			startAt:          "2026-07-04T13:30:00Z",
 			want:             "Il centro Be Aesthetic ti informa che il tuo appuntamento è stato spostato. La nuova data è sabato 4 luglio, 2026 alle ore 15:30.\nBuona giornata!\n",
 		},
+		{
+			name:             "canceled",
+			notificationType: "appointment_canceled",
+			startAt:          "2026-07-04T13:30:00Z",
+			want:             "Il centro Be Aesthetic ti informa che il tuo appuntamento di sabato 4 luglio, 2026 alle ore 15:30 è stato annullato. Contattaci per fissare una nuova data.\nBuona giornata!\n",
+		},
 		{
 			name:             "reminder christmas holidays",
 			notificationType: "appointment_reminder",